@@ -5,6 +5,8 @@ Copyright © 2023 NAME HERE <EMAIL ADDRESS>
 package add
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/tupyy/tinyedge-controller/client/cmd"
 )
@@ -16,6 +18,9 @@ var (
 	name            string
 	configurationID string
 	isDefault       bool
+	setID           string
+	maxUses         int32
+	tokenTTL        time.Duration
 )
 
 // addCmd represents the add command
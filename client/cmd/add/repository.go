@@ -3,6 +3,7 @@ package add
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
@@ -10,8 +11,17 @@ import (
 )
 
 var (
-	authMethod     string
-	authSecretPath string
+	authMethod            string
+	authSecretPath        string
+	webhookSecretPath     string
+	signingKeysSecretPath string
+	pullPeriod            time.Duration
+	branch                string
+	tagPattern            string
+	commit                string
+	subpath               string
+	includeGlobs          []string
+	excludeGlobs          []string
 )
 
 var addRepository = &cobra.Command{
@@ -36,10 +46,19 @@ var addRepository = &cobra.Command{
 
 		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.AddRepositoryResponse, error) {
 			req := &adminGrpc.AddRepositoryRequest{
-				Url:            repoUrl,
-				Name:           repoName,
-				AuthMethod:     authMethod,
-				AuthSecretPath: authSecretPath,
+				Url:                   repoUrl,
+				Name:                  repoName,
+				AuthMethod:            authMethod,
+				AuthSecretPath:        authSecretPath,
+				WebhookSecretPath:     webhookSecretPath,
+				SigningKeysSecretPath: signingKeysSecretPath,
+				PullPeriod:            int32(pullPeriod.Seconds()),
+				Branch:                branch,
+				TagPattern:            tagPattern,
+				Commit:                commit,
+				Subpath:               subpath,
+				IncludeGlobs:          includeGlobs,
+				ExcludeGlobs:          excludeGlobs,
 			}
 			return client.AddRepository(ctx, req)
 		}
@@ -55,4 +74,13 @@ func init() {
 	addRepository.Flags().StringVarP(&repoName, "name", "n", "", "git repository name")
 	addRepository.Flags().StringVar(&authMethod, "auth-method", "", "auth method")
 	addRepository.Flags().StringVar(&authSecretPath, "auth-secret-path", "", "auth vault secret path")
+	addRepository.Flags().DurationVar(&pullPeriod, "pull-period", 0, "period between two pulls of the repository, e.g. 5m. The default period is used if not set")
+	addRepository.Flags().StringVar(&branch, "branch", "", "branch followed by the repository. The default branch is followed if no reference is set")
+	addRepository.Flags().StringVar(&tagPattern, "tag-pattern", "", "glob pattern of the tags followed by the repository, e.g. v*. The highest semantic version is followed")
+	addRepository.Flags().StringVar(&commit, "commit", "", "full sha of the commit to which the repository is pinned")
+	addRepository.Flags().StringVar(&webhookSecretPath, "webhook-secret-path", "", "vault secret path of the webhook key. Push webhooks are refused if not set")
+	addRepository.Flags().StringVar(&signingKeysSecretPath, "signing-keys-secret-path", "", "vault secret path of the keys trusted to sign the commits. Commits are not verified if not set")
+	addRepository.Flags().StringVar(&subpath, "subpath", "", "directory of the repository holding the manifests. The whole repository is used if not set")
+	addRepository.Flags().StringArrayVar(&includeGlobs, "include", nil, "glob of the files used for the manifests, relative to the subpath, e.g. team-a/**. Can be repeated")
+	addRepository.Flags().StringArrayVar(&excludeGlobs, "exclude", nil, "glob of the files ignored, relative to the subpath, e.g. **/testdata. Can be repeated")
 }
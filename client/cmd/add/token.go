@@ -0,0 +1,42 @@
+package add
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+var addToken = &cobra.Command{
+	Use:   "token",
+	Short: "token [options]",
+	Long:  "Creates an enrolment token. The token is printed only once.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.EnrolmentToken, error) {
+			req := &adminGrpc.AddEnrolmentTokenRequest{
+				MaxUses: maxUses,
+				Ttl:     int64(tokenTTL.Seconds()),
+			}
+			if namespaceID != "" {
+				req.NamespaceId = &namespaceID
+			}
+			if setID != "" {
+				req.SetId = &setID
+			}
+			return client.AddEnrolmentToken(ctx, req)
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	addCmd.AddCommand(addToken)
+
+	addToken.Flags().StringVarP(&namespaceID, "namespace", "n", "", "namespace in which the devices are placed")
+	addToken.Flags().StringVarP(&setID, "set", "s", "", "set in which the devices are placed")
+	addToken.Flags().Int32VarP(&maxUses, "max-uses", "", 1, "number of devices which can enrol with the token")
+	addToken.Flags().DurationVarP(&tokenTTL, "ttl", "", 24*time.Hour, "validity of the token")
+}
@@ -0,0 +1,39 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
+)
+
+var keepTombstone bool
+
+var deleteDeviceCmd = &cobra.Command{
+	Use:   "device",
+	Short: "device [name]",
+	Long:  "Decommission the device. The device certificate is revoked and the device is removed.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("device id is missing")
+		}
+
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*common.Device, error) {
+			req := &adminGrpc.DecommissionDeviceRequest{
+				Id:            args[0],
+				KeepTombstone: keepTombstone,
+			}
+			return client.DecommissionDevice(ctx, req)
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	deleteCmd.AddCommand(deleteDeviceCmd)
+	deleteDeviceCmd.Flags().BoolVar(&keepTombstone, "tombstone", false, "keep the device as decommissioned for audit")
+}
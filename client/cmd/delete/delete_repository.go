@@ -0,0 +1,38 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+var force bool
+
+var deleteRepositoryCmd = &cobra.Command{
+	Use:   "repository",
+	Short: "repository [id] [--force]",
+	Long:  "Removes the repository with its manifests. The repository is not removed if its manifests are still deployed to devices unless --force is set",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("repository id is missing")
+		}
+
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.Repository, error) {
+			req := &adminGrpc.DeleteRepositoryRequest{
+				Id:    args[0],
+				Force: force,
+			}
+			return client.DeleteRepository(ctx, req)
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	deleteCmd.AddCommand(deleteRepositoryCmd)
+	deleteRepositoryCmd.Flags().BoolVar(&force, "force", false, "delete the repository even if its manifests are still deployed to devices")
+}
@@ -0,0 +1,34 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+var deleteTokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "token [id]",
+	Long:  "Removes the enrolment token",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("token id is missing")
+		}
+
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.EnrolmentToken, error) {
+			req := &adminGrpc.IdRequest{
+				Id: args[0],
+			}
+			return client.DeleteEnrolmentToken(ctx, req)
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	deleteCmd.AddCommand(deleteTokenCmd)
+}
@@ -0,0 +1,48 @@
+package enrol
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
+)
+
+var (
+	setID       string
+	namespaceID string
+)
+
+var approveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "approve [device_id] [FLAGS]",
+	Long:  "Approve the enrolment of a pending device and optionally place it into a namespace and/or set",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("Please provide a device id")
+		}
+
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*common.Device, error) {
+			req := &adminGrpc.ApproveDeviceRequest{
+				Id: args[0],
+			}
+			if namespaceID != "" {
+				req.NamespaceId = &namespaceID
+			}
+			if setID != "" {
+				req.SetId = &setID
+			}
+			return client.ApproveDevice(ctx, req)
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	enrolCmd.AddCommand(approveCmd)
+	approveCmd.Flags().StringVarP(&setID, "set", "s", "", "set id")
+	approveCmd.Flags().StringVarP(&namespaceID, "namespace", "n", "", "namespace id")
+}
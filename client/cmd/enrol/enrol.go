@@ -0,0 +1,16 @@
+package enrol
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tupyy/tinyedge-controller/client/cmd"
+)
+
+// enrolCmd represents the enrol command
+var enrolCmd = &cobra.Command{
+	Use:   "enrol",
+	Short: "manage the enrolment of pending devices",
+}
+
+func init() {
+	cmd.AddCommand(enrolCmd)
+}
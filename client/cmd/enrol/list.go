@@ -0,0 +1,25 @@
+package enrol
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list",
+	Long:  "Print out the devices waiting for enrolment approval.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.DevicesListResponse, error) {
+			return client.GetPendingDevices(ctx, &adminGrpc.ListRequest{})
+		}
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	enrolCmd.AddCommand(listCmd)
+}
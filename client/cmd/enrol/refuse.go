@@ -0,0 +1,32 @@
+package enrol
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
+)
+
+var refuseCmd = &cobra.Command{
+	Use:   "refuse",
+	Short: "refuse [device_id]",
+	Long:  "Refuse the enrolment of a pending device",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("Please provide a device id")
+		}
+
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*common.Device, error) {
+			return client.RefuseDevice(ctx, &adminGrpc.IdRequest{Id: args[0]})
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	enrolCmd.AddCommand(refuseCmd)
+}
@@ -0,0 +1,25 @@
+package get
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
+)
+
+var getAuthStatsCmd = &cobra.Command{
+	Use:   "auth-stats",
+	Short: "Get the counters of the device certificate cache",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.AuthCacheStats, error) {
+			return client.GetAuthCacheStats(ctx, &common.Empty{})
+		}
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getAuthStatsCmd)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
 )
 
+var deviceWorkloads bool
+
 var getDeviceCmd = &cobra.Command{
 	Use:   "device",
 	Short: "device [id]",
@@ -17,6 +19,12 @@ var getDeviceCmd = &cobra.Command{
 		if len(args) == 0 {
 			return errors.New("Please provide device id")
 		}
+		if deviceWorkloads {
+			fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.DeviceWorkloadsResponse, error) {
+				return client.GetDeviceWorkloads(ctx, &adminGrpc.IdRequest{Id: args[0]})
+			}
+			return rootCmd.RunCmd(fn)
+		}
 		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*common.Device, error) {
 			return client.GetDevice(ctx, &adminGrpc.IdRequest{Id: args[0]})
 		}
@@ -26,4 +34,5 @@ var getDeviceCmd = &cobra.Command{
 
 func init() {
 	getCmd.AddCommand(getDeviceCmd)
+	getDeviceCmd.Flags().BoolVar(&deviceWorkloads, "workloads", false, "show the status of the workloads reported by the device")
 }
@@ -9,6 +9,8 @@ import (
 	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
 )
 
+var manifestStatus bool
+
 var getNamespaceCmd = &cobra.Command{
 	Use:   "manifest",
 	Short: "manifest [id]",
@@ -16,6 +18,12 @@ var getNamespaceCmd = &cobra.Command{
 		if len(args) == 0 {
 			return errors.New("Please provide manifest id")
 		}
+		if manifestStatus {
+			fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.ManifestRollout, error) {
+				return client.GetManifestRollout(ctx, &adminGrpc.IdRequest{Id: args[0]})
+			}
+			return rootCmd.RunCmd(fn)
+		}
 		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.Manifest, error) {
 			return client.GetManifest(ctx, &adminGrpc.IdRequest{Id: args[0]})
 		}
@@ -25,4 +33,5 @@ var getNamespaceCmd = &cobra.Command{
 
 func init() {
 	getCmd.AddCommand(getNamespaceCmd)
+	getNamespaceCmd.Flags().BoolVar(&manifestStatus, "status", false, "show the status of the manifest on the targeted devices")
 }
@@ -0,0 +1,47 @@
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+var repositoryHistory bool
+
+var getRepositoryCmd = &cobra.Command{
+	Use:   "repository",
+	Short: "repository [id]",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("Please provide repository id")
+		}
+		if repositoryHistory {
+			fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.RepositorySyncHistory, error) {
+				return client.GetRepositorySyncHistory(ctx, &adminGrpc.IdRequest{Id: args[0]})
+			}
+			return rootCmd.RunCmd(fn)
+		}
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.Repository, error) {
+			resp, err := client.GetRepositories(ctx, &adminGrpc.ListRequest{})
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Repositories {
+				if r.Id == args[0] {
+					return r, nil
+				}
+			}
+			return nil, fmt.Errorf("repository %q not found", args[0])
+		}
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getRepositoryCmd)
+	getRepositoryCmd.Flags().BoolVar(&repositoryHistory, "history", false, "show the last sync runs of the repository")
+}
@@ -0,0 +1,25 @@
+package list
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+var tokenCmd = &cobra.Command{
+	Use:   "tokens",
+	Short: "tokens",
+	Long:  "Print out information about enrolment tokens.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.EnrolmentTokenListResponse, error) {
+			return client.GetEnrolmentTokens(ctx, &adminGrpc.ListRequest{})
+		}
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	listCmd.AddCommand(tokenCmd)
+}
@@ -0,0 +1,53 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
+)
+
+var deviceLabels = &cobra.Command{
+	Use:   "labels",
+	Short: "labels [device id] key=value ... key- ...",
+	Long:  "Add or update device labels with key=value and remove them with key-",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 2 {
+			return fmt.Errorf("Please provide a device id and at least one label")
+		}
+		deviceID := args[0]
+
+		labels := make(map[string]string)
+		removed := make([]string, 0)
+		for _, arg := range args[1:] {
+			if strings.HasSuffix(arg, "-") && !strings.Contains(arg, "=") {
+				removed = append(removed, strings.TrimSuffix(arg, "-"))
+				continue
+			}
+			parts := strings.SplitN(arg, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return fmt.Errorf("Invalid label %q. Expected key=value or key-", arg)
+			}
+			labels[parts[0]] = parts[1]
+		}
+
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*common.Device, error) {
+			req := &adminGrpc.UpdateDeviceLabelsRequest{
+				Id:           deviceID,
+				Labels:       labels,
+				RemoveLabels: removed,
+			}
+			return client.UpdateDeviceLabels(ctx, req)
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	setCmd.AddCommand(deviceLabels)
+}
@@ -0,0 +1,156 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+var (
+	pullPeriod            time.Duration
+	pause                 bool
+	branch                string
+	tagPattern            string
+	commit                string
+	repoUrl               string
+	authMethod            string
+	authSecretPath        string
+	webhookSecretPath     string
+	signingKeysSecretPath string
+	subpath               string
+	includeGlobs          []string
+	excludeGlobs          []string
+)
+
+// settingsFlags are the flags changing the settings of the repository through UpdateRepository.
+var settingsFlags = []string{"url", "auth-method", "auth-secret-path", "webhook-secret-path", "signing-keys-secret-path", "subpath", "include", "exclude"}
+
+var updateRepository = &cobra.Command{
+	Use:   "repository",
+	Short: "repository [id] [FLAGS]",
+	Long:  "Change the settings, the git reference or the pull period of a repository or pause it. A paused repository is resumed by setting its pull period",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("Please provide a repository id")
+		}
+
+		updateSchedule := pause || cmd.Flags().Changed("pull-period")
+		updateRef := branch != "" || tagPattern != "" || commit != ""
+		updateSettings := false
+		for _, flag := range settingsFlags {
+			updateSettings = updateSettings || cmd.Flags().Changed(flag)
+		}
+		if !updateSchedule && !updateRef && !updateSettings {
+			return fmt.Errorf("Please provide a setting, a git reference, the pull-period or the pause flag")
+		}
+		if pause && cmd.Flags().Changed("pull-period") {
+			return fmt.Errorf("Please provide either the pull-period or the pause flag")
+		}
+		if cmd.Flags().Changed("pull-period") && pullPeriod < time.Second {
+			return fmt.Errorf("Pull period must be at least one second")
+		}
+
+		if updateSettings {
+			fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.Repository, error) {
+				return client.UpdateRepository(ctx, updateRepositoryRequest(cmd, args[0]))
+			}
+			if err := rootCmd.RunCmd(fn); err != nil {
+				return err
+			}
+		}
+
+		if updateRef {
+			fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.Repository, error) {
+				req := &adminGrpc.UpdateRepositoryRefRequest{
+					Id:         args[0],
+					Branch:     branch,
+					TagPattern: tagPattern,
+					Commit:     commit,
+				}
+				return client.UpdateRepositoryRef(ctx, req)
+			}
+			if err := rootCmd.RunCmd(fn); err != nil {
+				return err
+			}
+		}
+
+		if !updateSchedule {
+			return nil
+		}
+
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.Repository, error) {
+			req := &adminGrpc.UpdateRepositoryPullPeriodRequest{
+				Id: args[0],
+			}
+			if !pause {
+				req.PullPeriod = int32(pullPeriod.Seconds())
+			}
+			return client.UpdateRepositoryPullPeriod(ctx, req)
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+// updateRepositoryRequest returns a request changing only the settings whose flag is set.
+func updateRepositoryRequest(cmd *cobra.Command, id string) *adminGrpc.UpdateRepositoryRequest {
+	req := &adminGrpc.UpdateRepositoryRequest{Id: id}
+	flags := cmd.Flags()
+	if flags.Changed("url") {
+		req.Url = &repoUrl
+	}
+	if flags.Changed("auth-method") {
+		req.AuthMethod = &authMethod
+	}
+	if flags.Changed("auth-secret-path") {
+		req.AuthSecretPath = &authSecretPath
+	}
+	if flags.Changed("webhook-secret-path") {
+		req.WebhookSecretPath = &webhookSecretPath
+	}
+	if flags.Changed("signing-keys-secret-path") {
+		req.SigningKeysSecretPath = &signingKeysSecretPath
+	}
+	if flags.Changed("subpath") {
+		req.Subpath = &subpath
+	}
+	if flags.Changed("include") {
+		req.IncludeGlobs = &adminGrpc.Globs{Globs: nonEmpty(includeGlobs)}
+	}
+	if flags.Changed("exclude") {
+		req.ExcludeGlobs = &adminGrpc.Globs{Globs: nonEmpty(excludeGlobs)}
+	}
+	return req
+}
+
+// nonEmpty removes the empty values so --include "" removes all the globs.
+func nonEmpty(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func init() {
+	setCmd.AddCommand(updateRepository)
+	updateRepository.Flags().DurationVar(&pullPeriod, "pull-period", 0, "period between two pulls of the repository, e.g. 5m")
+	updateRepository.Flags().BoolVar(&pause, "pause", false, "stop pulling the repository")
+	updateRepository.Flags().StringVar(&branch, "branch", "", "branch followed by the repository")
+	updateRepository.Flags().StringVar(&tagPattern, "tag-pattern", "", "glob pattern of the tags followed by the repository, e.g. v*. The highest semantic version is followed")
+	updateRepository.Flags().StringVar(&commit, "commit", "", "full sha of the commit to which the repository is pinned")
+	updateRepository.Flags().StringVar(&repoUrl, "url", "", "git repository url. The repository is cloned again from the new url")
+	updateRepository.Flags().StringVar(&authMethod, "auth-method", "", "auth method, one of \"ssh\", \"token\", \"basic\". An empty method makes the repository public")
+	updateRepository.Flags().StringVar(&authSecretPath, "auth-secret-path", "", "auth vault secret path")
+	updateRepository.Flags().StringVar(&webhookSecretPath, "webhook-secret-path", "", "vault secret path of the webhook key. An empty path refuses the webhooks")
+	updateRepository.Flags().StringVar(&signingKeysSecretPath, "signing-keys-secret-path", "", "vault secret path of the keys trusted to sign the commits. An empty path stops verifying the commits")
+	updateRepository.Flags().StringVar(&subpath, "subpath", "", "directory of the repository holding the manifests. An empty subpath uses the whole repository")
+	updateRepository.Flags().StringArrayVar(&includeGlobs, "include", nil, "glob of the files used for the manifests, relative to the subpath. Can be repeated. Replaces the current globs, an empty glob removes them")
+	updateRepository.Flags().StringArrayVar(&excludeGlobs, "exclude", nil, "glob of the files ignored, relative to the subpath. Can be repeated. Replaces the current globs, an empty glob removes them")
+}
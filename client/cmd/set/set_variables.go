@@ -0,0 +1,65 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	rootCmd "github.com/tupyy/tinyedge-controller/client/cmd"
+	adminGrpc "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+var variables = &cobra.Command{
+	Use:   "variables",
+	Short: "variables [namespace|set|device] [id] key=value ... key- ...",
+	Long:  "Add or update the template variables of a namespace, set or device with key=value and remove them with key-",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 3 {
+			return fmt.Errorf("Please provide a target, an id and at least one variable")
+		}
+
+		var target adminGrpc.VariablesTarget
+		switch args[0] {
+		case "namespace":
+			target = adminGrpc.VariablesTarget_NAMESPACE_TARGET
+		case "set":
+			target = adminGrpc.VariablesTarget_SET_TARGET
+		case "device":
+			target = adminGrpc.VariablesTarget_DEVICE_TARGET
+		default:
+			return fmt.Errorf("Invalid target %q. Expected namespace, set or device", args[0])
+		}
+		id := args[1]
+
+		vars := make(map[string]string)
+		removed := make([]string, 0)
+		for _, arg := range args[2:] {
+			if strings.HasSuffix(arg, "-") && !strings.Contains(arg, "=") {
+				removed = append(removed, strings.TrimSuffix(arg, "-"))
+				continue
+			}
+			parts := strings.SplitN(arg, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return fmt.Errorf("Invalid variable %q. Expected key=value or key-", arg)
+			}
+			vars[parts[0]] = parts[1]
+		}
+
+		fn := func(ctx context.Context, client adminGrpc.AdminServiceClient) (*adminGrpc.Variables, error) {
+			req := &adminGrpc.UpdateVariablesRequest{
+				Target:          target,
+				Id:              id,
+				Variables:       vars,
+				RemoveVariables: removed,
+			}
+			return client.UpdateVariables(ctx, req)
+		}
+
+		return rootCmd.RunCmd(fn)
+	},
+}
+
+func init() {
+	setCmd.AddCommand(variables)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/tupyy/tinyedge-controller/client/cmd"
 	_ "github.com/tupyy/tinyedge-controller/client/cmd/add"
 	_ "github.com/tupyy/tinyedge-controller/client/cmd/delete"
+	_ "github.com/tupyy/tinyedge-controller/client/cmd/enrol"
 	_ "github.com/tupyy/tinyedge-controller/client/cmd/get"
 	_ "github.com/tupyy/tinyedge-controller/client/cmd/list"
 	_ "github.com/tupyy/tinyedge-controller/client/cmd/set"
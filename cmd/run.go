@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
 	"time"
 
 	"github.com/cristalhq/aconfig"
@@ -81,6 +82,10 @@ var runCmd = &cobra.Command{
 		if err != nil {
 			zap.S().Fatal(err)
 		}
+		tokenRepo, err := repo.NewTokenRepo(pgClient)
+		if err != nil {
+			zap.S().Fatal(err)
+		}
 		// cacheRepo := cache.NewCacheRepo()
 
 		// git repo
@@ -89,16 +94,31 @@ var runCmd = &cobra.Command{
 		// create services
 		zap.S().Info("create services")
 		certService := services.NewCertificate(certRepo)
-		manifestService := services.NewManifest(deviceRepo, manifestRepo, gitRepo)
-		deviceService := services.NewDevice(deviceRepo)
-		configurationService := services.NewConfiguration(deviceService)
-		edgeService := services.NewEdge(deviceRepo, configurationService, certService)
-		authService := services.NewAuth(certService, deviceRepo)
+		notificationService := services.NewNotification()
+		manifestService := services.NewManifest(deviceRepo, manifestRepo, gitRepo, notificationService)
+		deviceService := services.NewDevice(deviceRepo, certService, notificationService)
+		configurationService := services.NewConfiguration(deviceService, manifestService, secretRepo, certService)
+		tokenService := services.NewToken(tokenRepo, deviceRepo)
+		workloadService := services.NewWorkload(deviceRepo, manifestRepo, configurationService)
+		edgeService := services.NewEdge(deviceRepo, configurationService, certService, notificationService, tokenService, services.EdgeOptions{
+			AutoEnrolment:            conf.EnableAutoEnrolment,
+			RequireEnrolmentToken:    conf.RequireEnrolmentToken,
+			CertificateTTL:           conf.GetDeviceCertificateTTL(),
+			RenewalWindow:            conf.GetCertificateRenewalWindow(),
+			RevokeRenewedCertificate: conf.RevokeRenewedCertificate,
+		})
+		authService := services.NewAuth(certService, deviceRepo, conf.GetAuthCacheTTL())
 		repoService := services.NewRepository(repoRepo, gitRepo, secretRepo)
+		secretService := services.NewSecret(manifestRepo, secretRepo, deviceService, configurationService, notificationService)
 
+		gitOpsWorker := workers.NewGitOpsWorker(repoService, manifestService, configurationService, conf.GetWebhookPullPeriod())
 		scheduler := workers.New(5 * time.Second)
-		scheduler.AddWorker(workers.NewGitOpsWorker(repoService, manifestService, configurationService))
+		scheduler.AddWorker(gitOpsWorker)
+		scheduler.AddWorker(workers.NewDeviceStateWorker(deviceService, configurationService))
+		scheduler.AddWorker(workers.NewCRLWorker(authService, conf.GetCRLRefreshPeriod()))
+		scheduler.AddWorker(workers.NewSecretRotationWorker(secretService, conf.GetSecretRotationPeriod()))
 		go scheduler.Start(ctx)
+		go gitOpsWorker.Start(ctx)
 
 		tlsConfig, err := certService.TlsConfig(ctx, conf.GetCertificateTTL())
 		if err != nil {
@@ -121,8 +141,16 @@ var runCmd = &cobra.Command{
 		edgePb.RegisterEdgeServiceServer(grpcEdgeServer, edgeServer)
 		go grpcEdgeServer.Serve(lis)
 
+		webhookMux := http.NewServeMux()
+		webhookMux.Handle(servers.WebhookPath, servers.NewWebhookServer(repoService, gitOpsWorker))
+		go func() {
+			if err := http.ListenAndServe(fmt.Sprintf(":%d", conf.WebhookPort), webhookMux); err != nil {
+				zap.S().Fatalf("failed to serve webhooks: %v", err)
+			}
+		}()
+
 		grpcAdminServer := createAdminServer(logger)
-		adminServer := servers.NewAdminServer(repoService, manifestService, deviceService, configurationService)
+		adminServer := servers.NewAdminServer(repoService, manifestService, deviceService, configurationService, authService, tokenService, workloadService)
 		admin.RegisterAdminServiceServer(grpcAdminServer, adminServer)
 		grpcAdminServer.Serve(connAdmin)
 	},
@@ -194,6 +222,11 @@ func createEdgeServer(tlsConfig *tls.Config, auth *services.Auth, logger *zap.Lo
 		grpc_ctxtags.UnaryServerInterceptor(altOpts...),
 		grpc_zap.UnaryServerInterceptor(logger, zapOpts...),
 	))
+	opts = append(opts, grpc_middleware.WithStreamServerChain(
+		interceptors.StreamAuthInterceptor(auth),
+		grpc_ctxtags.StreamServerInterceptor(altOpts...),
+		grpc_zap.StreamServerInterceptor(logger, zapOpts...),
+	))
 
 	grpc_zap.ReplaceGrpcLoggerV2(logger)
 	return grpc.NewServer(opts...)
@@ -11,22 +11,56 @@ const (
 )
 
 type Configuration struct {
-	BaseDomain            string `default:"home.net" usage:"base domain"`
-	DefaultCertificateTTL int64  `default:"31536000"`
-	VaultAddress          string `default:"http://localhost:8200" usage:"vault address"`
-	VaultApproleRoleID    string `default:"app-role-id"`
-	VaultAppRoleSecretID  string
-	VaultSecretMountPath  string `default:"tinyedge"`
-	PostgresAddress       string `default:"localhost:5432"`
-	PostgresUser          string `default:"postgres"`
-	PostgresPassword      string `default:"postgres"`
-	PostgresDB            string `default:"tinyedge"`
+	BaseDomain               string `default:"home.net" usage:"base domain"`
+	DefaultCertificateTTL    int64  `default:"31536000"`
+	DeviceCertificateTTL     int64  `default:"31536000" usage:"ttl in seconds of the device certificates"`
+	CertificateRenewalWindow int64  `default:"2592000" usage:"period in seconds before expiry during which a device can renew its certificate. 0 allows renewal at any time"`
+	RevokeRenewedCertificate bool   `default:"true" usage:"revoke the old device certificate after renewal"`
+	EnableAutoEnrolment      bool   `default:"true" usage:"enrol unknown devices automatically. If false, devices wait for approval"`
+	RequireEnrolmentToken    bool   `default:"false" usage:"refuse the enrolment of devices without a valid enrolment token"`
+	AuthCacheTTL             int64  `default:"300" usage:"period in seconds during which a cached certificate status is used without asking vault. While vault is unreachable, it is used up to 12 times this period. 0 disables the cache"`
+	CRLRefreshPeriod         int64  `default:"60" usage:"period in seconds between two fetches of the certificate revocation list"`
+	SecretRotationPeriod     int64  `default:"60" usage:"period in seconds between two checks of the secrets in vault"`
+	WebhookPullPeriod        int64  `default:"300" usage:"minimum period in seconds between two pulls of the repositories accepting webhooks. They are synced as soon as they are pushed"`
+	WebhookPort              int    `default:"8082" usage:"port of the http server receiving the push webhooks of the git servers"`
+	VaultAddress             string `default:"http://localhost:8200" usage:"vault address"`
+	VaultApproleRoleID       string `default:"app-role-id"`
+	VaultAppRoleSecretID     string
+	VaultSecretMountPath     string `default:"tinyedge"`
+	PostgresAddress          string `default:"localhost:5432"`
+	PostgresUser             string `default:"postgres"`
+	PostgresPassword         string `default:"postgres"`
+	PostgresDB               string `default:"tinyedge"`
 }
 
 func (c Configuration) GetCertificateTTL() time.Duration {
 	return time.Duration(c.DefaultCertificateTTL) * time.Second
 }
 
+func (c Configuration) GetDeviceCertificateTTL() time.Duration {
+	return time.Duration(c.DeviceCertificateTTL) * time.Second
+}
+
+func (c Configuration) GetCertificateRenewalWindow() time.Duration {
+	return time.Duration(c.CertificateRenewalWindow) * time.Second
+}
+
+func (c Configuration) GetAuthCacheTTL() time.Duration {
+	return time.Duration(c.AuthCacheTTL) * time.Second
+}
+
+func (c Configuration) GetCRLRefreshPeriod() time.Duration {
+	return time.Duration(c.CRLRefreshPeriod) * time.Second
+}
+
+func (c Configuration) GetSecretRotationPeriod() time.Duration {
+	return time.Duration(c.SecretRotationPeriod) * time.Second
+}
+
+func (c Configuration) GetWebhookPullPeriod() time.Duration {
+	return time.Duration(c.WebhookPullPeriod) * time.Second
+}
+
 func GetConfiguration() Configuration {
 	var cfg Configuration
 	loader := aconfig.LoaderFor(&cfg, aconfig.Config{
@@ -14,6 +14,8 @@ func (e EnrolStatus) String() string {
 		return "pending"
 	case RefusedEnrolStatus:
 		return "refused"
+	case DecommissionedEnrolStatus:
+		return "decommissioned"
 	default:
 		return "not_enroled"
 	}
@@ -27,6 +29,8 @@ func (e EnrolStatus) FromString(s string) EnrolStatus {
 		return PendingEnrolStatus
 	case "refused":
 		return RefusedEnrolStatus
+	case "decommissioned":
+		return DecommissionedEnrolStatus
 	default:
 		return NotEnroledStatus
 	}
@@ -37,6 +41,37 @@ const (
 	PendingEnrolStatus
 	RefusedEnrolStatus
 	NotEnroledStatus
+	DecommissionedEnrolStatus
+)
+
+type DeviceState int
+
+func (d DeviceState) String() string {
+	switch d {
+	case OnlineDeviceState:
+		return "online"
+	case LateDeviceState:
+		return "late"
+	default:
+		return "offline"
+	}
+}
+
+func (d DeviceState) FromString(s string) DeviceState {
+	switch s {
+	case "online":
+		return OnlineDeviceState
+	case "late":
+		return LateDeviceState
+	default:
+		return OfflineDeviceState
+	}
+}
+
+const (
+	OfflineDeviceState DeviceState = iota
+	OnlineDeviceState
+	LateDeviceState
 )
 
 type Device struct {
@@ -57,8 +92,24 @@ type Device struct {
 	CertificateSerialNumber string
 	// ID of set in which the device is present
 	SetID *string
+	// Labels of the device used by the manifests' label selectors.
+	Labels map[string]string
+	// Variables used to render the templated resources of the workloads. They override the variables of the set and namespace.
+	Variables map[string]string
 	// List of workloads attached to this device
 	Workloads []ManifestV1
+	// Configuration attached to this device. It overrides the configuration of the set and namespace.
+	Configuration *Configuration
+	// State is the online state of the device computed from its heartbeats.
+	State DeviceState
+	// LastSeen represents the time when the last heartbeat was received.
+	LastSeen time.Time
+	// Uptime of the agent as reported by the last heartbeat.
+	Uptime time.Duration
+	// ConfigurationHash is the hash of the configuration applied by the device.
+	ConfigurationHash string
+	// DecommissionedAt represents the time when the device was decommissioned.
+	DecommissionedAt time.Time
 }
 
 type Set struct {
@@ -70,6 +121,10 @@ type Set struct {
 	Devices []string
 	// List of workload's reference attached to this set
 	Workloads []ManifestV1
+	// Configuration attached to this set. It overrides the configuration of the namespace.
+	Configuration *Configuration
+	// Variables used to render the templated resources of the workloads. They override the variables of the namespace.
+	Variables map[string]string
 }
 
 type Namespace struct {
@@ -81,4 +136,28 @@ type Namespace struct {
 	Devices []string
 	// List of workload's reference attached to this namespace
 	Workloads []ManifestV1
+	// Configuration attached to this namespace.
+	Configuration *Configuration
+	// Variables used to render the templated resources of the workloads.
+	Variables map[string]string
+}
+
+// VariablesTarget is the kind of resource holding template variables.
+type VariablesTarget int
+
+func (v VariablesTarget) String() string {
+	switch v {
+	case DeviceVariablesTarget:
+		return "device"
+	case SetVariablesTarget:
+		return "set"
+	default:
+		return "namespace"
+	}
 }
+
+const (
+	NamespaceVariablesTarget VariablesTarget = iota
+	SetVariablesTarget
+	DeviceVariablesTarget
+)
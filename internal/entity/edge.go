@@ -1,10 +1,153 @@
 package entity
 
+import (
+	"time"
+)
+
 // DeviceConfiguration is the entity which maps the response to the device following the GetConfiguration call.
 type DeviceConfiguration struct {
+	// Hash is the sha256 sum of the configuration and workloads.
 	Hash          string
 	Configuration Configuration
-	Workload      []byte
+	Workloads     []DeviceWorkload
+	// NotModified is true when the device already has this configuration. Only the Hash is set in this case.
+	NotModified bool
+}
+
+// WorkloadSource is the level at which a workload targets a device.
+// The order of the constants is the precedence used when two workloads have the same name: a workload targeting
+// the device overrides one selected by labels which overrides one targeting the set which overrides one targeting the namespace.
+type WorkloadSource int
+
+func (w WorkloadSource) String() string {
+	switch w {
+	case DeviceWorkloadSource:
+		return "device"
+	case LabelWorkloadSource:
+		return "label"
+	case SetWorkloadSource:
+		return "set"
+	default:
+		return "namespace"
+	}
+}
+
+const (
+	NamespaceWorkloadSource WorkloadSource = iota
+	SetWorkloadSource
+	LabelWorkloadSource
+	DeviceWorkloadSource
+)
+
+// DeviceWorkload is a workload resolved for a device along with the level it came from.
+type DeviceWorkload struct {
+	ManifestV1
+	Source WorkloadSource
+	// Data holds the resources sent to the device along with the workload.
+	// The secrets are sent as Kubernetes Secrets with the values encrypted with the device's certificate public key.
+	Data []byte
+	// Error is the reason the templated resources of the workload could not be rendered for the device.
+	// A workload with an error is sent without data so the device keeps the version it runs.
+	Error string
+}
+
+type WorkloadState int
+
+func (w WorkloadState) String() string {
+	switch w {
+	case RunningWorkloadState:
+		return "running"
+	case CrashedWorkloadState:
+		return "crashed"
+	case StoppedWorkloadState:
+		return "stopped"
+	default:
+		return "deploying"
+	}
+}
+
+func (w WorkloadState) FromString(s string) WorkloadState {
+	switch s {
+	case "running":
+		return RunningWorkloadState
+	case "crashed":
+		return CrashedWorkloadState
+	case "stopped":
+		return StoppedWorkloadState
+	default:
+		return DeployingWorkloadState
+	}
 }
 
-type Heartbeat struct{}
+const (
+	DeployingWorkloadState WorkloadState = iota
+	RunningWorkloadState
+	CrashedWorkloadState
+	StoppedWorkloadState
+)
+
+// WorkloadStatus is the status of a workload as reported by the device.
+type WorkloadStatus struct {
+	Name        string
+	State       WorkloadState
+	LastUpdated time.Time
+}
+
+// Heartbeat holds the information sent periodically by the device.
+type Heartbeat struct {
+	DeviceID string
+	// Timestamp is the time when the heartbeat has been received.
+	Timestamp time.Time
+	// Uptime of the agent.
+	Uptime time.Duration
+	// ConfigurationHash is the hash of the configuration applied by the device.
+	ConfigurationHash string
+	// Workloads holds the status of each workload running on the device.
+	Workloads []WorkloadStatus
+}
+
+// WorkloadDeployment is the last status of a manifest's workload reported by a device.
+type WorkloadDeployment struct {
+	DeviceID   string
+	ManifestID string
+	State      WorkloadState
+	// LastUpdated is the time when the state changed on the device.
+	LastUpdated time.Time
+	// ReportedAt is the time of the heartbeat which reported the state.
+	ReportedAt time.Time
+	// Stale is true if the device is not online anymore so the state may not be accurate.
+	Stale bool
+}
+
+// ManifestRollout holds the deployment status of a manifest across the devices targeted by it.
+type ManifestRollout struct {
+	ManifestID string
+	// TargetedDevices holds the ids of the devices which should run the manifest.
+	TargetedDevices []string
+	// Deployments holds the status reported by the targeted devices.
+	Deployments []WorkloadDeployment
+	// UnreportedDevices holds the ids of the targeted devices which did not report yet the workload.
+	UnreportedDevices []string
+}
+
+// Count returns the number of devices reporting the workload in state.
+func (m ManifestRollout) Count(state WorkloadState) int {
+	count := 0
+	for _, d := range m.Deployments {
+		if d.State == state {
+			count++
+		}
+	}
+	return count
+}
+
+// StaleDevices returns the ids of the devices whose reported state may not be accurate.
+func (m ManifestRollout) StaleDevices() []string {
+	devices := make([]string, 0)
+	for _, d := range m.Deployments {
+		if d.Stale {
+			devices = append(devices, d.DeviceID)
+		}
+	}
+	return devices
+}
@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type LabelSelectorOperator int
+
+func (l LabelSelectorOperator) String() string {
+	switch l {
+	case InLabelOperator:
+		return "In"
+	case NotInLabelOperator:
+		return "NotIn"
+	case ExistsLabelOperator:
+		return "Exists"
+	default:
+		return "DoesNotExist"
+	}
+}
+
+const (
+	InLabelOperator LabelSelectorOperator = iota
+	NotInLabelOperator
+	ExistsLabelOperator
+	DoesNotExistLabelOperator
+)
+
+// LabelSelectorRequirement is a requirement on the value of a label.
+type LabelSelectorRequirement struct {
+	Key      string
+	Operator LabelSelectorOperator
+	Values   []string
+}
+
+func (r LabelSelectorRequirement) matches(labels map[string]string) bool {
+	value, found := labels[r.Key]
+	switch r.Operator {
+	case ExistsLabelOperator:
+		return found
+	case DoesNotExistLabelOperator:
+		return !found
+	case InLabelOperator:
+		return found && contains(r.Values, value)
+	case NotInLabelOperator:
+		return !found || !contains(r.Values, value)
+	}
+	return false
+}
+
+// LabelSelector selects devices by their labels. All the requirements must be met for a device to be selected.
+type LabelSelector struct {
+	MatchLabels      map[string]string
+	MatchExpressions []LabelSelectorRequirement
+}
+
+// IsEmpty returns true if the selector has no requirement.
+func (l *LabelSelector) IsEmpty() bool {
+	return l == nil || (len(l.MatchLabels) == 0 && len(l.MatchExpressions) == 0)
+}
+
+// Matches returns true if the labels meet all the requirements of the selector.
+// An empty selector matches nothing.
+func (l *LabelSelector) Matches(labels map[string]string) bool {
+	if l.IsEmpty() {
+		return false
+	}
+
+	for k, v := range l.MatchLabels {
+		if value, found := labels[k]; !found || value != v {
+			return false
+		}
+	}
+
+	for _, r := range l.MatchExpressions {
+		if !r.matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the selector in the kubectl-like form: "env=prod,zone in (a,b),!debug".
+func (l *LabelSelector) String() string {
+	if l.IsEmpty() {
+		return ""
+	}
+
+	keys := make([]string, 0, len(l.MatchLabels))
+	for k := range l.MatchLabels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys)+len(l.MatchExpressions))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", k, l.MatchLabels[k]))
+	}
+
+	for _, r := range l.MatchExpressions {
+		switch r.Operator {
+		case ExistsLabelOperator:
+			parts = append(parts, r.Key)
+		case DoesNotExistLabelOperator:
+			parts = append(parts, "!"+r.Key)
+		case InLabelOperator:
+			parts = append(parts, fmt.Sprintf("%s in (%s)", r.Key, strings.Join(r.Values, ",")))
+		case NotInLabelOperator:
+			parts = append(parts, fmt.Sprintf("%s notin (%s)", r.Key, strings.Join(r.Values, ",")))
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,12 @@
 package entity
 
-import "time"
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/pkg/models"
+)
 
 type Version string
 
@@ -15,9 +21,11 @@ const (
 
 type Manifest interface {
 	GetID() string
+	GetName() string
 	GetVersion() Version
 	GetHash() string
 	GetSelectors() Selectors
+	GetLabelSelector() *LabelSelector
 	GetNamespaces() []string
 	GetSets() []string
 	GetDevices() []string
@@ -33,17 +41,29 @@ func (t TypeMeta) GetVersion() Version {
 }
 
 type ObjectMeta struct {
-	// Id - id of the manifest which is the hash of the filepath
+	// Id - id of the manifest computed from the repository id and the name of the manifest. See NewManifestID.
 	Id string
+	// Name of the manifest as defined in the manifest file
+	Name string
 	// Labels
 	Labels map[string]string
 	Hash   string
 }
 
+// NewManifestID returns the id of the manifest named name in the repository. Names are unique within a repository so
+// the id does not change when the manifest file is moved.
+func NewManifestID(repoID, name string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(repoID+"/"+name)))[:12]
+}
+
 func (o ObjectMeta) GetID() string {
 	return o.Id
 }
 
+func (o ObjectMeta) GetName() string {
+	return o.Name
+}
+
 func (o ObjectMeta) GetLabels() map[string]string {
 	return o.Labels
 }
@@ -52,13 +72,32 @@ func (o ObjectMeta) GetHash() string {
 	return o.Hash
 }
 
+// WorkloadKind is the way the workload is run on the device.
+type WorkloadKind int
+
+func (w WorkloadKind) String() string {
+	switch w {
+	case QuadletWorkloadKind:
+		return "quadlet"
+	default:
+		return "pod"
+	}
+}
+
+const (
+	// PodWorkloadKind workloads are run with podman kube play.
+	PodWorkloadKind WorkloadKind = iota
+	// QuadletWorkloadKind workloads are podman Quadlet units run by systemd.
+	QuadletWorkloadKind
+)
+
 // ManifestV1 holds the workload definition.
 type ManifestV1 struct {
 	TypeMeta
 	ObjectMeta
 	// repository
 	Repository Repository
-	// path of the manifest file in the local repo
+	// path of the manifest file relative to the root of the repository
 	Path string
 	// Description - description of the manifest
 	Description string
@@ -68,8 +107,20 @@ type ManifestV1 struct {
 	Secrets []Secret
 	// Resources holds the list of file paths
 	Resources []string
+	// Kind is QuadletWorkloadKind if the resources are Quadlet units.
+	Kind WorkloadKind
+	// Quadlets holds the Quadlet units read from the resources.
+	Quadlets []models.Quadlet
+	// Objects holds the Kubernetes objects read from the resources of a pod workload.
+	Objects []models.Object
+	// Templates holds the templated resources. They are rendered for each device when its configuration is computed.
+	Templates []models.Template
+	// ValidationError is set when a resource cannot be read or is invalid. Invalid workloads are not sent to devices.
+	ValidationError string
 	// Selectors list of selectors
 	Selectors []Selector
+	// LabelSelector selects the devices by their labels. It is evaluated each time the configuration is computed.
+	LabelSelector *LabelSelector
 	// Devices holds the list of devices' ids which use this manifest
 	Devices []string
 	// Namespaces hold the list of namespace ids which use this manifest
@@ -78,10 +129,19 @@ type ManifestV1 struct {
 	Sets []string
 }
 
+// IsValid returns true if all the resources of the workload are valid.
+func (w ManifestV1) IsValid() bool {
+	return w.ValidationError == ""
+}
+
 func (w ManifestV1) GetSelectors() Selectors {
 	return w.Selectors
 }
 
+func (w ManifestV1) GetLabelSelector() *LabelSelector {
+	return w.LabelSelector
+}
+
 func (w ManifestV1) GetNamespaces() []string {
 	return w.Namespaces
 }
@@ -100,7 +160,7 @@ type Configuration struct {
 	ObjectMeta
 	// repository
 	Repository Repository
-	// path of the manifest file in the local repo
+	// path of the manifest file relative to the root of the repository
 	Path string
 	// list of profiles
 	Profiles []Profile
@@ -110,6 +170,8 @@ type Configuration struct {
 	LogLevel string
 	// Selectors list of selectors
 	Selectors []Selector
+	// LabelSelector selects the devices by their labels. It is evaluated each time the configuration is computed.
+	LabelSelector *LabelSelector
 	// Devices holds the list of devices' ids which use this manifest
 	Devices []string
 	// Namespaces hold the list of namespace ids which use this manifest
@@ -122,6 +184,10 @@ func (c Configuration) GetSelectors() Selectors {
 	return c.Selectors
 }
 
+func (c Configuration) GetLabelSelector() *LabelSelector {
+	return c.LabelSelector
+}
+
 func (c Configuration) GetNamespaces() []string {
 	return c.Namespaces
 }
@@ -142,6 +208,24 @@ type Secret struct {
 	Value string
 }
 
+// SecretReference is a Vault secret referenced by manifests.
+type SecretReference struct {
+	ID   string
+	Path string
+	Key  string
+	// CurrentHash is the hash of the value sent to the devices.
+	CurrentHash string
+	// TargetHash is the hash of the value read from Vault.
+	TargetHash string
+	// Manifests holds the ids of the manifests using the secret.
+	Manifests []string
+}
+
+// IsRotated returns true if the value in Vault has changed since it was sent to the devices.
+func (s SecretReference) IsRotated() bool {
+	return s.CurrentHash != s.TargetHash
+}
+
 /* DeviceProfile specify all the conditions of a profile:
 ```yaml
 state:
@@ -2,6 +2,10 @@ package entity
 
 import (
 	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -14,6 +18,21 @@ const (
 	NoRepositoryAuthType
 )
 
+// RepositoryReset is the part of the sync state of a repository dropped when its settings change.
+type RepositoryReset int
+
+const (
+	// NoRepositoryReset keeps the sync state.
+	NoRepositoryReset RepositoryReset = iota
+	// HeadRepositoryReset drops the current head so the manifests are read again at the next sync.
+	HeadRepositoryReset
+	// CloneRepositoryReset drops the local clone and the heads so the repository is cloned again at the next sync.
+	CloneRepositoryReset
+)
+
+// DefaultPullPeriod is the pull period of the repositories added without one.
+const DefaultPullPeriod = 20 * time.Second
+
 type CredentialsFunc func(ctx context.Context, path string) (interface{}, error)
 
 // Repository holds the information about the git repository where the ManifestWork are to be found.
@@ -23,12 +42,154 @@ type Repository struct {
 	Credentials           CredentialsFunc
 	CredentialsSecretPath string
 	Url                   string
-	Branch                string
-	LocalPath             string
-	CurrentHeadSha        string
-	TargetHeadSha         string
-	PullPeriod            time.Duration
-	Manifests             []string
+	// Branch, TagPattern and Commit are the git reference followed by the repository. Only one of them is set.
+	// Branch is the name of the branch. It is set to the default branch of the remote when the repository is cloned without reference.
+	Branch string
+	// TagPattern is a glob pattern, e.g. v*. The repository follows the highest semantic version among the matching tags.
+	TagPattern string
+	// Commit is the sha of the commit to which the repository is pinned.
+	Commit         string
+	LocalPath      string
+	CurrentHeadSha string
+	TargetHeadSha  string
+	// PullPeriod is the period between two pulls of the repository. The repository is not synced if it is 0.
+	PullPeriod time.Duration
+	// NextSyncAt is the time of the next pull. It is zero if the repository has never been pulled.
+	NextSyncAt time.Time
+	// WebhookSecretPath is the path of the vault secret holding the key used to sign the push webhooks.
+	// It is empty if the repository does not accept webhooks.
+	WebhookSecretPath string
+	// SigningKeysSecretPath is the path of the vault secret holding the keys allowed to sign the commits.
+	// The signatures are not verified if it is empty.
+	SigningKeysSecretPath string
+	// RejectedHeadSha is the last commit refused because its signature did not verify and RejectionReason the reason.
+	// They are empty if the last commit has been accepted.
+	RejectedHeadSha string
+	RejectionReason string
+	// Subpath is the directory of the repository holding the manifests. The whole repository is used if it is empty.
+	Subpath string
+	// IncludeGlobs and ExcludeGlobs select the files of the subpath used for the manifests and their resources.
+	// See IsInScope.
+	IncludeGlobs []string
+	ExcludeGlobs []string
+	Manifests    []string
+}
+
+// Ref returns a description of the git reference followed by the repository.
+func (r Repository) Ref() string {
+	switch {
+	case r.Commit != "":
+		return fmt.Sprintf("commit %s", r.Commit)
+	case r.TagPattern != "":
+		return fmt.Sprintf("tags %s", r.TagPattern)
+	default:
+		return fmt.Sprintf("branch %s", r.Branch)
+	}
+}
+
+// IsPaused returns true if the repository is not synced.
+func (r Repository) IsPaused() bool {
+	return r.PullPeriod == 0
+}
+
+// IsSyncDue returns true if the repository has to be pulled at the time now.
+func (r Repository) IsSyncDue(now time.Time) bool {
+	return !r.IsPaused() && !now.Before(r.NextSyncAt)
+}
+
+// Root returns the path of the directory holding the manifests in the local clone.
+// The $ref of the manifests are relative to it.
+func (r Repository) Root() string {
+	return filepath.Join(r.LocalPath, filepath.FromSlash(r.Subpath))
+}
+
+// IsInScope returns true if the file is used for the manifests. The path is relative to the root of the manifests.
+// The file must not match any of the exclude globs and, if there are include globs, it must match one of them.
+// A glob matching a directory matches all the files of the directory and "**" matches any number of directories,
+// e.g. "team-a/**/*.yaml" or "**/testdata".
+func (r Repository) IsInScope(file string) bool {
+	file = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(file)), "/")
+
+	for _, glob := range r.ExcludeGlobs {
+		if MatchGlob(glob, file) {
+			return false
+		}
+	}
+
+	if len(r.IncludeGlobs) == 0 {
+		return true
+	}
+
+	for _, glob := range r.IncludeGlobs {
+		if MatchGlob(glob, file) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchGlob returns true if the glob matches the file or one of its parent directories.
+// The segments of the glob are matched as by path.Match and "**" matches zero or more directories.
+func MatchGlob(glob, file string) bool {
+	globSegments := strings.Split(strings.Trim(glob, "/"), "/")
+	fileSegments := strings.Split(file, "/")
+	for i := len(fileSegments); i > 0; i-- {
+		if matchSegments(globSegments, fileSegments[:i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateGlob returns an error if the glob is malformed.
+func ValidateGlob(glob string) error {
+	if strings.Trim(glob, "/") == "" {
+		return fmt.Errorf("empty glob")
+	}
+	for _, segment := range strings.Split(strings.Trim(glob, "/"), "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid glob %q: %w", glob, err)
+		}
+	}
+	return nil
+}
+
+func matchSegments(glob, file []string) bool {
+	if len(glob) == 0 {
+		return len(file) == 0
+	}
+
+	if glob[0] == "**" {
+		for i := 0; i <= len(file); i++ {
+			if matchSegments(glob[1:], file[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(file) == 0 {
+		return false
+	}
+
+	if ok, err := path.Match(glob[0], file[0]); err != nil || !ok {
+		return false
+	}
+
+	return matchSegments(glob[1:], file[1:])
+}
+
+// SigningKeys are the keys trusted to sign the commits of a repository.
+type SigningKeys struct {
+	// GPG holds the armored public keys.
+	GPG []string
+	// SSH holds the public keys in the authorized_keys format.
+	SSH []string
+}
+
+func (s SigningKeys) IsEmpty() bool {
+	return len(s.GPG) == 0 && len(s.SSH) == 0
 }
 
 type SSHRepositoryAuth struct {
@@ -0,0 +1,50 @@
+package entity
+
+import "time"
+
+// ManifestFileError is the reason why a manifest file of a repository has not been accepted.
+type ManifestFileError struct {
+	// Path is the path of the file relative to the root of the repository.
+	Path   string
+	Reason string
+}
+
+// ManifestChanges are the changes made to the manifests of a repository by a sync.
+type ManifestChanges struct {
+	Created int
+	// Updated counts the manifests still found in the repository whose content or path changed.
+	Updated int
+	Deleted int
+	// FileErrors holds the manifest files which are invalid or could not be parsed.
+	FileErrors []ManifestFileError
+}
+
+// SyncRun is a run of the synchronization of a repository with its remote.
+type SyncRun struct {
+	ID           string
+	RepositoryID string
+	StartedAt    time.Time
+	FinishedAt   time.Time
+	// FromSha is the commit of the repository before the sync and ToSha the commit pulled.
+	FromSha   string
+	ToSha     string
+	Manifests ManifestChanges
+	// Error is the reason why the sync failed. It is empty if the sync succeeded.
+	Error string
+}
+
+func NewSyncRun(repo Repository) SyncRun {
+	return SyncRun{
+		RepositoryID: repo.Id,
+		StartedAt:    time.Now(),
+		FromSha:      repo.CurrentHeadSha,
+	}
+}
+
+// Finish ends the run with the result of the sync.
+func (s *SyncRun) Finish(err error) {
+	s.FinishedAt = time.Now()
+	if err != nil {
+		s.Error = err.Error()
+	}
+}
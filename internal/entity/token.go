@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// EnrolmentToken allows a device to enrol without approval. The device is placed in the namespace and set of the token.
+type EnrolmentToken struct {
+	// ID of the token. It is used by the admin to manage the token.
+	ID string
+	// Hash is the sha256 sum of the token. The token itself is never stored.
+	Hash string
+	// NamespaceID is the namespace in which the device is placed.
+	NamespaceID string
+	// SetID is the set in which the device is placed. Nil if the device is not placed in any set.
+	SetID *string
+	// MaxUses is the number of devices which can enrol with this token.
+	MaxUses int
+	// Uses is the number of devices already enroled with this token.
+	Uses      int
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
@@ -0,0 +1,17 @@
+package entity
+
+type WebhookSignatureKind int
+
+const (
+	// HMACWebhookSignature is the hex encoded HMAC-SHA256 of the payload computed with the webhook secret.
+	// It is sent by GitHub, Gitea and the generic webhooks.
+	HMACWebhookSignature WebhookSignatureKind = iota
+	// TokenWebhookSignature is the webhook secret itself. It is sent by GitLab.
+	TokenWebhookSignature
+)
+
+// WebhookSignature is the proof sent by the git server that a push webhook has been emitted by it.
+type WebhookSignature struct {
+	Kind  WebhookSignatureKind
+	Value string
+}
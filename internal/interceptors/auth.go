@@ -3,6 +3,7 @@ package interceptors
 import (
 	"context"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/tupyy/tinyedge-controller/internal/services/auth"
 	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
 	"go.uber.org/zap"
@@ -42,6 +43,35 @@ func AuthInterceptor(auth *auth.Service) grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamAuthInterceptor is the stream counterpart of AuthInterceptor.
+func StreamAuthInterceptor(auth *auth.Service) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		peer, _ := peer.FromContext(ctx)
+		tlsInfo := peer.AuthInfo.(credentials.TLSInfo)
+
+		if len(tlsInfo.State.PeerCertificates) == 0 {
+			return status.Errorf(codes.PermissionDenied, "missing peer certificates")
+		}
+
+		deviceID, err := getDeviceIDFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		newCtx, err := auth.Auth(ctx, info.FullMethod, deviceID, tlsInfo.State.PeerCertificates)
+		if err != nil {
+			zap.S().Errorw("unable to authenticate device", "error", err)
+			return status.Errorf(codes.PermissionDenied, err.Error())
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = newCtx
+
+		return handler(srv, wrapped)
+	}
+}
+
 func getDeviceIDFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
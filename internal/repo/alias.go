@@ -11,6 +11,7 @@ type (
 	Device      postgres.DeviceRepo
 	Manifest    postgres.ManifestRepository
 	Repository  postgres.Repository
+	Token       postgres.EnrolmentTokenRepo
 	Git         git.GitRepo
 	Certificate vault.CertficateRepo
 	Secret      vault.SecretRepository
@@ -21,6 +22,7 @@ var (
 	NewDeviceRepo  = postgres.NewDeviceRepo
 	NewManifest    = postgres.NewManifestRepository
 	NewRepository  = postgres.NewRepository
+	NewTokenRepo   = postgres.NewEnrolmentTokenRepo
 	NewGit         = git.New
 	NewCertificate = vault.NewCertificateRepository
 	NewSecret      = vault.NewSecretRepository
@@ -1,7 +0,0 @@
-package git
-
-import "github.com/tupyy/tinyedge-controller/internal/entity"
-
-func parseConfigurationManifest(content []byte, filename, basePath string) (entity.Configuration, error) {
-	return entity.Configuration{}, nil
-}
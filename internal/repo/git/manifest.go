@@ -3,12 +3,12 @@ package git
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 	reader "github.com/tupyy/tinyedge-controller/internal/repo/manifest"
@@ -27,43 +27,96 @@ func init() {
 	manifestPattern = regexp.MustCompile(pattern)
 }
 
-func getManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
-	files, err := findManifestFiles(ctx, repo.LocalPath, manifestPattern)
+// getManifests returns the manifests of the repo and the files which have been refused.
+// Workloads with a resource which cannot be read are returned like any manifest but they are reported in the file errors too.
+func getManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
+	root := repo.Root()
+	// a missing subpath must not be taken for a repository without manifests
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		return nil, nil, fmt.Errorf("subpath %q not found in repo %q", repo.Subpath, repo.LocalPath)
+	}
+
+	files, err := findManifestFiles(ctx, root, manifestPattern)
 	if err != nil {
-		return nil, fmt.Errorf("unable to search for manifest files in repo %q: %w", repo.LocalPath, err)
+		return nil, nil, fmt.Errorf("unable to search for manifest files in repo %q: %w", repo.LocalPath, err)
 	}
-	manifests := make([]entity.Manifest, 0, len(files))
+	sort.Strings(files)
 
+	manifests := make([]entity.Manifest, 0, len(files))
+	fileErrors := []entity.ManifestFileError{}
+	paths := make(map[string]string, len(files))
 	for _, file := range files {
+		if rel, err := filepath.Rel(root, file); err != nil || !repo.IsInScope(rel) {
+			continue
+		}
+
+		relPath, err := filepath.Rel(repo.LocalPath, file)
+		if err != nil {
+			relPath = file
+		}
+
 		manifest, err := getManifest(ctx, repo, file)
 		if err != nil {
-			zap.S().Errorf("unable to parse manifest file %q in repo %q: %w", file, repo.LocalPath, err)
+			zap.S().Errorw("unable to parse manifest file", "error", err, "repo_id", repo.Id, "path", relPath)
+			fileErrors = append(fileErrors, entity.ManifestFileError{Path: relPath, Reason: err.Error()})
 			continue
 		}
+
+		// the name is the identity of the manifest so only the first file using a name is kept
+		if path, found := paths[manifest.GetID()]; found {
+			zap.S().Errorw("duplicate manifest name. The manifest is ignored", "repo_id", repo.Id, "name", manifest.GetName(), "path", file, "used_by", path)
+			fileErrors = append(fileErrors, entity.ManifestFileError{Path: relPath, Reason: fmt.Sprintf("manifest name %q is already used by %q", manifest.GetName(), path)})
+			continue
+		}
+		paths[manifest.GetID()] = relPath
+
+		if w, ok := manifest.(entity.ManifestV1); ok && !w.IsValid() {
+			fileErrors = append(fileErrors, entity.ManifestFileError{Path: relPath, Reason: w.ValidationError})
+		}
+
 		if filterFn(manifest) {
 			manifests = append(manifests, manifest)
 		}
 	}
 
-	return manifests, nil
+	return manifests, fileErrors, nil
 }
 
-func getManifest(ctx context.Context, repo entity.Repository, filepath string) (entity.Manifest, error) {
-	_, err := os.Stat(filepath)
+func getManifest(ctx context.Context, repo entity.Repository, file string) (entity.Manifest, error) {
+	_, err := os.Stat(file)
 	if err != nil {
-		return nil, fmt.Errorf("unable to find file %q in repo %q", filepath, repo.LocalPath)
+		return nil, fmt.Errorf("unable to find file %q in repo %q", file, repo.LocalPath)
 	}
 
-	return parseManifest(ctx, filepath, func(m entity.Manifest) entity.Manifest {
-		if m.GetVersion() == entity.ManifestVersionV1 {
-			w, _ := m.(entity.ManifestV1)
-			w.Id = hash(filepath)[:12]
-			w.Repository = repo
-			w.Path = filepath
-			return w
+	path, err := filepath.Rel(repo.LocalPath, file)
+	if err != nil {
+		return nil, fmt.Errorf("file %q is not in repo %q: %w", file, repo.LocalPath, err)
+	}
+
+	manifest, err := parseManifest(ctx, file, func(m entity.Manifest) entity.Manifest {
+		switch v := m.(type) {
+		case entity.ManifestV1:
+			v.Id = entity.NewManifestID(repo.Id, v.Name)
+			v.Repository = repo
+			v.Path = path
+			return v
+		case entity.Configuration:
+			v.Id = entity.NewManifestID(repo.Id, v.Name)
+			v.Repository = repo
+			v.Path = path
+			return v
 		}
 		return m
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.GetName() == "" {
+		return nil, fmt.Errorf("manifest %q has no name", path)
+	}
+
+	return reader.ReadResources(manifest, repo)
 }
 
 func parseManifest(ctx context.Context, filepath string, transformFn func(entity.Manifest) entity.Manifest) (entity.Manifest, error) {
@@ -124,12 +177,3 @@ func findManifestFiles(ctx context.Context, path string, manifestPattern *regexp
 
 	return manifestWorks, nil
 }
-
-func hash(base string, s ...string) string {
-	hash := sha256.New()
-	hash.Write([]byte(base))
-	for _, ss := range s {
-		hash.Write([]byte(ss))
-	}
-	return fmt.Sprintf("%x", hash.Sum(nil))
-}
@@ -0,0 +1,72 @@
+package git
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/go-version"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+// resolveRef returns the hash of the commit referred by the branch, the tags or the commit followed by the repository.
+func resolveRef(repo *git.Repository, r entity.Repository) (plumbing.Hash, error) {
+	var revision plumbing.Revision
+	switch {
+	case r.Commit != "":
+		revision = plumbing.Revision(r.Commit)
+	case r.TagPattern != "":
+		tag, err := latestTag(repo, r.TagPattern)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		revision = plumbing.Revision(plumbing.NewTagReferenceName(tag))
+	default:
+		revision = plumbing.Revision(plumbing.NewRemoteReferenceName("origin", r.Branch))
+	}
+
+	hash, err := repo.ResolveRevision(revision)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return *hash, nil
+}
+
+// latestTag returns the tag with the highest semantic version among the tags matching the pattern.
+// Tags which are not semantic versions are ignored.
+func latestTag(repo *git.Repository, pattern string) (string, error) {
+	tags, err := repo.Tags()
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		latest        string
+		latestVersion *version.Version
+	)
+	err = tags.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+		if ok, err := path.Match(pattern, name); err != nil || !ok {
+			return err
+		}
+
+		v, err := version.NewSemver(name)
+		if err != nil {
+			return nil
+		}
+
+		if latestVersion == nil || v.GreaterThan(latestVersion) {
+			latest, latestVersion = name, v
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if latest == "" {
+		return "", fmt.Errorf("no semantic version tag matches %q", pattern)
+	}
+	return latest, nil
+}
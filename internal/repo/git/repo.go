@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"path"
+	"path/filepath"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
@@ -41,21 +44,21 @@ func (g *GitRepo) Open(ctx context.Context, r entity.Repository) (entity.Reposit
 	return r, nil
 }
 
-// Pull pull from origin the repo.
+// Pull fetches the branches and the tags of the repo from origin.
 func (g *GitRepo) Pull(ctx context.Context, r entity.Repository) error {
 	repo, err := g.openRepository(ctx, r)
 	if err != nil {
 		return fmt.Errorf("unable to pull from %q: %w", r.Url, err)
 	}
-	w, err := repo.Worktree()
-	if err != nil {
-		return err
-	}
 
-	pullOptions := &git.PullOptions{
-		RemoteName:      "origin",
-		RemoteURL:       r.Url,
-		SingleBranch:    true,
+	fetchOptions := &git.FetchOptions{
+		RemoteName: "origin",
+		RefSpecs: []config.RefSpec{
+			"+refs/heads/*:refs/remotes/origin/*",
+			"+refs/tags/*:refs/tags/*",
+		},
+		Tags:            git.AllTags,
+		Force:           true,
 		InsecureSkipTLS: true,
 	}
 	if r.AuthType != entity.NoRepositoryAuthType {
@@ -63,10 +66,10 @@ func (g *GitRepo) Pull(ctx context.Context, r entity.Repository) error {
 		if err != nil {
 			return err
 		}
-		pullOptions.Auth = authMethod
+		fetchOptions.Auth = authMethod
 	}
 
-	err = w.Pull(pullOptions)
+	err = repo.FetchContext(ctx, fetchOptions)
 	if err != nil {
 		if errors.Is(err, git.NoErrAlreadyUpToDate) {
 			return nil
@@ -76,28 +79,37 @@ func (g *GitRepo) Pull(ctx context.Context, r entity.Repository) error {
 	return nil
 }
 
-// GetHeadSha returns the head sha for the specified repo.
-// It does not pull before returning the sha.
+// GetHeadSha resolves the reference followed by the repo and returns the sha of the commit.
+// The commit is not checked out so nothing is read from it before it is accepted. It does not pull before returning the sha.
 func (g *GitRepo) GetHeadSha(ctx context.Context, r entity.Repository) (string, error) {
 	repo, err := g.openRepository(ctx, r)
 	if err != nil {
 		return "", fmt.Errorf("unable to open repository %q: %w", r.Url, err)
 	}
-	w, err := repo.Worktree()
+
+	hash, err := resolveRef(repo, r)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("unable to resolve %s from repo %q: %w", r.Ref(), r.Url, err)
 	}
-	err = w.Checkout(&git.CheckoutOptions{
-		Branch: plumbing.NewBranchReferenceName(r.Branch),
-	})
+
+	return hash.String(), nil
+}
+
+// Checkout checks out the commit and its submodules in the working tree of the repo.
+func (g *GitRepo) Checkout(ctx context.Context, r entity.Repository, sha string) error {
+	repo, err := g.openRepository(ctx, r)
 	if err != nil {
-		return "", fmt.Errorf("unable to checkout branch %q from repo %q", r.Branch, r.Url)
+		return fmt.Errorf("unable to open repository %q: %w", r.Url, err)
 	}
-	ref, err := repo.Head()
-	if err != nil {
-		return "", fmt.Errorf("unable to read head from repo %q: %w", r.Url, err)
+
+	if err := checkout(repo, plumbing.NewHash(sha)); err != nil {
+		return fmt.Errorf("unable to checkout commit %q from repo %q: %w", sha, r.Url, err)
+	}
+
+	if err := g.updateSubmodules(ctx, repo, r); err != nil {
+		return fmt.Errorf("unable to update submodules of commit %q from repo %q: %w", sha, r.Url, err)
 	}
-	return ref.Hash().String(), err
+	return nil
 }
 
 // GetManifest return the manifest referred by ref
@@ -105,17 +117,20 @@ func (g *GitRepo) GetManifest(ctx context.Context, repo entity.Repository, filep
 	return getManifest(ctx, repo, filepath)
 }
 
-// GetManifests returns all the manifest of a repo.
-func (g *GitRepo) GetManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+// GetManifests returns all the manifest of a repo and the manifest files which have been refused.
+func (g *GitRepo) GetManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 	return getManifests(ctx, repo, filterFn)
 }
 
 func (g *GitRepo) Clone(ctx context.Context, repo entity.Repository) (entity.Repository, error) {
 	zap.S().Infof("clone repo %q to local storage %q", repo.Url, g.localStorage)
 
+	// the working tree is left empty until the commit followed by the repo is accepted and checked out.
+	// The submodules are cloned by Checkout.
 	cloneOptions := &git.CloneOptions{
-		URL:               repo.Url,
-		RecurseSubmodules: git.DefaultSubmoduleRecursionDepth,
+		URL:        repo.Url,
+		Tags:       git.AllTags,
+		NoCheckout: true,
 	}
 	if repo.AuthType != entity.NoRepositoryAuthType {
 		authMethod, err := g.getCredentials(ctx, repo.Credentials, repo.CredentialsSecretPath)
@@ -125,24 +140,39 @@ func (g *GitRepo) Clone(ctx context.Context, repo entity.Repository) (entity.Rep
 		cloneOptions.Auth = authMethod
 	}
 
-	clone, err := git.PlainClone(path.Join(g.localStorage, repo.Id), false, cloneOptions)
+	localPath := path.Join(g.localStorage, repo.Id)
+	clone, err := git.PlainClone(localPath, false, cloneOptions)
 	if err != nil {
 		if !errors.Is(err, git.ErrRepositoryAlreadyExists) {
 			return entity.Repository{}, err
 		}
-		r, err := git.PlainOpen(path.Join(g.localStorage, repo.Id))
+		r, err := git.PlainOpen(localPath)
 		if err != nil {
 			return entity.Repository{}, err
 		}
 		clone = r
+
+		// the clone left behind by a change of the url is removed and the repo is cloned from the new remote.
+		if originURL(r) != repo.Url {
+			zap.S().Infow("local clone has another remote. clone it again", "repo_id", repo.Id, "local", localPath)
+			if err := os.RemoveAll(localPath); err != nil {
+				return entity.Repository{}, fmt.Errorf("unable to remove the local clone of repo %q: %w", repo.Id, err)
+			}
+			clone, err = git.PlainClone(localPath, false, cloneOptions)
+			if err != nil {
+				return entity.Repository{}, err
+			}
+		}
 	}
 
-	mainBranch, err := g.getMainBranch(clone)
-	if err != nil {
-		return entity.Repository{}, err
+	repo.LocalPath = localPath
+	if repo.Branch == "" && repo.TagPattern == "" && repo.Commit == "" {
+		defaultBranch, err := g.getDefaultBranch(clone)
+		if err != nil {
+			return entity.Repository{}, err
+		}
+		repo.Branch = defaultBranch
 	}
-	repo.LocalPath = path.Join(g.localStorage, repo.Id)
-	repo.Branch = mainBranch
 
 	headSha, err := g.GetHeadSha(ctx, repo)
 	if err != nil {
@@ -153,6 +183,24 @@ func (g *GitRepo) Clone(ctx context.Context, repo entity.Repository) (entity.Rep
 	return repo, nil
 }
 
+// Delete removes the local clone of the repository. Only a directory of the local storage is removed.
+func (g *GitRepo) Delete(ctx context.Context, repo entity.Repository) error {
+	if repo.LocalPath == "" {
+		return nil
+	}
+
+	localPath := filepath.Clean(repo.LocalPath)
+	if filepath.Dir(localPath) != filepath.Clean(g.localStorage) || filepath.Base(localPath) == ".." {
+		return fmt.Errorf("local clone %q of repo %q is outside the local storage %q", repo.LocalPath, repo.Id, g.localStorage)
+	}
+
+	if err := os.RemoveAll(localPath); err != nil {
+		return fmt.Errorf("unable to remove the local clone of repo %q: %w", repo.Url, err)
+	}
+	zap.S().Debugw("local clone removed", "repo_id", repo.Id, "local", localPath)
+	return nil
+}
+
 // openRepository opens a repo from local storage.
 func (g *GitRepo) openRepository(ctx context.Context, r entity.Repository) (*git.Repository, error) {
 	repo, err := git.PlainOpen(r.LocalPath)
@@ -163,24 +211,62 @@ func (g *GitRepo) openRepository(ctx context.Context, r entity.Repository) (*git
 	return repo, nil
 }
 
-func (g *GitRepo) getMainBranch(r *git.Repository) (string, error) {
-	// check if main branch is "main" or "master"
-	w, err := r.Worktree()
+// originURL returns the url of the origin remote of the repo. It is empty if the repo has no origin.
+func originURL(r *git.Repository) string {
+	remote, err := r.Remote("origin")
+	if err != nil || len(remote.Config().URLs) == 0 {
+		return ""
+	}
+	return remote.Config().URLs[0]
+}
+
+// getDefaultBranch returns the branch checked out by the clone which is the default branch of the remote.
+func (g *GitRepo) getDefaultBranch(r *git.Repository) (string, error) {
+	head, err := r.Head()
 	if err != nil {
-		return "", fmt.Errorf("unable to open repository %w", err)
+		return "", fmt.Errorf("unable to read the default branch of the repository: %w", err)
+	}
+	if !head.Name().IsBranch() {
+		return "", fmt.Errorf("head of the repository is not a branch")
 	}
-	// try main
-	err = w.Checkout(&git.CheckoutOptions{
-		Branch: plumbing.NewBranchReferenceName("main"),
+	return head.Name().Short(), nil
+}
+
+func checkout(repo *git.Repository, hash plumbing.Hash) error {
+	w, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	return w.Checkout(&git.CheckoutOptions{
+		Hash:  hash,
+		Force: true,
 	})
-	if err == nil {
-		return "main", nil
+}
+
+func (g *GitRepo) updateSubmodules(ctx context.Context, repo *git.Repository, r entity.Repository) error {
+	w, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	submodules, err := w.Submodules()
+	if err != nil || len(submodules) == 0 {
+		return err
+	}
+
+	updateOptions := &git.SubmoduleUpdateOptions{
+		Init:              true,
+		RecurseSubmodules: git.DefaultSubmoduleRecursionDepth,
 	}
-	err = w.Checkout(&git.CheckoutOptions{})
-	if err == nil {
-		return "master", nil
+	if r.AuthType != entity.NoRepositoryAuthType {
+		authMethod, err := g.getCredentials(ctx, r.Credentials, r.CredentialsSecretPath)
+		if err != nil {
+			return err
+		}
+		updateOptions.Auth = authMethod
 	}
-	return "", fmt.Errorf("no branch named \"main\" or \"master\" in repo")
+
+	return submodules.UpdateContext(ctx, updateOptions)
 }
 
 func (g *GitRepo) getCredentials(ctx context.Context, fn entity.CredentialsFunc, secretPath string) (transport.AuthMethod, error) {
@@ -7,10 +7,12 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-billy/v5/osfs"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/cache"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/storage/filesystem"
@@ -121,6 +123,35 @@ var _ = Describe("Git repository", func() {
 			fmt.Println(commit)
 		})
 
+		It("removes the local clone", func() {
+			repo := entity.Repository{
+				Id:       "test",
+				Url:      tmpDir,
+				AuthType: entity.NoRepositoryAuthType,
+			}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+
+			err = r.Delete(context.TODO(), clone)
+			Expect(err).To(BeNil())
+			_, err = os.Stat(clone.LocalPath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			// the repository can be cloned again
+			_, err = r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+		})
+
+		It("does not remove a directory outside the local storage", func() {
+			r := gitRepo.New(cloneDir)
+			err := r.Delete(context.TODO(), entity.Repository{Id: "..", LocalPath: path.Join(cloneDir, "..")})
+			Expect(err).ToNot(BeNil())
+			_, err = os.Stat(tmpDir)
+			Expect(err).To(BeNil())
+		})
+
 		It("successfully get the head sha", func() {
 			repo := entity.Repository{
 				Id:       "test",
@@ -173,6 +204,129 @@ var _ = Describe("Git repository", func() {
 			headSha, err = r.GetHeadSha(context.Background(), clone)
 			Expect(headSha).To(Equal(newCommit.String()))
 
+			// the new commit is checked out only on demand
+			_, err = os.Stat(filepath.Join(clone.LocalPath, path.Base(filename)))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			Expect(r.Checkout(context.TODO(), clone, headSha)).To(Succeed())
+			_, err = os.Stat(filepath.Join(clone.LocalPath, path.Base(filename)))
+			Expect(err).To(BeNil())
+
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+			os.RemoveAll(cloneDir)
+		})
+	})
+
+	Context("references", func() {
+		var (
+			tmpDir   string
+			cloneDir string
+			source   *git.Repository
+			commits  []string
+		)
+
+		commit := func(w *git.Worktree, content string) string {
+			err := ioutil.WriteFile(filepath.Join(tmpDir, "file"), []byte(content), 0644)
+			Expect(err).To(BeNil())
+			_, err = w.Add("file")
+			Expect(err).To(BeNil())
+			c, err := w.Commit(content, &git.CommitOptions{
+				Author: &object.Signature{Name: "John Doe", Email: "j@doe.org", When: time.Now()},
+			})
+			Expect(err).To(BeNil())
+			return c.String()
+		}
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = os.MkdirTemp("", "git-*")
+			Expect(err).To(BeNil())
+
+			fs := osfs.New(tmpDir)
+			source, err = git.Init(filesystem.NewStorage(fs, cache.NewObjectLRUDefault()), fs)
+			Expect(err).To(BeNil())
+
+			w, err := source.Worktree()
+			Expect(err).To(BeNil())
+
+			// master: c0 <- c1 <- c2 tagged v1.2.0, v1.10.0 and latest. release points to c0.
+			commits = []string{commit(w, "c0"), commit(w, "c1"), commit(w, "c2")}
+			_, err = source.CreateTag("v1.2.0", plumbing.NewHash(commits[2]), nil)
+			Expect(err).To(BeNil())
+			_, err = source.CreateTag("v1.10.0", plumbing.NewHash(commits[1]), &git.CreateTagOptions{
+				Tagger:  &object.Signature{Name: "John Doe", Email: "j@doe.org", When: time.Now()},
+				Message: "annotated",
+			})
+			Expect(err).To(BeNil())
+			_, err = source.CreateTag("latest", plumbing.NewHash(commits[0]), nil)
+			Expect(err).To(BeNil())
+			err = source.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("release"), plumbing.NewHash(commits[0])))
+			Expect(err).To(BeNil())
+
+			cloneDir, err = os.MkdirTemp("", "git-clone-*")
+			Expect(err).To(BeNil())
+		})
+
+		It("follows any branch", func() {
+			repo := entity.Repository{Id: "test", Url: tmpDir, Branch: "release", AuthType: entity.NoRepositoryAuthType}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(clone.Branch).To(Equal("release"))
+			Expect(clone.TargetHeadSha).To(Equal(commits[0]))
+		})
+
+		It("follows the highest semantic version among the matching tags", func() {
+			repo := entity.Repository{Id: "test", Url: tmpDir, TagPattern: "v*", AuthType: entity.NoRepositoryAuthType}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(clone.Branch).To(BeEmpty())
+			Expect(clone.TargetHeadSha).To(Equal(commits[1]))
+
+			// a new release is pushed
+			w, err := source.Worktree()
+			Expect(err).To(BeNil())
+			c3 := commit(w, "c3")
+			_, err = source.CreateTag("v2.0.0", plumbing.NewHash(c3), nil)
+			Expect(err).To(BeNil())
+
+			err = r.Pull(context.TODO(), clone)
+			Expect(err).To(BeNil())
+			headSha, err := r.GetHeadSha(context.TODO(), clone)
+			Expect(err).To(BeNil())
+			Expect(headSha).To(Equal(c3))
+		})
+
+		It("fails when no tag matches the pattern", func() {
+			repo := entity.Repository{Id: "test", Url: tmpDir, TagPattern: "release-*", AuthType: entity.NoRepositoryAuthType}
+
+			r := gitRepo.New(cloneDir)
+			_, err := r.Clone(context.TODO(), repo)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("stays on the pinned commit", func() {
+			repo := entity.Repository{Id: "test", Url: tmpDir, Commit: commits[1], AuthType: entity.NoRepositoryAuthType}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(clone.TargetHeadSha).To(Equal(commits[1]))
+
+			w, err := source.Worktree()
+			Expect(err).To(BeNil())
+			commit(w, "c3")
+
+			err = r.Pull(context.TODO(), clone)
+			Expect(err).To(BeNil())
+			headSha, err := r.GetHeadSha(context.TODO(), clone)
+			Expect(err).To(BeNil())
+			Expect(headSha).To(Equal(commits[1]))
 		})
 
 		AfterEach(func() {
@@ -236,12 +390,139 @@ var _ = Describe("Git repository", func() {
 			r := gitRepo.New(cloneDir)
 			clone, err := r.Clone(context.TODO(), repo)
 			Expect(err).To(BeNil())
+			Expect(r.Checkout(context.TODO(), clone, clone.TargetHeadSha)).To(Succeed())
 			Expect(clone.Branch).To(Equal("master"))
 
-			manifests, err := r.GetManifests(context.TODO(), clone, func(m entity.Manifest) bool { return true })
+			manifests, _, err := r.GetManifests(context.TODO(), clone, func(m entity.Manifest) bool { return true })
 			Expect(len(manifests)).To(Equal(2)) // TODO FIX == 2
 		})
 
+		It("identifies the manifests by repository and name", func() {
+			repo := entity.Repository{
+				Id:       "test",
+				Url:      tmpDir,
+				AuthType: entity.NoRepositoryAuthType,
+			}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(r.Checkout(context.TODO(), clone, clone.TargetHeadSha)).To(Succeed())
+
+			manifests, _, err := r.GetManifests(context.TODO(), clone, func(m entity.Manifest) bool { return m.GetName() == "manifest1" })
+			Expect(err).To(BeNil())
+			Expect(len(manifests)).To(Equal(1))
+			Expect(manifests[0].GetID()).To(Equal(entity.NewManifestID("test", "manifest1")))
+			Expect(manifests[0].(entity.ManifestV1).Path).To(Equal(filepath.Join("folder1", "test.manifest.yaml")))
+		})
+
+		It("keeps only the first manifest when two manifests have the same name", func() {
+			repo := entity.Repository{
+				Id:       "test",
+				Url:      tmpDir,
+				AuthType: entity.NoRepositoryAuthType,
+			}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(r.Checkout(context.TODO(), clone, clone.TargetHeadSha)).To(Succeed())
+
+			Expect(os.Mkdir(path.Join(clone.LocalPath, "folder3"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(clone.LocalPath, "folder3", "copy.manifest.yaml"), []byte(manifest1), 0644)).To(Succeed())
+
+			manifests, fileErrors, err := r.GetManifests(context.TODO(), clone, func(m entity.Manifest) bool { return true })
+			Expect(err).To(BeNil())
+			Expect(len(manifests)).To(Equal(2))
+			for _, m := range manifests {
+				Expect(m.(entity.ManifestV1).Path).ToNot(HavePrefix("folder3"))
+			}
+			Expect(fileErrors).To(ContainElement(entity.ManifestFileError{
+				Path:   filepath.Join("folder3", "copy.manifest.yaml"),
+				Reason: `manifest name "manifest1" is already used by "folder1/test.manifest.yaml"`,
+			}))
+		})
+
+		It("reports the manifest files which cannot be parsed", func() {
+			repo := entity.Repository{
+				Id:       "test",
+				Url:      tmpDir,
+				AuthType: entity.NoRepositoryAuthType,
+			}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(r.Checkout(context.TODO(), clone, clone.TargetHeadSha)).To(Succeed())
+
+			Expect(ioutil.WriteFile(filepath.Join(clone.LocalPath, "broken.manifest.yaml"), []byte("version: [v1"), 0644)).To(Succeed())
+
+			manifests, fileErrors, err := r.GetManifests(context.TODO(), clone, func(m entity.Manifest) bool { return true })
+			Expect(err).To(BeNil())
+			Expect(len(manifests)).To(Equal(2))
+			Expect(fileErrors).To(ContainElement(HaveField("Path", "broken.manifest.yaml")))
+		})
+
+		It("searches the manifests only in the subpath", func() {
+			repo := entity.Repository{
+				Id:       "test",
+				Url:      tmpDir,
+				AuthType: entity.NoRepositoryAuthType,
+				Subpath:  "folder2",
+			}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(r.Checkout(context.TODO(), clone, clone.TargetHeadSha)).To(Succeed())
+
+			manifests, _, err := r.GetManifests(context.TODO(), clone, func(m entity.Manifest) bool { return true })
+			Expect(err).To(BeNil())
+			Expect(len(manifests)).To(Equal(1))
+			Expect(manifests[0].GetName()).To(Equal("manifest2"))
+			Expect(manifests[0].(entity.ManifestV1).Path).To(Equal(filepath.Join("folder2", "test1.manifest.yml")))
+		})
+
+		It("fails when the subpath does not exist", func() {
+			repo := entity.Repository{
+				Id:       "test",
+				Url:      tmpDir,
+				AuthType: entity.NoRepositoryAuthType,
+				Subpath:  "missing",
+			}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(r.Checkout(context.TODO(), clone, clone.TargetHeadSha)).To(Succeed())
+
+			_, _, err = r.GetManifests(context.TODO(), clone, func(m entity.Manifest) bool { return true })
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("keeps only the manifests selected by the globs", func() {
+			repo := entity.Repository{
+				Id:           "test",
+				Url:          tmpDir,
+				AuthType:     entity.NoRepositoryAuthType,
+				IncludeGlobs: []string{"folder*"},
+				ExcludeGlobs: []string{"**/*.yml"},
+			}
+
+			r := gitRepo.New(cloneDir)
+			clone, err := r.Clone(context.TODO(), repo)
+			Expect(err).To(BeNil())
+			Expect(r.Checkout(context.TODO(), clone, clone.TargetHeadSha)).To(Succeed())
+
+			Expect(os.Mkdir(path.Join(clone.LocalPath, "fixtures"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(clone.LocalPath, "fixtures", "fixture.manifest.yaml"), []byte(strings.Replace(manifest1, "manifest1", "fixture", 1)), 0644)).To(Succeed())
+
+			manifests, _, err := r.GetManifests(context.TODO(), clone, func(m entity.Manifest) bool { return true })
+			Expect(err).To(BeNil())
+			Expect(len(manifests)).To(Equal(1))
+			Expect(manifests[0].GetName()).To(Equal("manifest1"))
+		})
+
 		AfterEach(func() {
 			os.RemoveAll(tmpDir)
 			os.RemoveAll(cloneDir)
@@ -0,0 +1,153 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshSignatureMagic     = "SSHSIG"
+	sshSignaturePEMType   = "SSH SIGNATURE"
+	sshSignatureNamespace = "git"
+)
+
+// VerifyCommitSignature checks that the commit has been signed with one of the keys.
+// It returns a CommitSignatureError if the commit is not signed or if the signature does not verify.
+func (g *GitRepo) VerifyCommitSignature(ctx context.Context, r entity.Repository, sha string, keys entity.SigningKeys) error {
+	repo, err := g.openRepository(ctx, r)
+	if err != nil {
+		return fmt.Errorf("unable to open repository %q: %w", r.Url, err)
+	}
+
+	commit, err := repo.CommitObject(plumbing.NewHash(sha))
+	if err != nil {
+		return fmt.Errorf("unable to read commit %q from repo %q: %w", sha, r.Url, err)
+	}
+
+	if commit.PGPSignature == "" {
+		return errService.NewCommitSignatureError(r.Id, sha, "commit is not signed")
+	}
+
+	if strings.Contains(commit.PGPSignature, sshSignaturePEMType) {
+		err = verifySSHSignature(commit, keys.SSH)
+	} else {
+		err = verifyGPGSignature(commit, keys.GPG)
+	}
+	if err != nil {
+		return errService.NewCommitSignatureError(r.Id, sha, err.Error())
+	}
+
+	return nil
+}
+
+func verifyGPGSignature(commit *object.Commit, keys []string) error {
+	for _, key := range keys {
+		if _, err := commit.Verify(key); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("gpg signature does not verify against the trusted keys")
+}
+
+// verifySSHSignature verifies the signature in the SSHSIG format used by git.
+// See https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+func verifySSHSignature(commit *object.Commit, keys []string) error {
+	block, _ := pem.Decode([]byte(commit.PGPSignature))
+	if block == nil || block.Type != sshSignaturePEMType {
+		return fmt.Errorf("malformed ssh signature")
+	}
+	if !bytes.HasPrefix(block.Bytes, []byte(sshSignatureMagic)) {
+		return fmt.Errorf("malformed ssh signature")
+	}
+
+	var sig struct {
+		Version       uint32
+		PublicKey     []byte
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Signature     []byte
+	}
+	if err := ssh.Unmarshal(block.Bytes[len(sshSignatureMagic):], &sig); err != nil {
+		return fmt.Errorf("malformed ssh signature: %w", err)
+	}
+
+	if sig.Namespace != sshSignatureNamespace {
+		return fmt.Errorf("ssh signature namespace %q is not %q", sig.Namespace, sshSignatureNamespace)
+	}
+
+	signer, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return fmt.Errorf("malformed ssh signature: %w", err)
+	}
+
+	if !isTrustedSSHKey(signer, keys) {
+		return fmt.Errorf("commit is signed with the untrusted ssh key %s", ssh.FingerprintSHA256(signer))
+	}
+
+	var h hash.Hash
+	switch sig.HashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported ssh signature hash algorithm %q", sig.HashAlgorithm)
+	}
+
+	encoded := &plumbing.MemoryObject{}
+	if err := commit.EncodeWithoutSignature(encoded); err != nil {
+		return err
+	}
+	reader, err := encoded.Reader()
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(h, reader); err != nil {
+		return err
+	}
+
+	signedData := append([]byte(sshSignatureMagic), ssh.Marshal(struct {
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          []byte
+	}{sig.Namespace, sig.Reserved, sig.HashAlgorithm, h.Sum(nil)})...)
+
+	signature := &ssh.Signature{}
+	if err := ssh.Unmarshal(sig.Signature, signature); err != nil {
+		return fmt.Errorf("malformed ssh signature: %w", err)
+	}
+
+	if err := signer.Verify(signedData, signature); err != nil {
+		return fmt.Errorf("ssh signature does not verify: %w", err)
+	}
+
+	return nil
+}
+
+func isTrustedSSHKey(signer ssh.PublicKey, keys []string) bool {
+	for _, key := range keys {
+		trusted, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(trusted.Marshal(), signer.Marshal()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+package git_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	gitRepo "github.com/tupyy/tinyedge-controller/internal/repo/git"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("Commit signatures", func() {
+	var (
+		tmpDir   string
+		cloneDir string
+		source   *git.Repository
+		worktree *git.Worktree
+		author   = &object.Signature{Name: "John Doe", Email: "j@doe.org", When: time.Now()}
+	)
+
+	// commit creates a commit signed with the gpg key if the key is not nil.
+	commit := func(content string, key *openpgp.Entity) string {
+		err := ioutil.WriteFile(filepath.Join(tmpDir, "file"), []byte(content), 0644)
+		Expect(err).To(BeNil())
+		_, err = worktree.Add("file")
+		Expect(err).To(BeNil())
+		c, err := worktree.Commit(content, &git.CommitOptions{Author: author, SignKey: key})
+		Expect(err).To(BeNil())
+		return c.String()
+	}
+
+	// sshCommit creates a commit on top of HEAD signed like git does with gpg.format=ssh.
+	sshCommit := func(content string, key ed25519.PrivateKey) string {
+		head, err := source.Head()
+		Expect(err).To(BeNil())
+		parent, err := source.CommitObject(head.Hash())
+		Expect(err).To(BeNil())
+
+		c := &object.Commit{
+			Author:       *author,
+			Committer:    *author,
+			Message:      content,
+			TreeHash:     parent.TreeHash,
+			ParentHashes: []plumbing.Hash{parent.Hash},
+		}
+		unsigned := &plumbing.MemoryObject{}
+		Expect(c.EncodeWithoutSignature(unsigned)).To(BeNil())
+		reader, err := unsigned.Reader()
+		Expect(err).To(BeNil())
+		h := sha512.New()
+		_, err = io.Copy(h, reader)
+		Expect(err).To(BeNil())
+
+		signer, err := ssh.NewSignerFromKey(key)
+		Expect(err).To(BeNil())
+		signedData := append([]byte("SSHSIG"), ssh.Marshal(struct {
+			Namespace     string
+			Reserved      string
+			HashAlgorithm string
+			Hash          []byte
+		}{"git", "", "sha512", h.Sum(nil)})...)
+		signature, err := signer.Sign(rand.Reader, signedData)
+		Expect(err).To(BeNil())
+
+		blob := append([]byte("SSHSIG"), ssh.Marshal(struct {
+			Version       uint32
+			PublicKey     []byte
+			Namespace     string
+			Reserved      string
+			HashAlgorithm string
+			Signature     []byte
+		}{1, signer.PublicKey().Marshal(), "git", "", "sha512", ssh.Marshal(signature)})...)
+		c.PGPSignature = string(pem.EncodeToMemory(&pem.Block{Type: "SSH SIGNATURE", Bytes: blob}))
+
+		obj := source.Storer.NewEncodedObject()
+		Expect(c.Encode(obj)).To(BeNil())
+		hash, err := source.Storer.SetEncodedObject(obj)
+		Expect(err).To(BeNil())
+		err = source.Storer.SetReference(plumbing.NewHashReference(head.Name(), hash))
+		Expect(err).To(BeNil())
+		return hash.String()
+	}
+
+	armoredPublicKey := func(key *openpgp.Entity) string {
+		var buf bytes.Buffer
+		w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+		Expect(err).To(BeNil())
+		Expect(key.Serialize(w)).To(BeNil())
+		Expect(w.Close()).To(BeNil())
+		return buf.String()
+	}
+
+	authorizedKey := func(key ed25519.PrivateKey) string {
+		pub, err := ssh.NewPublicKey(key.Public())
+		Expect(err).To(BeNil())
+		return string(ssh.MarshalAuthorizedKey(pub))
+	}
+
+	verify := func(sha string, keys entity.SigningKeys) error {
+		repo := entity.Repository{Id: "test", Url: tmpDir, AuthType: entity.NoRepositoryAuthType}
+		r := gitRepo.New(cloneDir)
+		clone, err := r.Clone(context.TODO(), repo)
+		Expect(err).To(BeNil())
+		return r.VerifyCommitSignature(context.TODO(), clone, sha, keys)
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "git-*")
+		Expect(err).To(BeNil())
+
+		fs := osfs.New(tmpDir)
+		source, err = git.Init(filesystem.NewStorage(fs, cache.NewObjectLRUDefault()), fs)
+		Expect(err).To(BeNil())
+
+		worktree, err = source.Worktree()
+		Expect(err).To(BeNil())
+
+		cloneDir, err = os.MkdirTemp("", "git-clone-*")
+		Expect(err).To(BeNil())
+	})
+
+	It("accepts a commit signed with a trusted gpg key", func() {
+		key, err := openpgp.NewEntity("John Doe", "", "j@doe.org", nil)
+		Expect(err).To(BeNil())
+		other, err := openpgp.NewEntity("Jane Doe", "", "jane@doe.org", nil)
+		Expect(err).To(BeNil())
+
+		sha := commit("c0", key)
+
+		err = verify(sha, entity.SigningKeys{GPG: []string{armoredPublicKey(other), armoredPublicKey(key)}})
+		Expect(err).To(BeNil())
+	})
+
+	It("refuses a commit signed with an untrusted gpg key", func() {
+		key, err := openpgp.NewEntity("John Doe", "", "j@doe.org", nil)
+		Expect(err).To(BeNil())
+		other, err := openpgp.NewEntity("Jane Doe", "", "jane@doe.org", nil)
+		Expect(err).To(BeNil())
+
+		sha := commit("c0", key)
+
+		err = verify(sha, entity.SigningKeys{GPG: []string{armoredPublicKey(other)}})
+		Expect(errService.IsCommitSignatureError(err)).To(BeTrue())
+	})
+
+	It("accepts a commit signed with a trusted ssh key", func() {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).To(BeNil())
+
+		commit("c0", nil)
+		sha := sshCommit("c1", key)
+
+		err = verify(sha, entity.SigningKeys{SSH: []string{authorizedKey(key)}})
+		Expect(err).To(BeNil())
+	})
+
+	It("refuses a commit signed with an untrusted ssh key", func() {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).To(BeNil())
+		_, other, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).To(BeNil())
+
+		commit("c0", nil)
+		sha := sshCommit("c1", key)
+
+		err = verify(sha, entity.SigningKeys{SSH: []string{authorizedKey(other)}})
+		Expect(errService.IsCommitSignatureError(err)).To(BeTrue())
+	})
+
+	It("refuses an unsigned commit", func() {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).To(BeNil())
+
+		sha := commit("c0", nil)
+
+		err = verify(sha, entity.SigningKeys{SSH: []string{authorizedKey(key)}})
+		Expect(errService.IsCommitSignatureError(err)).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+		os.RemoveAll(cloneDir)
+	})
+})
@@ -0,0 +1,89 @@
+package manifest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	goyaml "github.com/go-yaml/yaml"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	apiv1 "github.com/tupyy/tinyedge-controller/pkg/api/v1"
+)
+
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+// parseConfigurationV1 parses the configuration manifest.
+// Fields which are not set are left to their zero value so they can be inherited from the namespace or the set.
+func parseConfigurationV1(content []byte) (entity.Manifest, error) {
+	var configuration apiv1.Configuration
+
+	if err := goyaml.Unmarshal(content, &configuration); err != nil {
+		return nil, err
+	}
+
+	if len(configuration.Selector.MatchLabels) > 0 || len(configuration.Selector.MatchExpressions) > 0 {
+		return nil, fmt.Errorf("label selectors are not supported by configuration manifests")
+	}
+
+	e := entity.Configuration{
+		TypeMeta: entity.TypeMeta{
+			Version: entity.ManifestVersionV1,
+		},
+		ObjectMeta: entity.ObjectMeta{
+			Name:   configuration.Name,
+			Labels: make(map[string]string),
+			Hash:   hash(string(content)),
+		},
+		Selectors: parseSelectors(configuration.Selector),
+	}
+
+	if configuration.HeartbeatPeriod != "" {
+		period, err := time.ParseDuration(configuration.HeartbeatPeriod)
+		if err != nil {
+			return nil, fmt.Errorf("invalid heartbeat period %q: %w", configuration.HeartbeatPeriod, err)
+		}
+		if period < time.Second {
+			return nil, fmt.Errorf("heartbeat period %q must be at least 1s", configuration.HeartbeatPeriod)
+		}
+		e.HeartbeatPeriod = period
+	}
+
+	if configuration.LogLevel != "" {
+		level := strings.ToLower(configuration.LogLevel)
+		if !contains(logLevels, level) {
+			return nil, fmt.Errorf("unknown log level %q. Expected one of %s", configuration.LogLevel, strings.Join(logLevels, ", "))
+		}
+		e.LogLevel = level
+	}
+
+	if configuration.Profiles != nil {
+		e.Profiles = make([]entity.Profile, 0, len(configuration.Profiles))
+		for _, p := range configuration.Profiles {
+			if p.Name == "" {
+				return nil, fmt.Errorf("profile without name")
+			}
+			profile := entity.Profile{
+				Name:       p.Name,
+				Conditions: make([]entity.ProfileCondition, 0, len(p.Conditions)),
+			}
+			for _, c := range p.Conditions {
+				profile.Conditions = append(profile.Conditions, entity.ProfileCondition{
+					Name:       c.Name,
+					Expression: c.Expression,
+				})
+			}
+			e.Profiles = append(e.Profiles, profile)
+		}
+	}
+
+	return e, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package manifest
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+var (
+	configurationManifest = `
+kind: configuration
+version: v1
+
+name: production
+
+selectors:
+  namespaces:
+    - production
+  sets:
+    - edge
+
+heartbeatPeriod: 1m
+logLevel: DEBUG
+
+profiles:
+  - name: performance
+    conditions:
+      - name: low
+        expression: cpu<25%
+`
+)
+
+func TestConfigurationReader(t *testing.T) {
+	RegisterTestingT(t)
+
+	m, err := ReadManifest(bytes.NewBufferString(configurationManifest))
+	Expect(err).To(BeNil())
+	c, ok := m.(entity.Configuration)
+	Expect(ok).To(BeTrue())
+
+	Expect(c.GetVersion()).To(Equal(entity.ManifestVersionV1))
+	Expect(c.GetHash()).NotTo(BeEmpty())
+	Expect(c.HeartbeatPeriod).To(Equal(time.Minute))
+	Expect(c.LogLevel).To(Equal("debug"))
+	Expect(len(c.Selectors)).To(Equal(2))
+	Expect(len(c.Profiles)).To(Equal(1))
+	Expect(c.Profiles[0].Conditions[0].Expression).To(Equal("cpu<25%"))
+}
+
+func TestConfigurationReaderUnsetFields(t *testing.T) {
+	RegisterTestingT(t)
+
+	m, err := ReadManifest(bytes.NewBufferString("kind: configuration\nversion: v1\nlogLevel: warn\n"))
+	Expect(err).To(BeNil())
+	c := m.(entity.Configuration)
+	Expect(c.HeartbeatPeriod).To(BeZero())
+	Expect(c.Profiles).To(BeNil())
+	Expect(c.LogLevel).To(Equal("warn"))
+}
+
+func TestConfigurationReaderInvalid(t *testing.T) {
+	RegisterTestingT(t)
+
+	for _, content := range []string{
+		"kind: configuration\nversion: v1\nheartbeatPeriod: often\n",
+		"kind: configuration\nversion: v1\nheartbeatPeriod: 10ms\n",
+		"kind: configuration\nversion: v1\nlogLevel: verbose\n",
+		"kind: configuration\nversion: v1\nselectors:\n  matchLabels:\n    env: prod\n",
+		"kind: something\nversion: v1\n",
+	} {
+		_, err := ReadManifest(bytes.NewBufferString(content))
+		Expect(err).NotTo(BeNil(), content)
+	}
+}
@@ -10,6 +10,11 @@ import (
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 )
 
+const (
+	workloadKind      = "workload"
+	configurationKind = "configuration"
+)
+
 type ManifestReader func(r io.Reader, transformFn ...func(entity.Manifest) entity.Manifest) (entity.Manifest, error)
 
 // ReadManifest parses the content of a reader and return a Manifest or error.
@@ -25,10 +30,20 @@ func ReadManifest(r io.Reader, transformFn ...func(entity.Manifest) entity.Manif
 		return nil, err
 	}
 
+	kind, err := getKind(content)
+	if err != nil {
+		return nil, err
+	}
+
 	var manifest entity.Manifest
 	switch version {
 	case entity.ManifestVersionV1:
-		manifest, err = parseManifestV1(content)
+		switch kind {
+		case configurationKind:
+			manifest, err = parseConfigurationV1(content)
+		default:
+			manifest, err = parseManifestV1(content)
+		}
 	}
 
 	if err != nil {
@@ -57,3 +72,22 @@ func getVersion(content []byte) (entity.Version, error) {
 		return entity.ManifestUnknownVersion, nil
 	}
 }
+
+// getKind returns the kind of the manifest. Manifests without kind are workloads.
+func getKind(content []byte) (string, error) {
+	type anonymousStruct struct {
+		Kind string `yaml:"kind"`
+	}
+	var a anonymousStruct
+	if err := goyaml.Unmarshal(content, &a); err != nil {
+		return "", fmt.Errorf("unknown struct: %s", err)
+	}
+	switch strings.ToLower(a.Kind) {
+	case "", workloadKind:
+		return workloadKind, nil
+	case configurationKind:
+		return configurationKind, nil
+	default:
+		return "", fmt.Errorf("unknown manifest kind %q", a.Kind)
+	}
+}
@@ -0,0 +1,132 @@
+package manifest
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/pkg/models"
+)
+
+// ReadResources reads the resources of a workload from the local clone of the repository.
+// The $ref paths are relative to the root of the manifests of the repository and must be in its scope. Quadlet units are parsed as such and the other resources
+// as Kubernetes Pods, ConfigMaps or Secrets. The content of the resources is added to the hash of the manifest so a change of
+// a resource changes the manifest. Templated resources are only checked for syntax since they are rendered for each device.
+// If a resource cannot be read or is invalid, the workload is returned with its ValidationError set.
+func ReadResources(m entity.Manifest, repo entity.Repository) (entity.Manifest, error) {
+	w, ok := m.(entity.ManifestV1)
+	if !ok {
+		return m, nil
+	}
+
+	if err := readResources(&w, repo); err != nil {
+		w.ValidationError = err.Error()
+		w.Quadlets = nil
+		w.Objects = nil
+		w.Templates = nil
+	}
+
+	return w, nil
+}
+
+func readResources(w *entity.ManifestV1, repo entity.Repository) error {
+	h := sha256.New()
+	h.Write([]byte(w.Hash))
+
+	quadlets := make(map[string]struct{})
+	objects := make(map[string]struct{})
+	for _, ref := range w.Resources {
+		path, err := resolveRef(repo.Root(), ref)
+		if err != nil {
+			return err
+		}
+		if !repo.IsInScope(ref) {
+			return fmt.Errorf("resource %q is excluded from the repository", ref)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read resource %q: %w", ref, err)
+		}
+		h.Write([]byte(ref))
+		h.Write(content)
+
+		if models.IsTemplate(ref) {
+			t, err := models.ParseTemplate(ref, content)
+			if err != nil {
+				return err
+			}
+			w.Templates = append(w.Templates, t)
+			continue
+		}
+
+		if w.Kind == entity.QuadletWorkloadKind {
+			q, err := models.ParseQuadlet(ref, content)
+			if err != nil {
+				return fmt.Errorf("invalid quadlet unit %q: %w", ref, err)
+			}
+			if _, found := quadlets[q.Name]; found {
+				return fmt.Errorf("two quadlet units are named %q", q.Name)
+			}
+			quadlets[q.Name] = struct{}{}
+			w.Quadlets = append(w.Quadlets, q)
+			continue
+		}
+
+		oo, err := models.Unmarshal(content)
+		if err != nil {
+			return fmt.Errorf("invalid resource %q: %w", ref, err)
+		}
+		for _, o := range oo {
+			key := fmt.Sprintf("%s/%s", o.Kind, o.Name)
+			if _, found := objects[key]; found {
+				return fmt.Errorf("%s %q is defined twice", o.Kind, o.Name)
+			}
+			objects[key] = struct{}{}
+		}
+		w.Objects = append(w.Objects, oo...)
+	}
+	w.Hash = fmt.Sprintf("%x", h.Sum(nil))
+
+	return nil
+}
+
+// resolveRef returns the path of the resource in the root of the manifests. Paths escaping the root are refused,
+// including through symbolic links.
+func resolveRef(root, ref string) (string, error) {
+	if ref == "" {
+		return "", errors.New("empty resource reference")
+	}
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve repository path %q: %w", root, err)
+	}
+
+	path := filepath.Join(realRoot, filepath.FromSlash(ref))
+	if !isInside(realRoot, path) {
+		return "", fmt.Errorf("resource %q is outside the repository", ref)
+	}
+
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read resource %q: %w", ref, err)
+	}
+	if !isInside(realRoot, realPath) {
+		return "", fmt.Errorf("resource %q is outside the repository", ref)
+	}
+
+	return realPath, nil
+}
+
+func isInside(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,298 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/pkg/models"
+)
+
+const (
+	quadletManifest = `
+version: v1
+name: web
+resources:
+  - $ref: /units/web.container
+  - $ref: /units/data.volume
+`
+	containerUnit = `# web server
+[Unit]
+Description=web server
+
+[Container]
+Image=docker.io/library/nginx:latest
+PublishPort=8080:80
+PublishPort=8443:443
+Exec=nginx \
+  -g "daemon off;"
+
+[Install]
+WantedBy=default.target
+`
+	volumeUnit = `[Volume]
+`
+)
+
+func writeUnits(t *testing.T, units map[string]string) string {
+	root := t.TempDir()
+	Expect(os.MkdirAll(filepath.Join(root, "units"), 0755)).To(Succeed())
+	for name, content := range units {
+		Expect(os.WriteFile(filepath.Join(root, "units", name), []byte(content), 0644)).To(Succeed())
+	}
+	return root
+}
+
+func TestReadQuadletResources(t *testing.T) {
+	RegisterTestingT(t)
+
+	root := writeUnits(t, map[string]string{"web.container": containerUnit, "data.volume": volumeUnit})
+
+	manifest, err := parseManifestV1([]byte(quadletManifest))
+	Expect(err).To(BeNil())
+	Expect(manifest.(entity.ManifestV1).Kind).To(Equal(entity.QuadletWorkloadKind))
+
+	withResources, err := ReadResources(manifest, entity.Repository{LocalPath: root})
+	Expect(err).To(BeNil())
+	w := withResources.(entity.ManifestV1)
+	Expect(len(w.Quadlets)).To(Equal(2))
+	Expect(w.Quadlets[0].Name).To(Equal("web.container"))
+	Expect(w.Quadlets[0].Get("Container", "Image")).To(Equal("docker.io/library/nginx:latest"))
+	Expect(w.Quadlets[0].Sections["Container"]["PublishPort"]).To(Equal([]string{"8080:80", "8443:443"}))
+	Expect(w.Quadlets[0].Get("Container", "Exec")).To(Equal(`nginx -g "daemon off;"`))
+	Expect(string(w.Quadlets[1].Content)).To(Equal(volumeUnit))
+	Expect(w.Hash).ToNot(Equal(manifest.GetHash()))
+
+	// the hash changes when a unit changes
+	Expect(os.WriteFile(filepath.Join(root, "units", "data.volume"), []byte("[Volume]\nLabel=app=web\n"), 0644)).To(Succeed())
+	changed, err := ReadResources(manifest, entity.Repository{LocalPath: root})
+	Expect(err).To(BeNil())
+	Expect(changed.GetHash()).ToNot(Equal(w.Hash))
+}
+
+func TestReadQuadletResourcesInvalid(t *testing.T) {
+	RegisterTestingT(t)
+
+	manifest, err := parseManifestV1([]byte(quadletManifest))
+	Expect(err).To(BeNil())
+
+	units := map[string]string{
+		"missing image":   "[Container]\nPublishPort=80:80\n",
+		"missing section": "[Unit]\nDescription=web\n",
+		"unknown section": "[Container]\nImage=nginx\n[Kube]\nYaml=web.yaml\n",
+		"no section":      "Image=nginx\n",
+		"no value":        "[Container]\nImage\n",
+	}
+	for name, content := range units {
+		root := writeUnits(t, map[string]string{"web.container": content, "data.volume": volumeUnit})
+		w, err := ReadResources(manifest, entity.Repository{LocalPath: root})
+		Expect(err).To(BeNil())
+		Expect(w.(entity.ManifestV1).IsValid()).To(BeFalse(), name)
+		Expect(w.(entity.ManifestV1).Quadlets).To(BeEmpty(), name)
+	}
+
+	// missing file
+	root := writeUnits(t, map[string]string{"web.container": containerUnit})
+	w, err := ReadResources(manifest, entity.Repository{LocalPath: root})
+	Expect(err).To(BeNil())
+	Expect(w.(entity.ManifestV1).ValidationError).To(ContainSubstring("data.volume"))
+}
+
+func TestReadQuadletResourcesMixed(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := parseManifestV1([]byte(`
+version: v1
+name: web
+resources:
+  - $ref: /units/web.container
+  - $ref: /dep/nginx.yaml
+`))
+	Expect(err).ToNot(BeNil())
+}
+
+const (
+	podManifest = `
+version: v1
+name: nginx
+resources:
+  - $ref: /dep/configmap.yaml
+  - $ref: dep/nginx.yaml
+`
+	configMapResource = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: nginx-conf
+data:
+  nginx.conf: |
+    server {}
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: nginx-certs
+stringData:
+  tls.key: key
+`
+	podResource = `apiVersion: v1
+kind: Pod
+metadata:
+  name: nginx
+spec:
+  containers:
+    - name: nginx
+      image: docker.io/library/nginx:latest
+      ports:
+        - containerPort: 80
+  volumes:
+    - name: conf
+      configMap:
+        name: nginx-conf
+`
+)
+
+func writeResources(t *testing.T, resources map[string]string) string {
+	root := t.TempDir()
+	for name, content := range resources {
+		path := filepath.Join(root, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+	return root
+}
+
+func TestReadPodResources(t *testing.T) {
+	RegisterTestingT(t)
+
+	root := writeResources(t, map[string]string{"dep/configmap.yaml": configMapResource, "dep/nginx.yaml": podResource})
+
+	manifest, err := parseManifestV1([]byte(podManifest))
+	Expect(err).To(BeNil())
+	Expect(manifest.(entity.ManifestV1).Kind).To(Equal(entity.PodWorkloadKind))
+
+	withResources, err := ReadResources(manifest, entity.Repository{LocalPath: root})
+	Expect(err).To(BeNil())
+	w := withResources.(entity.ManifestV1)
+	Expect(w.IsValid()).To(BeTrue(), w.ValidationError)
+	Expect(len(w.Objects)).To(Equal(3))
+	Expect(w.Objects[0].Kind).To(Equal(models.ConfigMapKind))
+	Expect(w.Objects[0].Name).To(Equal("nginx-conf"))
+	Expect(w.Objects[1].Kind).To(Equal(models.SecretKind))
+	Expect(w.Objects[2].Kind).To(Equal(models.PodKind))
+	Expect(string(w.Objects[2].Content)).To(ContainSubstring("containerPort: 80"))
+	Expect(w.Hash).ToNot(Equal(manifest.GetHash()))
+}
+
+func TestReadPodResourcesInvalid(t *testing.T) {
+	RegisterTestingT(t)
+
+	manifest, err := parseManifestV1([]byte(podManifest))
+	Expect(err).To(BeNil())
+
+	resources := map[string]string{
+		"no containers":       "apiVersion: v1\nkind: Pod\nmetadata:\n  name: nginx\n",
+		"no image":            "apiVersion: v1\nkind: Pod\nmetadata:\n  name: nginx\nspec:\n  containers:\n    - name: nginx\n",
+		"no name":             "apiVersion: v1\nkind: Pod\nspec:\n  containers:\n    - name: nginx\n      image: nginx\n",
+		"unsupported kind":    "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: nginx\n",
+		"invalid yaml":        "apiVersion: v1\nkind: [Pod\n",
+		"duplicated resource": configMapResource,
+	}
+	for name, content := range resources {
+		root := writeResources(t, map[string]string{"dep/configmap.yaml": configMapResource, "dep/nginx.yaml": content})
+		w, err := ReadResources(manifest, entity.Repository{LocalPath: root})
+		Expect(err).To(BeNil())
+		Expect(w.(entity.ManifestV1).IsValid()).To(BeFalse(), name)
+		Expect(w.(entity.ManifestV1).Objects).To(BeEmpty(), name)
+	}
+}
+
+func TestReadResourcesOutsideRepository(t *testing.T) {
+	RegisterTestingT(t)
+
+	outside := writeResources(t, map[string]string{"nginx.yaml": podResource})
+	root := writeResources(t, map[string]string{"dep/configmap.yaml": configMapResource})
+	Expect(os.Symlink(filepath.Join(outside, "nginx.yaml"), filepath.Join(root, "dep", "link.yaml"))).To(Succeed())
+
+	for _, ref := range []string{"../" + filepath.Base(outside) + "/nginx.yaml", "/dep/../../nginx.yaml", "/dep/link.yaml"} {
+		manifest, err := parseManifestV1([]byte("version: v1\nname: nginx\nresources:\n  - $ref: " + ref + "\n"))
+		Expect(err).To(BeNil())
+
+		w, err := ReadResources(manifest, entity.Repository{LocalPath: root})
+		Expect(err).To(BeNil())
+		Expect(w.(entity.ManifestV1).ValidationError).To(ContainSubstring("outside the repository"), ref)
+	}
+}
+
+func TestReadResourcesScope(t *testing.T) {
+	RegisterTestingT(t)
+
+	root := writeResources(t, map[string]string{
+		"team-a/dep/configmap.yaml":      configMapResource,
+		"team-a/dep/nginx.yaml":          podResource,
+		"team-a/testdata/configmap.yaml": configMapResource,
+		"team-b/dep/nginx.yaml":          podResource,
+	})
+
+	manifest, err := parseManifestV1([]byte(podManifest))
+	Expect(err).To(BeNil())
+
+	// the $ref are relative to the subpath
+	w, err := ReadResources(manifest, entity.Repository{LocalPath: root, Subpath: "team-a"})
+	Expect(err).To(BeNil())
+	Expect(w.(entity.ManifestV1).IsValid()).To(BeTrue(), w.(entity.ManifestV1).ValidationError)
+
+	// resources outside the subpath are refused
+	outside, err := parseManifestV1([]byte("version: v1\nname: nginx\nresources:\n  - $ref: ../team-b/dep/nginx.yaml\n"))
+	Expect(err).To(BeNil())
+	w, err = ReadResources(outside, entity.Repository{LocalPath: root, Subpath: "team-a"})
+	Expect(err).To(BeNil())
+	Expect(w.(entity.ManifestV1).ValidationError).To(ContainSubstring("outside the repository"))
+
+	// excluded resources are refused
+	excluded, err := parseManifestV1([]byte("version: v1\nname: nginx\nresources:\n  - $ref: /testdata/configmap.yaml\n"))
+	Expect(err).To(BeNil())
+	w, err = ReadResources(excluded, entity.Repository{LocalPath: root, Subpath: "team-a", ExcludeGlobs: []string{"**/testdata"}})
+	Expect(err).To(BeNil())
+	Expect(w.(entity.ManifestV1).ValidationError).To(ContainSubstring("excluded"))
+
+	// resources not matching the include globs are refused
+	w, err = ReadResources(manifest, entity.Repository{LocalPath: root, Subpath: "team-a", IncludeGlobs: []string{"dep/configmap.yaml"}})
+	Expect(err).To(BeNil())
+	Expect(w.(entity.ManifestV1).ValidationError).To(ContainSubstring("excluded"))
+}
+
+func TestReadTemplatedResources(t *testing.T) {
+	RegisterTestingT(t)
+
+	manifest, err := parseManifestV1([]byte(`
+version: v1
+name: web
+resources:
+  - $ref: /units/web.container.tmpl
+  - $ref: /units/data.volume
+`))
+	Expect(err).To(BeNil())
+	Expect(manifest.(entity.ManifestV1).Kind).To(Equal(entity.QuadletWorkloadKind))
+
+	root := writeUnits(t, map[string]string{"web.container.tmpl": "[Container]\nImage={{ .Vars.image }}\n", "data.volume": volumeUnit})
+	withResources, err := ReadResources(manifest, entity.Repository{LocalPath: root})
+	Expect(err).To(BeNil())
+	w := withResources.(entity.ManifestV1)
+	Expect(w.IsValid()).To(BeTrue())
+	Expect(len(w.Quadlets)).To(Equal(1))
+	Expect(len(w.Templates)).To(Equal(1))
+	Expect(w.Templates[0].Name).To(Equal("web.container"))
+
+	rendered, err := w.Templates[0].Render(models.TemplateData{Vars: map[string]string{"image": "nginx"}})
+	Expect(err).To(BeNil())
+	Expect(string(rendered)).To(Equal("[Container]\nImage=nginx\n"))
+
+	// invalid template syntax
+	root = writeUnits(t, map[string]string{"web.container.tmpl": "[Container]\nImage={{ .Vars.image \n", "data.volume": volumeUnit})
+	withResources, err = ReadResources(manifest, entity.Repository{LocalPath: root})
+	Expect(err).To(BeNil())
+	Expect(withResources.(entity.ManifestV1).IsValid()).To(BeFalse())
+	Expect(withResources.(entity.ManifestV1).Templates).To(BeEmpty())
+}
@@ -4,14 +4,15 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"strings"
 
 	goyaml "github.com/go-yaml/yaml"
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 	apiv1 "github.com/tupyy/tinyedge-controller/pkg/api/v1"
+	"github.com/tupyy/tinyedge-controller/pkg/models"
 )
 
-// parse parses the manifest file and verify that all the resources defined are valid k8s manifestv1.
-// Returns false if one resource is not a valid ConfigMap or Pod.
+// parse parses the manifest file. The resources are read and validated by ReadResources.
 func parseManifestV1(content []byte) (entity.Manifest, error) {
 	var workload apiv1.Workload
 
@@ -24,34 +25,72 @@ func parseManifestV1(content []byte) (entity.Manifest, error) {
 			Version: entity.ManifestVersionV1,
 		},
 		ObjectMeta: entity.ObjectMeta{
+			Name:   workload.Name,
 			Labels: make(map[string]string),
+			Hash:   hash(string(content)),
 		},
 		Description: workload.Description,
-		Selectors:   make([]entity.Selector, 0),
 		Secrets:     make([]entity.Secret, 0, len(workload.Secrets)),
 		Resources:   make([]string, 0, len(workload.Resources)),
 	}
 
+	e.Selectors = parseSelectors(workload.Selector)
+
+	labelSelector, err := parseLabelSelector(workload.Selector)
+	if err != nil {
+		return nil, err
+	}
+	e.LabelSelector = labelSelector
+
+	for _, s := range workload.Secrets {
+		e.Secrets = append(e.Secrets, entity.Secret{
+			Path: s.Path,
+			Id:   s.Name,
+			Key:  s.Key,
+		})
+	}
+
+	quadlets := 0
+	for _, resource := range workload.Resources {
+		e.Resources = append(e.Resources, resource.Ref)
+		if models.IsQuadlet(strings.TrimSuffix(resource.Ref, models.TemplateExtension)) {
+			quadlets++
+		}
+	}
+
+	if quadlets > 0 {
+		if quadlets != len(e.Resources) {
+			return nil, fmt.Errorf("workload %q mixes quadlet units with other resources", workload.Name)
+		}
+		e.Kind = entity.QuadletWorkloadKind
+	}
+
+	return e, nil
+}
+
+// parseSelectors returns the namespace, set and device selectors of the manifest.
+func parseSelectors(selector apiv1.Selector) []entity.Selector {
+	selectors := make([]entity.Selector, 0, len(selector.Namespaces)+len(selector.Sets)+len(selector.Devices))
 	for i := 0; true; i++ {
 		keepGoing := false
-		if i < len(workload.Selector.Namespaces) {
-			e.Selectors = append(e.Selectors, entity.Selector{
+		if i < len(selector.Namespaces) {
+			selectors = append(selectors, entity.Selector{
 				Type:  entity.NamespaceSelector,
-				Value: workload.Selector.Namespaces[i],
+				Value: selector.Namespaces[i],
 			})
 			keepGoing = true
 		}
-		if i < len(workload.Selector.Sets) {
-			e.Selectors = append(e.Selectors, entity.Selector{
+		if i < len(selector.Sets) {
+			selectors = append(selectors, entity.Selector{
 				Type:  entity.SetSelector,
-				Value: workload.Selector.Sets[i],
+				Value: selector.Sets[i],
 			})
 			keepGoing = true
 		}
-		if i < len(workload.Selector.Devices) {
-			e.Selectors = append(e.Selectors, entity.Selector{
+		if i < len(selector.Devices) {
+			selectors = append(selectors, entity.Selector{
 				Type:  entity.DeviceSelector,
-				Value: workload.Selector.Devices[i],
+				Value: selector.Devices[i],
 			})
 			keepGoing = true
 		}
@@ -59,20 +98,63 @@ func parseManifestV1(content []byte) (entity.Manifest, error) {
 			break
 		}
 	}
+	return selectors
+}
 
-	for _, s := range workload.Secrets {
-		e.Secrets = append(e.Secrets, entity.Secret{
-			Path: s.Path,
-			Id:   s.Name,
-			Key:  s.Key,
-		})
+// parseLabelSelector returns the label selector of the manifest or nil if the manifest has no label selector.
+func parseLabelSelector(selector apiv1.Selector) (*entity.LabelSelector, error) {
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		return nil, nil
 	}
 
-	for _, resource := range workload.Resources {
-		e.Resources = append(e.Resources, resource.Ref)
+	labelSelector := &entity.LabelSelector{
+		MatchLabels:      make(map[string]string, len(selector.MatchLabels)),
+		MatchExpressions: make([]entity.LabelSelectorRequirement, 0, len(selector.MatchExpressions)),
 	}
 
-	return e, nil
+	for k, v := range selector.MatchLabels {
+		labelSelector.MatchLabels[k] = v
+	}
+
+	for _, expr := range selector.MatchExpressions {
+		if expr.Key == "" {
+			return nil, fmt.Errorf("label selector requirement without key")
+		}
+
+		requirement := entity.LabelSelectorRequirement{
+			Key:    expr.Key,
+			Values: expr.Values,
+		}
+
+		switch expr.Operator {
+		case "In":
+			requirement.Operator = entity.InLabelOperator
+		case "NotIn":
+			requirement.Operator = entity.NotInLabelOperator
+		case "Exists":
+			requirement.Operator = entity.ExistsLabelOperator
+		case "DoesNotExist":
+			requirement.Operator = entity.DoesNotExistLabelOperator
+		default:
+			return nil, fmt.Errorf("unknown label selector operator %q for key %q", expr.Operator, expr.Key)
+		}
+
+		hasValues := len(expr.Values) > 0
+		switch requirement.Operator {
+		case entity.InLabelOperator, entity.NotInLabelOperator:
+			if !hasValues {
+				return nil, fmt.Errorf("operator %q requires values for key %q", expr.Operator, expr.Key)
+			}
+		default:
+			if hasValues {
+				return nil, fmt.Errorf("operator %q does not accept values for key %q", expr.Operator, expr.Key)
+			}
+		}
+
+		labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, requirement)
+	}
+
+	return labelSelector, nil
 }
 
 func hash(data string) string {
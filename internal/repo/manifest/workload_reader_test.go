@@ -55,3 +55,43 @@ func TestManifestReader(t *testing.T) {
 	Expect(len(w.Selectors)).To(Equal(5))
 	Expect(w.GetVersion().String()).To(Equal("v1"))
 }
+
+func TestManifestReaderLabelSelector(t *testing.T) {
+	RegisterTestingT(t)
+
+	content := bytes.NewBufferString(`
+version: v1
+name: labels
+selectors:
+  matchLabels:
+    zone: north
+  matchExpressions:
+    - key: arch
+      operator: In
+      values:
+        - arm64
+    - key: deprecated
+      operator: DoesNotExist
+`).Bytes()
+	manifest, err := parseManifestV1(content)
+	Expect(err).To(BeNil())
+	w := manifest.(entity.ManifestV1)
+
+	Expect(w.LabelSelector).NotTo(BeNil())
+	Expect(w.LabelSelector.MatchLabels).To(HaveKeyWithValue("zone", "north"))
+	Expect(len(w.LabelSelector.MatchExpressions)).To(Equal(2))
+	Expect(w.LabelSelector.Matches(map[string]string{"zone": "north", "arch": "arm64"})).To(BeTrue())
+	Expect(w.LabelSelector.Matches(map[string]string{"zone": "north", "arch": "amd64"})).To(BeFalse())
+	Expect(w.LabelSelector.Matches(map[string]string{"zone": "north", "arch": "arm64", "deprecated": "true"})).To(BeFalse())
+
+	_, err = parseManifestV1(bytes.NewBufferString(`
+version: v1
+selectors:
+  matchExpressions:
+    - key: arch
+      operator: Equals
+      values:
+        - arm64
+`).Bytes())
+	Expect(err).NotTo(BeNil())
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
@@ -18,10 +19,20 @@ func DeviceEntityToModel(device entity.Device) models.Device {
 		NamespaceID: device.NamespaceID,
 		Registered:  device.Registred,
 		Enroled:     device.EnrolStatus.String(),
-		EnroledAt:   device.EnroledAt,
+		State:       device.State.String(),
+		LastSeen:    device.LastSeen,
 	}
 
-	if device.EnrolStatus == entity.EnroledStatus {
+	if device.Uptime > 0 {
+		m.UptimeSeconds = sql.NullInt64{Valid: true, Int64: int64(device.Uptime.Seconds())}
+	}
+
+	if device.ConfigurationHash != "" {
+		m.ConfigurationHash = sql.NullString{Valid: true, String: device.ConfigurationHash}
+	}
+
+	// a decommissioned device keeps the time it was enroled at for audit purposes
+	if device.EnrolStatus == entity.EnroledStatus || device.EnrolStatus == entity.DecommissionedEnrolStatus {
 		m.EnroledAt = device.EnroledAt
 	}
 
@@ -37,6 +48,10 @@ func DeviceEntityToModel(device entity.Device) models.Device {
 		m.DeviceSetID = sql.NullString{Valid: true, String: *device.SetID}
 	}
 
+	if device.EnrolStatus == entity.DecommissionedEnrolStatus {
+		m.DecommissionedAt = device.DecommissionedAt
+	}
+
 	return m
 }
 
@@ -46,15 +61,27 @@ func DeviceToEntity(joins []models.DeviceJoin, readFn manifest.ManifestReader) (
 		NamespaceID: joins[0].NamespaceID,
 		Registred:   joins[0].Registered,
 		EnrolStatus: entity.EnroledStatus.FromString(joins[0].Enroled),
+		State:       entity.OfflineDeviceState.FromString(joins[0].State),
+		LastSeen:    joins[0].LastSeen,
+	}
+	if joins[0].UptimeSeconds.Valid {
+		e.Uptime = time.Duration(joins[0].UptimeSeconds.Int64) * time.Second
+	}
+	if joins[0].ConfigurationHash.Valid {
+		e.ConfigurationHash = joins[0].ConfigurationHash.String
 	}
 	if joins[0].Registered {
 		e.RegisteredAt = joins[0].RegisteredAt
 	}
 
-	if e.EnrolStatus == entity.EnroledStatus {
+	if e.EnrolStatus == entity.EnroledStatus || e.EnrolStatus == entity.DecommissionedEnrolStatus {
 		e.EnroledAt = joins[0].EnroledAt
 	}
 
+	if e.EnrolStatus == entity.DecommissionedEnrolStatus {
+		e.DecommissionedAt = joins[0].DecommissionedAt
+	}
+
 	if joins[0].CertificateSn.Valid {
 		e.CertificateSerialNumber = joins[0].CertificateSn.String
 	}
@@ -67,8 +94,12 @@ func DeviceToEntity(joins []models.DeviceJoin, readFn manifest.ManifestReader) (
 	for _, d := range joins {
 		if d.WorkloadID != "" && !idMap.exists(d.WorkloadID, "manifest") {
 			manifests = append(manifests, map[string]string{
-				"id":   d.WorkloadID,
-				"path": d.WorkloadPath,
+				"id":            d.WorkloadID,
+				"path":          d.WorkloadPath,
+				"root":          d.WorkloadRepoLocalPath,
+				"subpath":       d.WorkloadRepoSubpath,
+				"include_globs": d.WorkloadRepoIncludeGlobs,
+				"exclude_globs": d.WorkloadRepoExcludeGlobs,
 			})
 			idMap.add(d.WorkloadID, "manifest")
 		}
@@ -76,11 +107,16 @@ func DeviceToEntity(joins []models.DeviceJoin, readFn manifest.ManifestReader) (
 
 	e.Workloads = make([]entity.ManifestV1, 0, len(manifests))
 	for _, m := range manifests {
-		manifest, err := readManifest(m["path"], m["id"], readFn)
+		manifest, err := readManifest(m["path"], m["id"], workloadRepository(m), readFn)
 		if err != nil {
 			return entity.Device{}, fmt.Errorf("unable to read manifest file %q: %w", m["path"], err)
 		}
-		e.Workloads = append(e.Workloads, manifest.(entity.ManifestV1))
+		switch m := manifest.(type) {
+		case entity.ManifestV1:
+			e.Workloads = append(e.Workloads, m)
+		case entity.Configuration:
+			e.Configuration = firstConfiguration(e.Configuration, m)
+		}
 	}
 
 	return e, nil
@@ -111,6 +147,32 @@ func DevicesToEntity(joins []models.DeviceJoin, readFn manifest.ManifestReader)
 	return entities, nil
 }
 
+func DeviceLabelsToModel(deviceID string, labels map[string]string) []models.DeviceLabels {
+	m := make([]models.DeviceLabels, 0, len(labels))
+	for k, v := range labels {
+		m = append(m, models.DeviceLabels{
+			DeviceID: deviceID,
+			Key:      k,
+			Value:    v,
+		})
+	}
+	return m
+}
+
+// DeviceLabelsToEntity returns the labels grouped by device id.
+func DeviceLabelsToEntity(labels []models.DeviceLabels) map[string]map[string]string {
+	e := make(map[string]map[string]string)
+	for _, l := range labels {
+		deviceLabels, ok := e[l.DeviceID]
+		if !ok {
+			deviceLabels = make(map[string]string)
+			e[l.DeviceID] = deviceLabels
+		}
+		deviceLabels[l.Key] = l.Value
+	}
+	return e
+}
+
 func ConfigurationToEntity(c models.Configuration) entity.Configuration {
 	e := entity.Configuration{
 		ObjectMeta: entity.ObjectMeta{
@@ -165,8 +227,12 @@ func SetToEntity(s []models.SetJoin, readFn manifest.ManifestReader) (entity.Set
 		}
 		if ss.WorkloadID != "" && !idMap.exists(ss.WorkloadID, "manifest") {
 			manifests = append(manifests, map[string]string{
-				"id":   ss.WorkloadID,
-				"path": ss.WorkloadPath,
+				"id":            ss.WorkloadID,
+				"path":          ss.WorkloadPath,
+				"root":          ss.WorkloadRepoLocalPath,
+				"subpath":       ss.WorkloadRepoSubpath,
+				"include_globs": ss.WorkloadRepoIncludeGlobs,
+				"exclude_globs": ss.WorkloadRepoExcludeGlobs,
 			})
 			idMap.add(ss.WorkloadID, "manifest")
 		}
@@ -175,11 +241,16 @@ func SetToEntity(s []models.SetJoin, readFn manifest.ManifestReader) (entity.Set
 	set.Devices = devices
 	set.Workloads = make([]entity.ManifestV1, 0, len(manifests))
 	for _, m := range manifests {
-		manifest, err := readManifest(m["path"], m["id"], readFn)
+		manifest, err := readManifest(m["path"], m["id"], workloadRepository(m), readFn)
 		if err != nil {
 			return entity.Set{}, fmt.Errorf("unable to read manifest file %q: %w", m["path"], err)
 		}
-		set.Workloads = append(set.Workloads, manifest.(entity.ManifestV1))
+		switch m := manifest.(type) {
+		case entity.ManifestV1:
+			set.Workloads = append(set.Workloads, m)
+		case entity.Configuration:
+			set.Configuration = firstConfiguration(set.Configuration, m)
+		}
 	}
 
 	return set, nil
@@ -252,8 +323,12 @@ func NamespaceModelToEntity(n []models.NamespaceJoin, readFn manifest.ManifestRe
 		}
 		if nn.WorkloadID != "" && !idMap.exists(nn.WorkloadID, "manifest") {
 			manifests = append(manifests, map[string]string{
-				"id":   nn.WorkloadID,
-				"path": nn.WorkloadPath,
+				"id":            nn.WorkloadID,
+				"path":          nn.WorkloadPath,
+				"root":          nn.WorkloadRepoLocalPath,
+				"subpath":       nn.WorkloadRepoSubpath,
+				"include_globs": nn.WorkloadRepoIncludeGlobs,
+				"exclude_globs": nn.WorkloadRepoExcludeGlobs,
 			})
 			idMap.add(nn.WorkloadID, "manifest")
 		}
@@ -263,22 +338,51 @@ func NamespaceModelToEntity(n []models.NamespaceJoin, readFn manifest.ManifestRe
 	namespace.Devices = devices
 	namespace.Workloads = make([]entity.ManifestV1, 0, len(manifests))
 	for _, m := range manifests {
-		manifest, err := readManifest(m["path"], m["id"], readFn)
+		manifest, err := readManifest(m["path"], m["id"], workloadRepository(m), readFn)
 		if err != nil {
 			return entity.Namespace{}, fmt.Errorf("unable to read manifest file %q: %w", m["path"], err)
 		}
-		namespace.Workloads = append(namespace.Workloads, manifest.(entity.ManifestV1))
+		switch m := manifest.(type) {
+		case entity.ManifestV1:
+			namespace.Workloads = append(namespace.Workloads, m)
+		case entity.Configuration:
+			namespace.Configuration = firstConfiguration(namespace.Configuration, m)
+		}
 	}
 
 	return namespace, nil
 }
 
-func readManifest(filepath string, id string, readFn manifest.ManifestReader) (entity.Manifest, error) {
-	content, err := os.ReadFile(filepath)
+// firstConfiguration returns the configuration with the lowest id so the same configuration is used
+// when more than one configuration targets a resource.
+func firstConfiguration(current *entity.Configuration, c entity.Configuration) *entity.Configuration {
+	if current == nil || c.GetID() < current.GetID() {
+		return &c
+	}
+	return current
+}
+
+// workloadRepository returns the part of the repository of the workload needed to read its resources.
+func workloadRepository(m map[string]string) entity.Repository {
+	return entity.Repository{
+		LocalPath:    m["root"],
+		Subpath:      m["subpath"],
+		IncludeGlobs: splitGlobs(m["include_globs"]),
+		ExcludeGlobs: splitGlobs(m["exclude_globs"]),
+	}
+}
+
+// readManifest reads the manifest file. The path is relative to the root of the repository. Absolute paths are
+// still accepted for the manifests saved before paths were made relative.
+func readManifest(path string, id string, repo entity.Repository, readFn manifest.ManifestReader) (entity.Manifest, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(repo.LocalPath, path)
+	}
+	content, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
-	manifest, err := readFn(bytes.NewBuffer(content), func(m entity.Manifest) entity.Manifest {
+	parsed, err := readFn(bytes.NewBuffer(content), func(m entity.Manifest) entity.Manifest {
 		switch v := m.(type) {
 		case entity.ManifestV1:
 			v.ObjectMeta.Id = id
@@ -293,5 +397,5 @@ func readManifest(filepath string, id string, readFn manifest.ManifestReader) (e
 	if err != nil {
 		return nil, err
 	}
-	return manifest, nil
+	return manifest.ReadResources(parsed, repo)
 }
@@ -1,7 +1,10 @@
 package mappers
 
 import (
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
@@ -45,11 +48,32 @@ func ManifestEntityToModel(e entity.Manifest) models.Manifest {
 	case entity.ManifestV1:
 		m.RepoID = v.Repository.Id
 		m.Path = v.Path
+	case entity.Configuration:
+		m.RepoID = v.Repository.Id
+		m.Path = v.Path
+	}
+	if selector := e.GetLabelSelector(); selector != nil && !selector.IsEmpty() {
+		// the selector holds only strings and integers so it always encodes.
+		data, _ := json.Marshal(selector)
+		m.LabelSelector = sql.NullString{Valid: true, String: string(data)}
 	}
 
 	return m
 }
 
+// LabelSelectorModelToEntity decodes the label selector stored with a manifest.
+// It returns nil if the manifest does not select devices by labels.
+func LabelSelectorModelToEntity(s sql.NullString) (*entity.LabelSelector, error) {
+	if !s.Valid || s.String == "" {
+		return nil, nil
+	}
+	selector := entity.LabelSelector{}
+	if err := json.Unmarshal([]byte(s.String), &selector); err != nil {
+		return nil, fmt.Errorf("failed to decode label selector: %w", err)
+	}
+	return &selector, nil
+}
+
 func parseManifest(mm []models.ManifestJoin, readFn manifest.ManifestReader) (entity.Manifest, error) {
 	m := mm[0]
 
@@ -62,6 +86,9 @@ func parseManifest(mm []models.ManifestJoin, readFn manifest.ManifestReader) (en
 	if m.RepoLocalPath.Valid {
 		repo.LocalPath = m.RepoLocalPath.String
 	}
+	repo.Subpath = m.RepoSubpath.String
+	repo.IncludeGlobs = splitGlobs(m.RepoIncludeGlobs.String)
+	repo.ExcludeGlobs = splitGlobs(m.RepoExcludeGlobs.String)
 	if m.RepoCurrentHeadSha.Valid {
 		repo.CurrentHeadSha = m.RepoCurrentHeadSha.String
 	}
@@ -108,14 +135,20 @@ func parseManifest(mm []models.ManifestJoin, readFn manifest.ManifestReader) (en
 		}
 	}
 
-	manifest, err := readManifest(mm[0].Path, mm[0].ID, readFn)
+	manifest, err := readManifest(mm[0].Path, mm[0].ID, repo, readFn)
 	if err != nil {
-		return nil, err
+		// the file may have been moved or removed since the last synchronization. The manifest is kept as invalid
+		// so it can still be updated or deleted.
+		manifest = entity.ManifestV1{
+			ObjectMeta:      entity.ObjectMeta{Id: mm[0].ID},
+			ValidationError: fmt.Sprintf("unable to read manifest file %q: %s", mm[0].Path, err),
+		}
 	}
 
 	w, ok := manifest.(entity.ManifestV1)
 	if ok {
 		w.Repository = repo
+		w.Path = mm[0].Path
 		w.Devices = devices
 		w.Namespaces = namespaces
 		w.Sets = sets
@@ -125,6 +158,7 @@ func parseManifest(mm []models.ManifestJoin, readFn manifest.ManifestReader) (en
 	c, ok := manifest.(entity.Configuration)
 	if ok {
 		c.Repository = repo
+		c.Path = mm[0].Path
 		c.Devices = devices
 		c.Namespaces = namespaces
 		c.Sets = sets
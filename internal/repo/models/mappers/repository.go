@@ -2,6 +2,7 @@ package mappers
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
@@ -15,6 +16,10 @@ func RepoEntityToModel(r entity.Repository) models.Repo {
 		PullPeriodSeconds: sql.NullInt64{Valid: true, Int64: int64(r.PullPeriod.Seconds())},
 	}
 
+	if !r.NextSyncAt.IsZero() {
+		m.NextSyncAt = sql.NullTime{Valid: true, Time: r.NextSyncAt}
+	}
+
 	if r.CurrentHeadSha != "" {
 		m.CurrentHeadSha = sql.NullString{Valid: true, String: r.CurrentHeadSha}
 	}
@@ -23,6 +28,14 @@ func RepoEntityToModel(r entity.Repository) models.Repo {
 		m.Branch = sql.NullString{Valid: true, String: r.Branch}
 	}
 
+	if r.TagPattern != "" {
+		m.TagPattern = sql.NullString{Valid: true, String: r.TagPattern}
+	}
+
+	if r.Commit != "" {
+		m.CommitSha = sql.NullString{Valid: true, String: r.Commit}
+	}
+
 	if r.LocalPath != "" {
 		m.LocalPath = sql.NullString{Valid: true, String: r.LocalPath}
 	}
@@ -46,6 +59,31 @@ func RepoEntityToModel(r entity.Repository) models.Repo {
 		m.AuthSecretPath = sql.NullString{Valid: true, String: r.CredentialsSecretPath}
 	}
 
+	if r.SigningKeysSecretPath != "" {
+		m.SigningKeysSecretPath = sql.NullString{Valid: true, String: r.SigningKeysSecretPath}
+	}
+
+	if r.RejectedHeadSha != "" {
+		m.RejectedHeadSha = sql.NullString{Valid: true, String: r.RejectedHeadSha}
+		m.RejectionReason = sql.NullString{Valid: true, String: r.RejectionReason}
+	}
+
+	if r.WebhookSecretPath != "" {
+		m.WebhookSecretPath = sql.NullString{Valid: true, String: r.WebhookSecretPath}
+	}
+
+	if r.Subpath != "" {
+		m.Subpath = sql.NullString{Valid: true, String: r.Subpath}
+	}
+
+	if len(r.IncludeGlobs) > 0 {
+		m.IncludeGlobs = sql.NullString{Valid: true, String: joinGlobs(r.IncludeGlobs)}
+	}
+
+	if len(r.ExcludeGlobs) > 0 {
+		m.ExcludeGlobs = sql.NullString{Valid: true, String: joinGlobs(r.ExcludeGlobs)}
+	}
+
 	return m
 }
 
@@ -53,10 +91,14 @@ func RepoModelToEntity(m models.Repo) entity.Repository {
 	e := entity.Repository{
 		Id:         m.ID,
 		Url:        m.URL,
-		PullPeriod: 20 * time.Second,
+		PullPeriod: entity.DefaultPullPeriod,
 		AuthType:   entity.NoRepositoryAuthType,
 	}
 
+	if m.NextSyncAt.Valid {
+		e.NextSyncAt = m.NextSyncAt.Time
+	}
+
 	if m.CurrentHeadSha.Valid {
 		e.CurrentHeadSha = m.CurrentHeadSha.String
 	}
@@ -73,6 +115,14 @@ func RepoModelToEntity(m models.Repo) entity.Repository {
 		e.Branch = m.Branch.String
 	}
 
+	if m.TagPattern.Valid {
+		e.TagPattern = m.TagPattern.String
+	}
+
+	if m.CommitSha.Valid {
+		e.Commit = m.CommitSha.String
+	}
+
 	if m.TargetHeadSha.Valid {
 		e.TargetHeadSha = m.TargetHeadSha.String
 	}
@@ -81,6 +131,26 @@ func RepoModelToEntity(m models.Repo) entity.Repository {
 		e.CredentialsSecretPath = m.AuthSecretPath.String
 	}
 
+	if m.SigningKeysSecretPath.Valid {
+		e.SigningKeysSecretPath = m.SigningKeysSecretPath.String
+	}
+
+	if m.RejectedHeadSha.Valid {
+		e.RejectedHeadSha = m.RejectedHeadSha.String
+	}
+
+	if m.RejectionReason.Valid {
+		e.RejectionReason = m.RejectionReason.String
+	}
+
+	if m.WebhookSecretPath.Valid {
+		e.WebhookSecretPath = m.WebhookSecretPath.String
+	}
+
+	e.Subpath = m.Subpath.String
+	e.IncludeGlobs = splitGlobs(m.IncludeGlobs.String)
+	e.ExcludeGlobs = splitGlobs(m.ExcludeGlobs.String)
+
 	if m.AuthType.Valid {
 		switch m.AuthType.String {
 		case "ssh":
@@ -96,3 +166,15 @@ func RepoModelToEntity(m models.Repo) entity.Repository {
 
 	return e
 }
+
+// the globs are saved in a single column, one glob per line.
+func joinGlobs(globs []string) string {
+	return strings.Join(globs, "\n")
+}
+
+func splitGlobs(globs string) []string {
+	if globs == "" {
+		return nil
+	}
+	return strings.Split(globs, "\n")
+}
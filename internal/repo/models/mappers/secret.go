@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"fmt"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	models "github.com/tupyy/tinyedge-controller/internal/repo/models/pg"
+)
+
+// SecretID returns the id of the secret row. A secret is identified by its path and key in Vault.
+func SecretID(path, key string) string {
+	return fmt.Sprintf("%s:%s", path, key)
+}
+
+// ManifestSecretsToModels returns the secrets of the manifest and their relations with it.
+// The hashes are empty until the secrets are read from Vault.
+func ManifestSecretsToModels(manifest entity.ManifestV1) ([]models.Secret, []models.SecretsManifests) {
+	secrets := make([]models.Secret, 0, len(manifest.Secrets))
+	relations := make([]models.SecretsManifests, 0, len(manifest.Secrets))
+	seen := make(map[string]struct{})
+	for _, s := range manifest.Secrets {
+		id := SecretID(s.Path, s.Key)
+		if _, found := seen[id]; found {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		secrets = append(secrets, models.Secret{
+			ID:   id,
+			Path: s.Path,
+			Key:  s.Key,
+		})
+		relations = append(relations, models.SecretsManifests{
+			SecretID:   id,
+			ManifestID: manifest.GetID(),
+		})
+	}
+	return secrets, relations
+}
+
+func SecretsToEntities(joins []models.SecretJoin) []entity.SecretReference {
+	secrets := make([]entity.SecretReference, 0, len(joins))
+	index := make(map[string]int)
+	for _, j := range joins {
+		i, found := index[j.ID]
+		if !found {
+			secrets = append(secrets, entity.SecretReference{
+				ID:          j.ID,
+				Path:        j.Path,
+				Key:         j.Key,
+				CurrentHash: j.CurrentHash,
+				TargetHash:  j.TargetHash,
+				Manifests:   []string{},
+			})
+			i = len(secrets) - 1
+			index[j.ID] = i
+		}
+		if j.ManifestID != "" {
+			secrets[i].Manifests = append(secrets[i].Manifests, j.ManifestID)
+		}
+	}
+	return secrets
+}
+
+func SecretEntityToModel(secret entity.SecretReference) models.Secret {
+	return models.Secret{
+		ID:          secret.ID,
+		Path:        secret.Path,
+		Key:         secret.Key,
+		CurrentHash: secret.CurrentHash,
+		TargetHash:  secret.TargetHash,
+	}
+}
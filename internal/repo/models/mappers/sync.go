@@ -0,0 +1,67 @@
+package mappers
+
+import (
+	"database/sql"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	models "github.com/tupyy/tinyedge-controller/internal/repo/models/pg"
+)
+
+func SyncRunEntityToModel(s entity.SyncRun) (models.RepoSync, []models.RepoSyncFileError) {
+	m := models.RepoSync{
+		ID:               s.ID,
+		RepoID:           s.RepositoryID,
+		StartedAt:        s.StartedAt,
+		FinishedAt:       s.FinishedAt,
+		ManifestsCreated: int32(s.Manifests.Created),
+		ManifestsUpdated: int32(s.Manifests.Updated),
+		ManifestsDeleted: int32(s.Manifests.Deleted),
+	}
+
+	if s.FromSha != "" {
+		m.FromSha = sql.NullString{Valid: true, String: s.FromSha}
+	}
+
+	if s.ToSha != "" {
+		m.ToSha = sql.NullString{Valid: true, String: s.ToSha}
+	}
+
+	if s.Error != "" {
+		m.Error = sql.NullString{Valid: true, String: s.Error}
+	}
+
+	fileErrors := make([]models.RepoSyncFileError, 0, len(s.Manifests.FileErrors))
+	for _, f := range s.Manifests.FileErrors {
+		fileErrors = append(fileErrors, models.RepoSyncFileError{
+			SyncID: s.ID,
+			Path:   f.Path,
+			Error:  f.Reason,
+		})
+	}
+
+	return m, fileErrors
+}
+
+func SyncRunModelToEntity(m models.RepoSync, fileErrors []models.RepoSyncFileError) entity.SyncRun {
+	s := entity.SyncRun{
+		ID:           m.ID,
+		RepositoryID: m.RepoID,
+		StartedAt:    m.StartedAt,
+		FinishedAt:   m.FinishedAt,
+		FromSha:      m.FromSha.String,
+		ToSha:        m.ToSha.String,
+		Manifests: entity.ManifestChanges{
+			Created:    int(m.ManifestsCreated),
+			Updated:    int(m.ManifestsUpdated),
+			Deleted:    int(m.ManifestsDeleted),
+			FileErrors: make([]entity.ManifestFileError, 0, len(fileErrors)),
+		},
+		Error: m.Error.String,
+	}
+
+	for _, f := range fileErrors {
+		s.Manifests.FileErrors = append(s.Manifests.FileErrors, entity.ManifestFileError{Path: f.Path, Reason: f.Error})
+	}
+
+	return s
+}
@@ -0,0 +1,45 @@
+package mappers
+
+import (
+	"database/sql"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	models "github.com/tupyy/tinyedge-controller/internal/repo/models/pg"
+)
+
+func EnrolmentTokenEntityToModel(t entity.EnrolmentToken) models.EnrolmentToken {
+	m := models.EnrolmentToken{
+		ID:          t.ID,
+		TokenHash:   t.Hash,
+		NamespaceID: t.NamespaceID,
+		MaxUses:     int32(t.MaxUses),
+		Uses:        int32(t.Uses),
+		CreatedAt:   t.CreatedAt,
+		ExpiresAt:   t.ExpiresAt,
+	}
+
+	if t.SetID != nil {
+		m.DeviceSetID = sql.NullString{Valid: true, String: *t.SetID}
+	}
+
+	return m
+}
+
+func EnrolmentTokenModelToEntity(m models.EnrolmentToken) entity.EnrolmentToken {
+	t := entity.EnrolmentToken{
+		ID:          m.ID,
+		Hash:        m.TokenHash,
+		NamespaceID: m.NamespaceID,
+		MaxUses:     int(m.MaxUses),
+		Uses:        int(m.Uses),
+		CreatedAt:   m.CreatedAt,
+		ExpiresAt:   m.ExpiresAt,
+	}
+
+	if m.DeviceSetID.Valid {
+		setID := m.DeviceSetID.String
+		t.SetID = &setID
+	}
+
+	return t
+}
@@ -0,0 +1,66 @@
+package mappers
+
+import (
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	models "github.com/tupyy/tinyedge-controller/internal/repo/models/pg"
+)
+
+// VariablesToModel returns the rows of the variables table of the target.
+func VariablesToModel(target entity.VariablesTarget, id string, variables map[string]string) interface{} {
+	switch target {
+	case entity.DeviceVariablesTarget:
+		m := make([]models.DeviceVariables, 0, len(variables))
+		for k, v := range variables {
+			m = append(m, models.DeviceVariables{DeviceID: id, Key: k, Value: v})
+		}
+		return m
+	case entity.SetVariablesTarget:
+		m := make([]models.SetVariables, 0, len(variables))
+		for k, v := range variables {
+			m = append(m, models.SetVariables{DeviceSetID: id, Key: k, Value: v})
+		}
+		return m
+	default:
+		m := make([]models.NamespaceVariables, 0, len(variables))
+		for k, v := range variables {
+			m = append(m, models.NamespaceVariables{NamespaceID: id, Key: k, Value: v})
+		}
+		return m
+	}
+}
+
+// DeviceVariablesToEntity returns the variables grouped by device id.
+func DeviceVariablesToEntity(variables []models.DeviceVariables) map[string]map[string]string {
+	e := make(map[string]map[string]string)
+	for _, v := range variables {
+		addVariable(e, v.DeviceID, v.Key, v.Value)
+	}
+	return e
+}
+
+// SetVariablesToEntity returns the variables grouped by set id.
+func SetVariablesToEntity(variables []models.SetVariables) map[string]map[string]string {
+	e := make(map[string]map[string]string)
+	for _, v := range variables {
+		addVariable(e, v.DeviceSetID, v.Key, v.Value)
+	}
+	return e
+}
+
+// NamespaceVariablesToEntity returns the variables grouped by namespace id.
+func NamespaceVariablesToEntity(variables []models.NamespaceVariables) map[string]map[string]string {
+	e := make(map[string]map[string]string)
+	for _, v := range variables {
+		addVariable(e, v.NamespaceID, v.Key, v.Value)
+	}
+	return e
+}
+
+func addVariable(e map[string]map[string]string, id, key, value string) {
+	variables, ok := e[id]
+	if !ok {
+		variables = make(map[string]string)
+		e[id] = variables
+	}
+	variables[key] = value
+}
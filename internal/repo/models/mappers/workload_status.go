@@ -0,0 +1,44 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	models "github.com/tupyy/tinyedge-controller/internal/repo/models/pg"
+)
+
+// WorkloadStatusesToModel returns one status per workload. If a workload is reported more than once, its last status is kept.
+func WorkloadStatusesToModel(deviceID string, reportedAt time.Time, statuses []entity.WorkloadStatus) []models.WorkloadStatus {
+	m := make([]models.WorkloadStatus, 0, len(statuses))
+	indexes := make(map[string]int, len(statuses))
+	for _, s := range statuses {
+		status := models.WorkloadStatus{
+			DeviceID:    deviceID,
+			ManifestID:  s.Name,
+			State:       s.State.String(),
+			LastUpdated: s.LastUpdated,
+			ReportedAt:  reportedAt,
+		}
+		if i, ok := indexes[s.Name]; ok {
+			m[i] = status
+			continue
+		}
+		indexes[s.Name] = len(m)
+		m = append(m, status)
+	}
+	return m
+}
+
+func WorkloadStatusesToEntity(statuses []models.WorkloadStatus) []entity.WorkloadDeployment {
+	e := make([]entity.WorkloadDeployment, 0, len(statuses))
+	for _, s := range statuses {
+		e = append(e, entity.WorkloadDeployment{
+			DeviceID:    s.DeviceID,
+			ManifestID:  s.ManifestID,
+			State:       entity.DeployingWorkloadState.FromString(s.State),
+			LastUpdated: s.LastUpdated,
+			ReportedAt:  s.ReportedAt,
+		})
+	}
+	return e
+}
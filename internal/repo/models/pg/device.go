@@ -29,11 +29,16 @@ Table: device
 [ 5] certificate_sn                                 TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 [ 6] namespace_id                                   VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
 [ 7] device_set_id                                  VARCHAR(255)         null: true   primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 8] state                                          VARCHAR(20)          null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 20      default: [offline]
+[ 9] last_seen                                      TIMESTAMP            null: true   primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+[10] uptime_seconds                                 INT8                 null: true   primary: false  isArray: false  auto: false  col: INT8            len: -1      default: []
+[11] configuration_hash                             TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[12] decommissioned_at                              TIMESTAMP            null: true   primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
 
 
 JSON Sample
 -------------------------------------
-{    "id": "tkIDgkMxaysYXlhOJJRXKSeUe",    "enroled_at": "2075-07-04T04:15:24.943481996+02:00",    "registered_at": "2267-05-12T21:49:45.30072072+02:00",    "enroled": "STNJpkLStNlboPukBMlAfKnOA",    "registered": true,    "certificate_sn": "oLVKWlmuyZuaefbONXFfejApV",    "namespace_id": "fmVPVvdlclZhnkXFPWuuYGEvH",    "device_set_id": "bgIKUYcNRKyCoJQxqVgdkacBJ"}
+{    "id": "tkIDgkMxaysYXlhOJJRXKSeUe",    "enroled_at": "2075-07-04T04:15:24.943481996+02:00",    "registered_at": "2267-05-12T21:49:45.30072072+02:00",    "enroled": "STNJpkLStNlboPukBMlAfKnOA",    "registered": true,    "certificate_sn": "oLVKWlmuyZuaefbONXFfejApV",    "namespace_id": "fmVPVvdlclZhnkXFPWuuYGEvH",    "device_set_id": "bgIKUYcNRKyCoJQxqVgdkacBJ",    "state": "wnwtYgiqBOMSQSsqlVHvmgrAv",    "last_seen": "2223-04-11T22:00:43.558705169+02:00",    "uptime_seconds": 45,    "configuration_hash": "LInMaFHaLtIXOKflxZWCfrHKT",    "decommissioned_at": "2140-04-12T09:50:06.135159339+02:00"}
 
 
 
@@ -57,6 +62,16 @@ type Device struct {
 	NamespaceID string `gorm:"column:namespace_id;type:VARCHAR;size:255;"`
 	//[ 7] device_set_id                                  VARCHAR(255)         null: true   primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
 	DeviceSetID sql.NullString `gorm:"column:device_set_id;type:VARCHAR;size:255;"`
+	//[ 8] state                                          VARCHAR(20)          null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 20      default: [offline]
+	State string `gorm:"column:state;type:VARCHAR;size:20;default:offline;"`
+	//[ 9] last_seen                                      TIMESTAMP            null: true   primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	LastSeen time.Time `gorm:"column:last_seen;type:TIMESTAMP;"`
+	//[10] uptime_seconds                                 INT8                 null: true   primary: false  isArray: false  auto: false  col: INT8            len: -1      default: []
+	UptimeSeconds sql.NullInt64 `gorm:"column:uptime_seconds;type:INT8;"`
+	//[11] configuration_hash                             TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	ConfigurationHash sql.NullString `gorm:"column:configuration_hash;type:TEXT;"`
+	//[12] decommissioned_at                              TIMESTAMP            null: true   primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	DecommissionedAt time.Time `gorm:"column:decommissioned_at;type:TIMESTAMP;"`
 }
 
 var deviceTableInfo = &TableInfo{
@@ -230,6 +245,111 @@ var deviceTableInfo = &TableInfo{
 			ProtobufType:       "string",
 			ProtobufPos:        8,
 		},
+
+		&ColumnInfo{
+			Index:              8,
+			Name:               "state",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(20)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       20,
+			GoFieldName:        "State",
+			GoFieldType:        "string",
+			JSONFieldName:      "state",
+			ProtobufFieldName:  "state",
+			ProtobufType:       "string",
+			ProtobufPos:        9,
+		},
+
+		&ColumnInfo{
+			Index:              9,
+			Name:               "last_seen",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "LastSeen",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "last_seen",
+			ProtobufFieldName:  "last_seen",
+			ProtobufType:       "uint64",
+			ProtobufPos:        10,
+		},
+
+		&ColumnInfo{
+			Index:              10,
+			Name:               "uptime_seconds",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "INT8",
+			DatabaseTypePretty: "INT8",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "INT8",
+			ColumnLength:       -1,
+			GoFieldName:        "UptimeSeconds",
+			GoFieldType:        "sql.NullInt64",
+			JSONFieldName:      "uptime_seconds",
+			ProtobufFieldName:  "uptime_seconds",
+			ProtobufType:       "int64",
+			ProtobufPos:        11,
+		},
+
+		&ColumnInfo{
+			Index:              11,
+			Name:               "configuration_hash",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "ConfigurationHash",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "configuration_hash",
+			ProtobufFieldName:  "configuration_hash",
+			ProtobufType:       "string",
+			ProtobufPos:        12,
+		},
+
+		&ColumnInfo{
+			Index:              12,
+			Name:               "decommissioned_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "DecommissionedAt",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "decommissioned_at",
+			ProtobufFieldName:  "decommissioned_at",
+			ProtobufType:       "uint64",
+			ProtobufPos:        13,
+		},
 	},
 }
 
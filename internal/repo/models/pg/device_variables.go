@@ -0,0 +1,138 @@
+package pg
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/satori/go.uuid"
+)
+
+var (
+	_ = time.Second
+	_ = sql.LevelDefault
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+DB Table Details
+-------------------------------------
+
+
+Table: device_variables
+[ 0] device_id                                      VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 1] key                                            VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 2] value                                          TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+
+
+JSON Sample
+-------------------------------------
+{    "device_id": "aGcNIQBCoHVZDLoevuIRoIcZC",    "key": "ZQJiqqYhOmAwnLRyMwiIEaQQf",    "value": "ZTzUWrDmXCXetTEjuvJkYQrMU"}
+
+
+
+*/
+
+// DeviceVariables struct is a row record of the device_variables table in the tinyedge database
+type DeviceVariables struct {
+	//[ 0] device_id                                      VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	DeviceID string `gorm:"primary_key;column:device_id;type:VARCHAR;size:255;"`
+	//[ 1] key                                            VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	Key string `gorm:"primary_key;column:key;type:VARCHAR;size:255;"`
+	//[ 2] value                                          TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	Value string `gorm:"column:value;type:TEXT;"`
+}
+
+var device_variablesTableInfo = &TableInfo{
+	Name: "device_variables",
+	Columns: []*ColumnInfo{
+
+		&ColumnInfo{
+			Index:              0,
+			Name:               "device_id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "DeviceID",
+			GoFieldType:        "string",
+			JSONFieldName:      "device_id",
+			ProtobufFieldName:  "device_id",
+			ProtobufType:       "string",
+			ProtobufPos:        1,
+		},
+
+		&ColumnInfo{
+			Index:              1,
+			Name:               "key",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "Key",
+			GoFieldType:        "string",
+			JSONFieldName:      "key",
+			ProtobufFieldName:  "key",
+			ProtobufType:       "string",
+			ProtobufPos:        2,
+		},
+
+		&ColumnInfo{
+			Index:              2,
+			Name:               "value",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "Value",
+			GoFieldType:        "string",
+			JSONFieldName:      "value",
+			ProtobufFieldName:  "value",
+			ProtobufType:       "string",
+			ProtobufPos:        3,
+		},
+	},
+}
+
+// TableName sets the insert table name for this struct type
+func (d *DeviceVariables) TableName() string {
+	return "device_variables"
+}
+
+// BeforeSave invoked before saving, return an error if field is not populated.
+func (d *DeviceVariables) BeforeSave() error {
+	return nil
+}
+
+// Prepare invoked before saving, can be used to populate fields etc.
+func (d *DeviceVariables) Prepare() {
+}
+
+// Validate invoked before performing action, return an error if field is not populated.
+func (d *DeviceVariables) Validate(action Action) error {
+	return nil
+}
+
+// TableInfo return table meta data
+func (d *DeviceVariables) TableInfo() *TableInfo {
+	return device_variablesTableInfo
+}
@@ -0,0 +1,258 @@
+package pg
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/satori/go.uuid"
+)
+
+var (
+	_ = time.Second
+	_ = sql.LevelDefault
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+DB Table Details
+-------------------------------------
+
+
+Table: enrolment_token
+[ 0] id                                             VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 1] token_hash                                     TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[ 2] namespace_id                                   VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 3] device_set_id                                  VARCHAR(255)         null: true   primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 4] max_uses                                       INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [1]
+[ 5] uses                                           INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [0]
+[ 6] created_at                                     TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+[ 7] expires_at                                     TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+
+
+JSON Sample
+-------------------------------------
+{    "id": "MXVgPQIJuUCAlHsvqkSsroOUW",    "token_hash": "eBlafNONbmrfmgMOBZmBBhLmo",    "namespace_id": "joMfgryOvLwNiIXVmLIExbKKz",    "device_set_id": "VZyJepRukjAicdFgagkDkUbfQ",    "max_uses": 45,    "uses": 41,    "created_at": "2062-05-23T17:37:08.270360267+02:00",    "expires_at": "2059-05-22T13:40:52.956060146+02:00"}
+
+
+
+*/
+
+// EnrolmentToken struct is a row record of the enrolment_token table in the tinyedge database
+type EnrolmentToken struct {
+	//[ 0] id                                             VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	ID string `gorm:"primary_key;column:id;type:VARCHAR;size:255;"`
+	//[ 1] token_hash                                     TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	TokenHash string `gorm:"column:token_hash;type:TEXT;"`
+	//[ 2] namespace_id                                   VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	NamespaceID string `gorm:"column:namespace_id;type:VARCHAR;size:255;"`
+	//[ 3] device_set_id                                  VARCHAR(255)         null: true   primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	DeviceSetID sql.NullString `gorm:"column:device_set_id;type:VARCHAR;size:255;"`
+	//[ 4] max_uses                                       INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [1]
+	MaxUses int32 `gorm:"column:max_uses;type:INT4;default:1;"`
+	//[ 5] uses                                           INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [0]
+	Uses int32 `gorm:"column:uses;type:INT4;default:0;"`
+	//[ 6] created_at                                     TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	CreatedAt time.Time `gorm:"column:created_at;type:TIMESTAMP;"`
+	//[ 7] expires_at                                     TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	ExpiresAt time.Time `gorm:"column:expires_at;type:TIMESTAMP;"`
+}
+
+var enrolment_tokenTableInfo = &TableInfo{
+	Name: "enrolment_token",
+	Columns: []*ColumnInfo{
+
+		&ColumnInfo{
+			Index:              0,
+			Name:               "id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "ID",
+			GoFieldType:        "string",
+			JSONFieldName:      "id",
+			ProtobufFieldName:  "id",
+			ProtobufType:       "string",
+			ProtobufPos:        1,
+		},
+
+		&ColumnInfo{
+			Index:              1,
+			Name:               "token_hash",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "TokenHash",
+			GoFieldType:        "string",
+			JSONFieldName:      "token_hash",
+			ProtobufFieldName:  "token_hash",
+			ProtobufType:       "string",
+			ProtobufPos:        2,
+		},
+
+		&ColumnInfo{
+			Index:              2,
+			Name:               "namespace_id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "NamespaceID",
+			GoFieldType:        "string",
+			JSONFieldName:      "namespace_id",
+			ProtobufFieldName:  "namespace_id",
+			ProtobufType:       "string",
+			ProtobufPos:        3,
+		},
+
+		&ColumnInfo{
+			Index:              3,
+			Name:               "device_set_id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "DeviceSetID",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "device_set_id",
+			ProtobufFieldName:  "device_set_id",
+			ProtobufType:       "string",
+			ProtobufPos:        4,
+		},
+
+		&ColumnInfo{
+			Index:              4,
+			Name:               "max_uses",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "INT4",
+			DatabaseTypePretty: "INT4",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "INT4",
+			ColumnLength:       -1,
+			GoFieldName:        "MaxUses",
+			GoFieldType:        "int32",
+			JSONFieldName:      "max_uses",
+			ProtobufFieldName:  "max_uses",
+			ProtobufType:       "int32",
+			ProtobufPos:        5,
+		},
+
+		&ColumnInfo{
+			Index:              5,
+			Name:               "uses",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "INT4",
+			DatabaseTypePretty: "INT4",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "INT4",
+			ColumnLength:       -1,
+			GoFieldName:        "Uses",
+			GoFieldType:        "int32",
+			JSONFieldName:      "uses",
+			ProtobufFieldName:  "uses",
+			ProtobufType:       "int32",
+			ProtobufPos:        6,
+		},
+
+		&ColumnInfo{
+			Index:              6,
+			Name:               "created_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "CreatedAt",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "created_at",
+			ProtobufFieldName:  "created_at",
+			ProtobufType:       "uint64",
+			ProtobufPos:        7,
+		},
+
+		&ColumnInfo{
+			Index:              7,
+			Name:               "expires_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "ExpiresAt",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "expires_at",
+			ProtobufFieldName:  "expires_at",
+			ProtobufType:       "uint64",
+			ProtobufPos:        8,
+		},
+	},
+}
+
+// TableName sets the insert table name for this struct type
+func (e *EnrolmentToken) TableName() string {
+	return "enrolment_token"
+}
+
+// BeforeSave invoked before saving, return an error if field is not populated.
+func (e *EnrolmentToken) BeforeSave() error {
+	return nil
+}
+
+// Prepare invoked before saving, can be used to populate fields etc.
+func (e *EnrolmentToken) Prepare() {
+}
+
+// Validate invoked before performing action, return an error if field is not populated.
+func (e *EnrolmentToken) Validate(action Action) error {
+	return nil
+}
+
+// TableInfo return table meta data
+func (e *EnrolmentToken) TableInfo() *TableInfo {
+	return enrolment_tokenTableInfo
+}
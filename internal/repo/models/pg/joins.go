@@ -6,35 +6,44 @@ import (
 
 type DeviceJoin struct {
 	Device
-	ConfigurationID        string `gorm:"column:conf_id;type:TEXT"`
-	ConfigurationPath      string `gorm:"column:conf_path;type:TEXT"`
-	ConfigurationLocalPath string `gorm:"column:conf_local_path;type:TEXT"`
-	WorkloadID             string `gorm:"column:workload_id;type:TEXT"`
-	WorkloadRepoLocalPath  string `gorm:"column:workload_repo_local_path;type:TEXT"`
-	WorkloadPath           string `gorm:"column:workload_path;type:TEXT"`
+	ConfigurationID          string `gorm:"column:conf_id;type:TEXT"`
+	ConfigurationPath        string `gorm:"column:conf_path;type:TEXT"`
+	ConfigurationLocalPath   string `gorm:"column:conf_local_path;type:TEXT"`
+	WorkloadID               string `gorm:"column:workload_id;type:TEXT"`
+	WorkloadRepoLocalPath    string `gorm:"column:workload_repo_local_path;type:TEXT"`
+	WorkloadRepoSubpath      string `gorm:"column:workload_repo_subpath;type:TEXT"`
+	WorkloadRepoIncludeGlobs string `gorm:"column:workload_repo_include_globs;type:TEXT"`
+	WorkloadRepoExcludeGlobs string `gorm:"column:workload_repo_exclude_globs;type:TEXT"`
+	WorkloadPath             string `gorm:"column:workload_path;type:TEXT"`
 }
 
 type SetJoin struct {
 	DeviceSet
-	DeviceId               string `gorm:"column:device_id;type:TEXT"`
-	ConfigurationID        string `gorm:"column:conf_id;type:TEXT"`
-	ConfigurationPath      string `gorm:"column:conf_path;type:TEXT"`
-	ConfigurationLocalPath string `gorm:"column:conf_local_path;type:TEXT"`
-	WorkloadID             string `gorm:"column:workload_id;type:TEXT"`
-	WorkloadRepoLocalPath  string `gorm:"column:workload_repo_local_path;type:TEXT"`
-	WorkloadPath           string `gorm:"column:workload_path;type:TEXT"`
+	DeviceId                 string `gorm:"column:device_id;type:TEXT"`
+	ConfigurationID          string `gorm:"column:conf_id;type:TEXT"`
+	ConfigurationPath        string `gorm:"column:conf_path;type:TEXT"`
+	ConfigurationLocalPath   string `gorm:"column:conf_local_path;type:TEXT"`
+	WorkloadID               string `gorm:"column:workload_id;type:TEXT"`
+	WorkloadRepoLocalPath    string `gorm:"column:workload_repo_local_path;type:TEXT"`
+	WorkloadRepoSubpath      string `gorm:"column:workload_repo_subpath;type:TEXT"`
+	WorkloadRepoIncludeGlobs string `gorm:"column:workload_repo_include_globs;type:TEXT"`
+	WorkloadRepoExcludeGlobs string `gorm:"column:workload_repo_exclude_globs;type:TEXT"`
+	WorkloadPath             string `gorm:"column:workload_path;type:TEXT"`
 }
 
 type NamespaceJoin struct {
 	Namespace
-	DeviceId               string `gorm:"column:device_id;type:TEXT"`
-	SetId                  string `gorm:"column:set_id;type:TEXT"`
-	ConfigurationID        string `gorm:"column:conf_id;type:TEXT"`
-	ConfigurationPath      string `gorm:"column:conf_path;type:TEXT"`
-	ConfigurationLocalPath string `gorm:"column:conf_local_path;type:TEXT"`
-	WorkloadID             string `gorm:"column:workload_id;type:TEXT"`
-	WorkloadRepoLocalPath  string `gorm:"column:workload_repo_local_path;type:TEXT"`
-	WorkloadPath           string `gorm:"column:workload_path;type:TEXT"`
+	DeviceId                 string `gorm:"column:device_id;type:TEXT"`
+	SetId                    string `gorm:"column:set_id;type:TEXT"`
+	ConfigurationID          string `gorm:"column:conf_id;type:TEXT"`
+	ConfigurationPath        string `gorm:"column:conf_path;type:TEXT"`
+	ConfigurationLocalPath   string `gorm:"column:conf_local_path;type:TEXT"`
+	WorkloadID               string `gorm:"column:workload_id;type:TEXT"`
+	WorkloadRepoLocalPath    string `gorm:"column:workload_repo_local_path;type:TEXT"`
+	WorkloadRepoSubpath      string `gorm:"column:workload_repo_subpath;type:TEXT"`
+	WorkloadRepoIncludeGlobs string `gorm:"column:workload_repo_include_globs;type:TEXT"`
+	WorkloadRepoExcludeGlobs string `gorm:"column:workload_repo_exclude_globs;type:TEXT"`
+	WorkloadPath             string `gorm:"column:workload_path;type:TEXT"`
 }
 
 type ManifestJoin struct {
@@ -46,9 +55,17 @@ type ManifestJoin struct {
 	RepoURL               string         `gorm:"column:repo_url;type:TEXT;"`
 	RepoBranch            sql.NullString `gorm:"column:repo_branch;type:TEXT;"`
 	RepoLocalPath         sql.NullString `gorm:"column:repo_local_path;type:TEXT;"`
+	RepoSubpath           sql.NullString `gorm:"column:repo_subpath;type:TEXT;"`
+	RepoIncludeGlobs      sql.NullString `gorm:"column:repo_include_globs;type:TEXT;"`
+	RepoExcludeGlobs      sql.NullString `gorm:"column:repo_exclude_globs;type:TEXT;"`
 	RepoAuth              sql.NullString `gorm:"column:repo_auth_type;type:TEXT;"`
 	RepoAuthSecret        sql.NullString `gorm:"column:repo_auth_secret_path;type:TEXT;"`
 	RepoCurrentHeadSha    sql.NullString `gorm:"column:repo_current_head_sha;type:TEXT;"`
 	RepoTargetHeadSha     sql.NullString `gorm:"column:repo_target_head_sha;type:TEXT;"`
 	RepoPullPeriodSeconds sql.NullInt64  `gorm:"column:repo_pull_period_seconds;type:INT2;default:20;"`
 }
+
+type SecretJoin struct {
+	Secret
+	ManifestID string `gorm:"column:manifest_id;type:TEXT"`
+}
@@ -25,11 +25,12 @@ Table: manifest
 [ 1] version                                        VARCHAR(30)          null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 30      default: []
 [ 2] repo_id                                        VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
 [ 3] path                                           TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[ 4] label_selector                                 TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 
 
 JSON Sample
 -------------------------------------
-{    "id": "hXiyjXhJDXOKlotoiaEtAsVLY",    "version": "wcDKIyvUhLEZZthKtpEpAwWBu",    "repo_id": "rQeFyuUZaibZXGJRquBrvxCAw",    "path": "ryYaRrUQiVXvdAUkKkCBgKlbe"}
+{    "id": "hXiyjXhJDXOKlotoiaEtAsVLY",    "version": "wcDKIyvUhLEZZthKtpEpAwWBu",    "repo_id": "rQeFyuUZaibZXGJRquBrvxCAw",    "path": "ryYaRrUQiVXvdAUkKkCBgKlbe",    "label_selector": "LjsyxNNCFDCJfqavSmQmSTpQj"}
 
 
 
@@ -45,6 +46,8 @@ type Manifest struct {
 	RepoID string `gorm:"column:repo_id;type:VARCHAR;size:255;"`
 	//[ 3] path                                           TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 	Path string `gorm:"column:path;type:TEXT;"`
+	//[ 4] label_selector                                 TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	LabelSelector sql.NullString `gorm:"column:label_selector;type:TEXT;"`
 }
 
 var manifestTableInfo = &TableInfo{
@@ -134,6 +137,27 @@ var manifestTableInfo = &TableInfo{
 			ProtobufType:       "string",
 			ProtobufPos:        4,
 		},
+
+		&ColumnInfo{
+			Index:              4,
+			Name:               "label_selector",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "LabelSelector",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "label_selector",
+			ProtobufFieldName:  "label_selector",
+			ProtobufType:       "string",
+			ProtobufPos:        5,
+		},
 	},
 }
 
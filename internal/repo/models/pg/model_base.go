@@ -31,15 +31,21 @@ func init() {
 	tables = make(map[string]*TableInfo)
 
 	tables["device"] = deviceTableInfo
+	tables["device_labels"] = device_labelsTableInfo
 	tables["device_set"] = device_setTableInfo
+	tables["device_variables"] = device_variablesTableInfo
 	tables["devices_manifests"] = devices_manifestsTableInfo
+	tables["enrolment_token"] = enrolment_tokenTableInfo
 	tables["manifest"] = manifestTableInfo
 	tables["namespace"] = namespaceTableInfo
+	tables["namespace_variables"] = namespace_variablesTableInfo
 	tables["namespaces_manifests"] = namespaces_manifestsTableInfo
 	tables["repo"] = repoTableInfo
 	tables["secret"] = secretTableInfo
 	tables["secrets_manifests"] = secrets_manifestsTableInfo
+	tables["set_variables"] = set_variablesTableInfo
 	tables["sets_manifests"] = sets_manifestsTableInfo
+	tables["workload_status"] = workload_statusTableInfo
 }
 
 // String describe the action
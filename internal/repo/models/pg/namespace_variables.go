@@ -0,0 +1,138 @@
+package pg
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/satori/go.uuid"
+)
+
+var (
+	_ = time.Second
+	_ = sql.LevelDefault
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+DB Table Details
+-------------------------------------
+
+
+Table: namespace_variables
+[ 0] namespace_id                                   VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 1] key                                            VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 2] value                                          TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+
+
+JSON Sample
+-------------------------------------
+{    "namespace_id": "RWHhTwKmlqTVGjJKSdbunIlmi",    "key": "RcdOnRXHSMxGASKfWIrHyIFLP",    "value": "PeGxdGIhXDcqLJgvdPSygAWrg"}
+
+
+
+*/
+
+// NamespaceVariables struct is a row record of the namespace_variables table in the tinyedge database
+type NamespaceVariables struct {
+	//[ 0] namespace_id                                   VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	NamespaceID string `gorm:"primary_key;column:namespace_id;type:VARCHAR;size:255;"`
+	//[ 1] key                                            VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	Key string `gorm:"primary_key;column:key;type:VARCHAR;size:255;"`
+	//[ 2] value                                          TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	Value string `gorm:"column:value;type:TEXT;"`
+}
+
+var namespace_variablesTableInfo = &TableInfo{
+	Name: "namespace_variables",
+	Columns: []*ColumnInfo{
+
+		&ColumnInfo{
+			Index:              0,
+			Name:               "namespace_id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "NamespaceID",
+			GoFieldType:        "string",
+			JSONFieldName:      "namespace_id",
+			ProtobufFieldName:  "namespace_id",
+			ProtobufType:       "string",
+			ProtobufPos:        1,
+		},
+
+		&ColumnInfo{
+			Index:              1,
+			Name:               "key",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "Key",
+			GoFieldType:        "string",
+			JSONFieldName:      "key",
+			ProtobufFieldName:  "key",
+			ProtobufType:       "string",
+			ProtobufPos:        2,
+		},
+
+		&ColumnInfo{
+			Index:              2,
+			Name:               "value",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "Value",
+			GoFieldType:        "string",
+			JSONFieldName:      "value",
+			ProtobufFieldName:  "value",
+			ProtobufType:       "string",
+			ProtobufPos:        3,
+		},
+	},
+}
+
+// TableName sets the insert table name for this struct type
+func (n *NamespaceVariables) TableName() string {
+	return "namespace_variables"
+}
+
+// BeforeSave invoked before saving, return an error if field is not populated.
+func (n *NamespaceVariables) BeforeSave() error {
+	return nil
+}
+
+// Prepare invoked before saving, can be used to populate fields etc.
+func (n *NamespaceVariables) Prepare() {
+}
+
+// Validate invoked before performing action, return an error if field is not populated.
+func (n *NamespaceVariables) Validate(action Action) error {
+	return nil
+}
+
+// TableInfo return table meta data
+func (n *NamespaceVariables) TableInfo() *TableInfo {
+	return namespace_variablesTableInfo
+}
@@ -30,11 +30,21 @@ Table: repo
 [ 6] current_head_sha                               TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 [ 7] target_head_sha                                TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 [ 8] pull_period_seconds                            INT2                 null: true   primary: false  isArray: false  auto: false  col: INT2            len: -1      default: [20]
+[ 9] webhook_secret_path                            TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[10] next_sync_at                                   TIMESTAMP            null: true   primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+[11] tag_pattern                                    TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[12] commit_sha                                     TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[13] signing_keys_secret_path                       TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[14] rejected_head_sha                              TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[15] rejection_reason                               TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[16] subpath                                        TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[17] include_globs                                  TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[18] exclude_globs                                  TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 
 
 JSON Sample
 -------------------------------------
-{    "id": "uwrofQrMLwSuAUmbaUwjTnqrP",    "url": "KZqVCrNfpibaoMhIgYeKvBtHZ",    "branch": "UfZVADvnLHgcXedZbCQbBEnYh",    "local_path": "LhHXKSWFeIoGxVLAdCBmQnxpZ",    "auth_type": "GvdrbDyqQyekJjwsuQVvkCdBf",    "auth_secret_path": "jfUfymMBfZLcyCQrMkXvXGlRw",    "current_head_sha": "hKhDQOpBwEiFurmWVGplvlknG",    "target_head_sha": "rnRYqfrydIveffMKABxACpUFu",    "pull_period_seconds": 62}
+{    "id": "uwrofQrMLwSuAUmbaUwjTnqrP",    "url": "KZqVCrNfpibaoMhIgYeKvBtHZ",    "branch": "UfZVADvnLHgcXedZbCQbBEnYh",    "local_path": "LhHXKSWFeIoGxVLAdCBmQnxpZ",    "auth_type": "GvdrbDyqQyekJjwsuQVvkCdBf",    "auth_secret_path": "jfUfymMBfZLcyCQrMkXvXGlRw",    "current_head_sha": "hKhDQOpBwEiFurmWVGplvlknG",    "target_head_sha": "rnRYqfrydIveffMKABxACpUFu",    "pull_period_seconds": 62,    "webhook_secret_path": "TqIyoDUpYMfqdTrVOXbWxcfhg",    "next_sync_at": "2230-05-18T12:14:29.778948287+02:00",    "tag_pattern": "TqIyoDUpYMfqdTrVOXbWxcfhg",    "commit_sha": "TPHpEFoubdazzSVPsSuqJPgQr",    "signing_keys_secret_path": "TqIyoDUpYMfqdTrVOXbWxcfhg",    "rejected_head_sha": "TPHpEFoubdazzSVPsSuqJPgQr",    "rejection_reason": "MOhMbdYdOiGnTBFMcgUrMImYS",    "subpath": "TqIyoDUpYMfqdTrVOXbWxcfhg",    "include_globs": "TPHpEFoubdazzSVPsSuqJPgQr",    "exclude_globs": "MOhMbdYdOiGnTBFMcgUrMImYS"}
 
 
 
@@ -60,6 +70,26 @@ type Repo struct {
 	TargetHeadSha sql.NullString `gorm:"column:target_head_sha;type:TEXT;"`
 	//[ 8] pull_period_seconds                            INT2                 null: true   primary: false  isArray: false  auto: false  col: INT2            len: -1      default: [20]
 	PullPeriodSeconds sql.NullInt64 `gorm:"column:pull_period_seconds;type:INT2;default:20;"`
+	//[ 9] webhook_secret_path                            TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	WebhookSecretPath sql.NullString `gorm:"column:webhook_secret_path;type:TEXT;"`
+	//[10] next_sync_at                                   TIMESTAMP            null: true   primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	NextSyncAt sql.NullTime `gorm:"column:next_sync_at;type:TIMESTAMP;"`
+	//[11] tag_pattern                                    TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	TagPattern sql.NullString `gorm:"column:tag_pattern;type:TEXT;"`
+	//[12] commit_sha                                     TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	CommitSha sql.NullString `gorm:"column:commit_sha;type:TEXT;"`
+	//[13] signing_keys_secret_path                       TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	SigningKeysSecretPath sql.NullString `gorm:"column:signing_keys_secret_path;type:TEXT;"`
+	//[14] rejected_head_sha                              TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	RejectedHeadSha sql.NullString `gorm:"column:rejected_head_sha;type:TEXT;"`
+	//[15] rejection_reason                               TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	RejectionReason sql.NullString `gorm:"column:rejection_reason;type:TEXT;"`
+	//[16] subpath                                        TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	Subpath sql.NullString `gorm:"column:subpath;type:TEXT;"`
+	//[17] include_globs                                  TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	IncludeGlobs sql.NullString `gorm:"column:include_globs;type:TEXT;"`
+	//[18] exclude_globs                                  TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	ExcludeGlobs sql.NullString `gorm:"column:exclude_globs;type:TEXT;"`
 }
 
 var repoTableInfo = &TableInfo{
@@ -254,6 +284,216 @@ var repoTableInfo = &TableInfo{
 			ProtobufType:       "int32",
 			ProtobufPos:        9,
 		},
+
+		&ColumnInfo{
+			Index:              9,
+			Name:               "webhook_secret_path",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "WebhookSecretPath",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "webhook_secret_path",
+			ProtobufFieldName:  "webhook_secret_path",
+			ProtobufType:       "string",
+			ProtobufPos:        10,
+		},
+
+		&ColumnInfo{
+			Index:              10,
+			Name:               "next_sync_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "NextSyncAt",
+			GoFieldType:        "sql.NullTime",
+			JSONFieldName:      "next_sync_at",
+			ProtobufFieldName:  "next_sync_at",
+			ProtobufType:       "uint64",
+			ProtobufPos:        11,
+		},
+
+		&ColumnInfo{
+			Index:              11,
+			Name:               "tag_pattern",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "TagPattern",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "tag_pattern",
+			ProtobufFieldName:  "tag_pattern",
+			ProtobufType:       "string",
+			ProtobufPos:        12,
+		},
+
+		&ColumnInfo{
+			Index:              12,
+			Name:               "commit_sha",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "CommitSha",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "commit_sha",
+			ProtobufFieldName:  "commit_sha",
+			ProtobufType:       "string",
+			ProtobufPos:        13,
+		},
+
+		&ColumnInfo{
+			Index:              13,
+			Name:               "signing_keys_secret_path",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "SigningKeysSecretPath",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "signing_keys_secret_path",
+			ProtobufFieldName:  "signing_keys_secret_path",
+			ProtobufType:       "string",
+			ProtobufPos:        14,
+		},
+
+		&ColumnInfo{
+			Index:              14,
+			Name:               "rejected_head_sha",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "RejectedHeadSha",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "rejected_head_sha",
+			ProtobufFieldName:  "rejected_head_sha",
+			ProtobufType:       "string",
+			ProtobufPos:        15,
+		},
+
+		&ColumnInfo{
+			Index:              15,
+			Name:               "rejection_reason",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "RejectionReason",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "rejection_reason",
+			ProtobufFieldName:  "rejection_reason",
+			ProtobufType:       "string",
+			ProtobufPos:        16,
+		},
+
+		&ColumnInfo{
+			Index:              16,
+			Name:               "subpath",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "Subpath",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "subpath",
+			ProtobufFieldName:  "subpath",
+			ProtobufType:       "string",
+			ProtobufPos:        17,
+		},
+
+		&ColumnInfo{
+			Index:              17,
+			Name:               "include_globs",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "IncludeGlobs",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "include_globs",
+			ProtobufFieldName:  "include_globs",
+			ProtobufType:       "string",
+			ProtobufPos:        18,
+		},
+
+		&ColumnInfo{
+			Index:              18,
+			Name:               "exclude_globs",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "ExcludeGlobs",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "exclude_globs",
+			ProtobufFieldName:  "exclude_globs",
+			ProtobufType:       "string",
+			ProtobufPos:        19,
+		},
 	},
 }
 
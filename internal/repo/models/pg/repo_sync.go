@@ -0,0 +1,306 @@
+package pg
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/satori/go.uuid"
+)
+
+var (
+	_ = time.Second
+	_ = sql.LevelDefault
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+DB Table Details
+-------------------------------------
+
+
+Table: repo_sync
+[ 0] id                                             VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 1] repo_id                                        VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 2] started_at                                     TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+[ 3] finished_at                                    TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+[ 4] from_sha                                       TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[ 5] to_sha                                         TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[ 6] manifests_created                              INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [0]
+[ 7] manifests_updated                              INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [0]
+[ 8] manifests_deleted                              INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [0]
+[ 9] error                                          TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+
+
+JSON Sample
+-------------------------------------
+{    "id": "TvxFYxMXFYkiznRZeWHngmkKH",    "repo_id": "CUJRErDIyvyVfErYiUksQjJoe",    "started_at": "2251-04-15T08:26:10.793331386+02:00",    "finished_at": "2079-03-06T06:29:38.361525275+02:00",    "from_sha": "IdsCOdtOzuSlxAUyfOHYxnmRs",    "to_sha": "vCuCqEuepAptvrCslungvXRnM",    "manifests_created": 43,    "manifests_updated": 10,    "manifests_deleted": 48,    "error": "UpsixjWiYDcIMSMBdFtdTNcwQ"}
+
+
+
+*/
+
+// RepoSync struct is a row record of the repo_sync table in the tinyedge database
+type RepoSync struct {
+	//[ 0] id                                             VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	ID string `gorm:"primary_key;column:id;type:VARCHAR;size:255;"`
+	//[ 1] repo_id                                        VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	RepoID string `gorm:"column:repo_id;type:VARCHAR;size:255;"`
+	//[ 2] started_at                                     TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	StartedAt time.Time `gorm:"column:started_at;type:TIMESTAMP;"`
+	//[ 3] finished_at                                    TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	FinishedAt time.Time `gorm:"column:finished_at;type:TIMESTAMP;"`
+	//[ 4] from_sha                                       TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	FromSha sql.NullString `gorm:"column:from_sha;type:TEXT;"`
+	//[ 5] to_sha                                         TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	ToSha sql.NullString `gorm:"column:to_sha;type:TEXT;"`
+	//[ 6] manifests_created                              INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [0]
+	ManifestsCreated int32 `gorm:"column:manifests_created;type:INT4;default:0;"`
+	//[ 7] manifests_updated                              INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [0]
+	ManifestsUpdated int32 `gorm:"column:manifests_updated;type:INT4;default:0;"`
+	//[ 8] manifests_deleted                              INT4                 null: false  primary: false  isArray: false  auto: false  col: INT4            len: -1      default: [0]
+	ManifestsDeleted int32 `gorm:"column:manifests_deleted;type:INT4;default:0;"`
+	//[ 9] error                                          TEXT                 null: true   primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	Error sql.NullString `gorm:"column:error;type:TEXT;"`
+}
+
+var repo_syncTableInfo = &TableInfo{
+	Name: "repo_sync",
+	Columns: []*ColumnInfo{
+
+		&ColumnInfo{
+			Index:              0,
+			Name:               "id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "ID",
+			GoFieldType:        "string",
+			JSONFieldName:      "id",
+			ProtobufFieldName:  "id",
+			ProtobufType:       "string",
+			ProtobufPos:        1,
+		},
+
+		&ColumnInfo{
+			Index:              1,
+			Name:               "repo_id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "RepoID",
+			GoFieldType:        "string",
+			JSONFieldName:      "repo_id",
+			ProtobufFieldName:  "repo_id",
+			ProtobufType:       "string",
+			ProtobufPos:        2,
+		},
+
+		&ColumnInfo{
+			Index:              2,
+			Name:               "started_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "StartedAt",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "started_at",
+			ProtobufFieldName:  "started_at",
+			ProtobufType:       "uint64",
+			ProtobufPos:        3,
+		},
+
+		&ColumnInfo{
+			Index:              3,
+			Name:               "finished_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "FinishedAt",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "finished_at",
+			ProtobufFieldName:  "finished_at",
+			ProtobufType:       "uint64",
+			ProtobufPos:        4,
+		},
+
+		&ColumnInfo{
+			Index:              4,
+			Name:               "from_sha",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "FromSha",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "from_sha",
+			ProtobufFieldName:  "from_sha",
+			ProtobufType:       "string",
+			ProtobufPos:        5,
+		},
+
+		&ColumnInfo{
+			Index:              5,
+			Name:               "to_sha",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "ToSha",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "to_sha",
+			ProtobufFieldName:  "to_sha",
+			ProtobufType:       "string",
+			ProtobufPos:        6,
+		},
+
+		&ColumnInfo{
+			Index:              6,
+			Name:               "manifests_created",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "INT4",
+			DatabaseTypePretty: "INT4",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "INT4",
+			ColumnLength:       -1,
+			GoFieldName:        "ManifestsCreated",
+			GoFieldType:        "int32",
+			JSONFieldName:      "manifests_created",
+			ProtobufFieldName:  "manifests_created",
+			ProtobufType:       "int32",
+			ProtobufPos:        7,
+		},
+
+		&ColumnInfo{
+			Index:              7,
+			Name:               "manifests_updated",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "INT4",
+			DatabaseTypePretty: "INT4",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "INT4",
+			ColumnLength:       -1,
+			GoFieldName:        "ManifestsUpdated",
+			GoFieldType:        "int32",
+			JSONFieldName:      "manifests_updated",
+			ProtobufFieldName:  "manifests_updated",
+			ProtobufType:       "int32",
+			ProtobufPos:        8,
+		},
+
+		&ColumnInfo{
+			Index:              8,
+			Name:               "manifests_deleted",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "INT4",
+			DatabaseTypePretty: "INT4",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "INT4",
+			ColumnLength:       -1,
+			GoFieldName:        "ManifestsDeleted",
+			GoFieldType:        "int32",
+			JSONFieldName:      "manifests_deleted",
+			ProtobufFieldName:  "manifests_deleted",
+			ProtobufType:       "int32",
+			ProtobufPos:        9,
+		},
+
+		&ColumnInfo{
+			Index:              9,
+			Name:               "error",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "Error",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "error",
+			ProtobufFieldName:  "error",
+			ProtobufType:       "string",
+			ProtobufPos:        10,
+		},
+	},
+}
+
+// TableName sets the insert table name for this struct type
+func (r *RepoSync) TableName() string {
+	return "repo_sync"
+}
+
+// BeforeSave invoked before saving, return an error if field is not populated.
+func (r *RepoSync) BeforeSave() error {
+	return nil
+}
+
+// Prepare invoked before saving, can be used to populate fields etc.
+func (r *RepoSync) Prepare() {
+}
+
+// Validate invoked before performing action, return an error if field is not populated.
+func (r *RepoSync) Validate(action Action) error {
+	return nil
+}
+
+// TableInfo return table meta data
+func (r *RepoSync) TableInfo() *TableInfo {
+	return repo_syncTableInfo
+}
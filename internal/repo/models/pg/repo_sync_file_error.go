@@ -0,0 +1,138 @@
+package pg
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/satori/go.uuid"
+)
+
+var (
+	_ = time.Second
+	_ = sql.LevelDefault
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+DB Table Details
+-------------------------------------
+
+
+Table: repo_sync_file_error
+[ 0] sync_id                                        VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 1] path                                           TEXT                 null: false  primary: true   isArray: false  auto: false  col: TEXT            len: -1      default: []
+[ 2] error                                          TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+
+
+JSON Sample
+-------------------------------------
+{    "sync_id": "diiPVKQLpDxMvnwQlDHHWRuPF",    "path": "wlwEQAVxCBXJVtsEMXgchDvov",    "error": "PpKbUrDDGnWCULnUXwpVcwton"}
+
+
+
+*/
+
+// RepoSyncFileError struct is a row record of the repo_sync_file_error table in the tinyedge database
+type RepoSyncFileError struct {
+	//[ 0] sync_id                                        VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	SyncID string `gorm:"primary_key;column:sync_id;type:VARCHAR;size:255;"`
+	//[ 1] path                                           TEXT                 null: false  primary: true   isArray: false  auto: false  col: TEXT            len: -1      default: []
+	Path string `gorm:"primary_key;column:path;type:TEXT;"`
+	//[ 2] error                                          TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	Error string `gorm:"column:error;type:TEXT;"`
+}
+
+var repo_sync_file_errorTableInfo = &TableInfo{
+	Name: "repo_sync_file_error",
+	Columns: []*ColumnInfo{
+
+		&ColumnInfo{
+			Index:              0,
+			Name:               "sync_id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "SyncID",
+			GoFieldType:        "string",
+			JSONFieldName:      "sync_id",
+			ProtobufFieldName:  "sync_id",
+			ProtobufType:       "string",
+			ProtobufPos:        1,
+		},
+
+		&ColumnInfo{
+			Index:              1,
+			Name:               "path",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "Path",
+			GoFieldType:        "string",
+			JSONFieldName:      "path",
+			ProtobufFieldName:  "path",
+			ProtobufType:       "string",
+			ProtobufPos:        2,
+		},
+
+		&ColumnInfo{
+			Index:              2,
+			Name:               "error",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TEXT",
+			DatabaseTypePretty: "TEXT",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TEXT",
+			ColumnLength:       -1,
+			GoFieldName:        "Error",
+			GoFieldType:        "string",
+			JSONFieldName:      "error",
+			ProtobufFieldName:  "error",
+			ProtobufType:       "string",
+			ProtobufPos:        3,
+		},
+	},
+}
+
+// TableName sets the insert table name for this struct type
+func (r *RepoSyncFileError) TableName() string {
+	return "repo_sync_file_error"
+}
+
+// BeforeSave invoked before saving, return an error if field is not populated.
+func (r *RepoSyncFileError) BeforeSave() error {
+	return nil
+}
+
+// Prepare invoked before saving, can be used to populate fields etc.
+func (r *RepoSyncFileError) Prepare() {
+}
+
+// Validate invoked before performing action, return an error if field is not populated.
+func (r *RepoSyncFileError) Validate(action Action) error {
+	return nil
+}
+
+// TableInfo return table meta data
+func (r *RepoSyncFileError) TableInfo() *TableInfo {
+	return repo_sync_file_errorTableInfo
+}
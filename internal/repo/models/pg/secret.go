@@ -23,13 +23,14 @@ DB Table Details
 Table: secret
 [ 0] id                                             VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
 [ 1] path                                           VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
-[ 2] current_hash                                   TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
-[ 3] target_hash                                    TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[ 2] key                                            VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 3] current_hash                                   TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+[ 4] target_hash                                    TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 
 
 JSON Sample
 -------------------------------------
-{    "id": "XcnMJAUotvKRdQoKALiCApbFN",    "path": "BZnWcwQiEytORtXbLHRRAUHgB",    "current_hash": "NxlhLNKUPdXuNdFqXVHVvUheh",    "target_hash": "lEZsTJrWJTVfrtnrmUNVrHUXr"}
+{    "id": "XcnMJAUotvKRdQoKALiCApbFN",    "path": "BZnWcwQiEytORtXbLHRRAUHgB",    "key": "xqGbWRtOmvTPNcLzHcYwIUyKd",    "current_hash": "NxlhLNKUPdXuNdFqXVHVvUheh",    "target_hash": "lEZsTJrWJTVfrtnrmUNVrHUXr"}
 
 
 
@@ -41,9 +42,11 @@ type Secret struct {
 	ID string `gorm:"primary_key;column:id;type:VARCHAR;size:255;"`
 	//[ 1] path                                           VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
 	Path string `gorm:"column:path;type:VARCHAR;size:255;"`
-	//[ 2] current_hash                                   TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	//[ 2] key                                            VARCHAR(255)         null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	Key string `gorm:"column:key;type:VARCHAR;size:255;"`
+	//[ 3] current_hash                                   TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 	CurrentHash string `gorm:"column:current_hash;type:TEXT;"`
-	//[ 3] target_hash                                    TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
+	//[ 4] target_hash                                    TEXT                 null: false  primary: false  isArray: false  auto: false  col: TEXT            len: -1      default: []
 	TargetHash string `gorm:"column:target_hash;type:TEXT;"`
 }
 
@@ -95,6 +98,27 @@ var secretTableInfo = &TableInfo{
 
 		&ColumnInfo{
 			Index:              2,
+			Name:               "key",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "Key",
+			GoFieldType:        "string",
+			JSONFieldName:      "key",
+			ProtobufFieldName:  "key",
+			ProtobufType:       "string",
+			ProtobufPos:        3,
+		},
+
+		&ColumnInfo{
+			Index:              3,
 			Name:               "current_hash",
 			Comment:            ``,
 			Notes:              ``,
@@ -111,11 +135,11 @@ var secretTableInfo = &TableInfo{
 			JSONFieldName:      "current_hash",
 			ProtobufFieldName:  "current_hash",
 			ProtobufType:       "string",
-			ProtobufPos:        3,
+			ProtobufPos:        4,
 		},
 
 		&ColumnInfo{
-			Index:              3,
+			Index:              4,
 			Name:               "target_hash",
 			Comment:            ``,
 			Notes:              ``,
@@ -132,7 +156,7 @@ var secretTableInfo = &TableInfo{
 			JSONFieldName:      "target_hash",
 			ProtobufFieldName:  "target_hash",
 			ProtobufType:       "string",
-			ProtobufPos:        4,
+			ProtobufPos:        5,
 		},
 	},
 }
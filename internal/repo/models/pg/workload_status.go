@@ -0,0 +1,186 @@
+package pg
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/satori/go.uuid"
+)
+
+var (
+	_ = time.Second
+	_ = sql.LevelDefault
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+DB Table Details
+-------------------------------------
+
+
+Table: workload_status
+[ 0] device_id                                      VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 1] manifest_id                                    VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+[ 2] state                                          VARCHAR(20)          null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 20      default: [deploying]
+[ 3] last_updated                                   TIMESTAMP            null: true   primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+[ 4] reported_at                                    TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+
+
+JSON Sample
+-------------------------------------
+{    "device_id": "ekshmqiWSrFTGnPeZpBEcoZyT",    "manifest_id": "NTWzjlPpegTZVRZZYVDNHQorn",    "state": "knBYyfDMsMuELRuUIvzmQTpUB",    "last_updated": "2203-08-19T19:50:29.787565885+02:00",    "reported_at": "2232-01-24T12:26:23.400573614+02:00"}
+
+
+
+*/
+
+// WorkloadStatus struct is a row record of the workload_status table in the tinyedge database
+type WorkloadStatus struct {
+	//[ 0] device_id                                      VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	DeviceID string `gorm:"primary_key;column:device_id;type:VARCHAR;size:255;"`
+	//[ 1] manifest_id                                    VARCHAR(255)         null: false  primary: true   isArray: false  auto: false  col: VARCHAR         len: 255     default: []
+	ManifestID string `gorm:"primary_key;column:manifest_id;type:VARCHAR;size:255;"`
+	//[ 2] state                                          VARCHAR(20)          null: false  primary: false  isArray: false  auto: false  col: VARCHAR         len: 20      default: [deploying]
+	State string `gorm:"column:state;type:VARCHAR;size:20;default:deploying;"`
+	//[ 3] last_updated                                   TIMESTAMP            null: true   primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	LastUpdated time.Time `gorm:"column:last_updated;type:TIMESTAMP;"`
+	//[ 4] reported_at                                    TIMESTAMP            null: false  primary: false  isArray: false  auto: false  col: TIMESTAMP       len: -1      default: []
+	ReportedAt time.Time `gorm:"column:reported_at;type:TIMESTAMP;"`
+}
+
+var workload_statusTableInfo = &TableInfo{
+	Name: "workload_status",
+	Columns: []*ColumnInfo{
+
+		&ColumnInfo{
+			Index:              0,
+			Name:               "device_id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "DeviceID",
+			GoFieldType:        "string",
+			JSONFieldName:      "device_id",
+			ProtobufFieldName:  "device_id",
+			ProtobufType:       "string",
+			ProtobufPos:        1,
+		},
+
+		&ColumnInfo{
+			Index:              1,
+			Name:               "manifest_id",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(255)",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       255,
+			GoFieldName:        "ManifestID",
+			GoFieldType:        "string",
+			JSONFieldName:      "manifest_id",
+			ProtobufFieldName:  "manifest_id",
+			ProtobufType:       "string",
+			ProtobufPos:        2,
+		},
+
+		&ColumnInfo{
+			Index:              2,
+			Name:               "state",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "VARCHAR",
+			DatabaseTypePretty: "VARCHAR(20)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "VARCHAR",
+			ColumnLength:       20,
+			GoFieldName:        "State",
+			GoFieldType:        "string",
+			JSONFieldName:      "state",
+			ProtobufFieldName:  "state",
+			ProtobufType:       "string",
+			ProtobufPos:        3,
+		},
+
+		&ColumnInfo{
+			Index:              3,
+			Name:               "last_updated",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "LastUpdated",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "last_updated",
+			ProtobufFieldName:  "last_updated",
+			ProtobufType:       "uint64",
+			ProtobufPos:        4,
+		},
+
+		&ColumnInfo{
+			Index:              4,
+			Name:               "reported_at",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "TIMESTAMP",
+			DatabaseTypePretty: "TIMESTAMP",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "TIMESTAMP",
+			ColumnLength:       -1,
+			GoFieldName:        "ReportedAt",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "reported_at",
+			ProtobufFieldName:  "reported_at",
+			ProtobufType:       "uint64",
+			ProtobufPos:        5,
+		},
+	},
+}
+
+// TableName sets the insert table name for this struct type
+func (w *WorkloadStatus) TableName() string {
+	return "workload_status"
+}
+
+// BeforeSave invoked before saving, return an error if field is not populated.
+func (w *WorkloadStatus) BeforeSave() error {
+	return nil
+}
+
+// Prepare invoked before saving, can be used to populate fields etc.
+func (w *WorkloadStatus) Prepare() {
+}
+
+// Validate invoked before performing action, return an error if field is not populated.
+func (w *WorkloadStatus) Validate(action Action) error {
+	return nil
+}
+
+// TableInfo return table meta data
+func (w *WorkloadStatus) TableInfo() *TableInfo {
+	return workload_statusTableInfo
+}
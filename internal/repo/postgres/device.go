@@ -56,7 +56,24 @@ func (d *DeviceRepo) GetDevice(ctx context.Context, id string) (entity.Device, e
 		return entity.Device{}, errService.NewResourceNotFoundError("device", id)
 	}
 
-	return mappers.DeviceToEntity(m, d.manifestReader)
+	device, err := mappers.DeviceToEntity(m, d.manifestReader)
+	if err != nil {
+		return entity.Device{}, err
+	}
+
+	labels, err := d.getLabels(ctx, id)
+	if err != nil {
+		return entity.Device{}, err
+	}
+	device.Labels = labels[id]
+
+	variables, err := d.getVariables(ctx, entity.DeviceVariablesTarget, id)
+	if err != nil {
+		return entity.Device{}, err
+	}
+	device.Variables = variables[id]
+
+	return device, nil
 }
 
 func (d *DeviceRepo) GetDevices(ctx context.Context) ([]entity.Device, error) {
@@ -77,7 +94,25 @@ func (d *DeviceRepo) GetDevices(ctx context.Context) ([]entity.Device, error) {
 		return []entity.Device{}, nil
 	}
 
-	return mappers.DevicesToEntity(m, d.manifestReader)
+	devices, err := mappers.DevicesToEntity(m, d.manifestReader)
+	if err != nil {
+		return []entity.Device{}, err
+	}
+
+	labels, err := d.getLabels(ctx)
+	if err != nil {
+		return []entity.Device{}, err
+	}
+	variables, err := d.getVariables(ctx, entity.DeviceVariablesTarget)
+	if err != nil {
+		return []entity.Device{}, err
+	}
+	for i := range devices {
+		devices[i].Labels = labels[devices[i].ID]
+		devices[i].Variables = variables[devices[i].ID]
+	}
+
+	return devices, nil
 }
 
 func (d *DeviceRepo) CreateDevice(ctx context.Context, device entity.Device) error {
@@ -100,6 +135,22 @@ func (d *DeviceRepo) DeleteDevice(ctx context.Context, id string) error {
 	return d.getDb(ctx).Where("id = ?", id).Delete(&models.Device{}).Error
 }
 
+// DeleteDeviceRelations removes all the relations between the device and its manifests.
+func (d *DeviceRepo) DeleteDeviceRelations(ctx context.Context, id string) error {
+	if !d.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("device repository")
+	}
+
+	if err := d.getDb(ctx).Where("device_id = ?", id).Delete(&models.DevicesManifests{}).Error; err != nil {
+		if d.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("device repository")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (d *DeviceRepo) UpdateDevice(ctx context.Context, device entity.Device) error {
 	if !d.circuitBreaker.IsAvailable() {
 		return errService.NewPostgresNotAvailableError("device repository")
@@ -111,7 +162,8 @@ func (d *DeviceRepo) UpdateDevice(ctx context.Context, device entity.Device) err
 	}
 
 	model := mappers.DeviceEntityToModel(device)
-	if err := d.getDb(ctx).Save(&model).Error; err != nil {
+	// heartbeat columns are written only by UpdateHeartbeat and UpdateDeviceState
+	if err := d.getDb(ctx).Omit("state", "last_seen", "uptime_seconds", "configuration_hash").Save(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return errService.NewResourceNotFoundError("device", device.ID)
 		}
@@ -121,6 +173,158 @@ func (d *DeviceRepo) UpdateDevice(ctx context.Context, device entity.Device) err
 	return nil
 }
 
+// SetDeviceLabels replaces all the labels of the device.
+func (d *DeviceRepo) SetDeviceLabels(ctx context.Context, id string, labels map[string]string) error {
+	if !d.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("device repository")
+	}
+
+	tx := d.getDb(ctx).Begin()
+
+	if err := tx.Where("device_id = ?", id).Delete(&models.DeviceLabels{}).Error; err != nil {
+		tx.Rollback()
+		if d.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("device repository")
+		}
+		return err
+	}
+
+	if len(labels) > 0 {
+		m := mappers.DeviceLabelsToModel(id, labels)
+		if err := tx.Create(&m).Error; err != nil {
+			tx.Rollback()
+			if d.checkNetworkError(err) {
+				return errService.NewPostgresNotAvailableError("device repository")
+			}
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// UpdateCertificateSerialNumber replaces the certificate serial number of the device only if the current one is oldSerialNumber.
+func (d *DeviceRepo) UpdateCertificateSerialNumber(ctx context.Context, id string, oldSerialNumber string, newSerialNumber string) error {
+	if !d.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("device repository")
+	}
+
+	tx := d.getDb(ctx).Model(&models.Device{}).
+		Where("id = ? AND certificate_sn = ?", id, oldSerialNumber).
+		Update("certificate_sn", newSerialNumber)
+	if err := tx.Error; err != nil {
+		if d.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("device repository")
+		}
+		return err
+	}
+
+	if tx.RowsAffected == 0 {
+		return fmt.Errorf("certificate %q of device %q has already been replaced", oldSerialNumber, id)
+	}
+
+	return nil
+}
+
+// UpdateHeartbeat saves the heartbeat information and marks the device as online.
+// The workload statuses previously reported by the device are replaced by the ones from the heartbeat.
+func (d *DeviceRepo) UpdateHeartbeat(ctx context.Context, heartbeat entity.Heartbeat) error {
+	if !d.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("device repository")
+	}
+
+	values := map[string]interface{}{
+		"state":              entity.OnlineDeviceState.String(),
+		"last_seen":          heartbeat.Timestamp,
+		"uptime_seconds":     int64(heartbeat.Uptime.Seconds()),
+		"configuration_hash": heartbeat.ConfigurationHash,
+	}
+
+	tx := d.getDb(ctx).Begin()
+
+	res := tx.Model(&models.Device{}).Where("id = ?", heartbeat.DeviceID).Updates(values)
+	if err := res.Error; err != nil {
+		tx.Rollback()
+		if d.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("device repository")
+		}
+		return err
+	}
+
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return errService.NewResourceNotFoundError("device", heartbeat.DeviceID)
+	}
+
+	if err := tx.Where("device_id = ?", heartbeat.DeviceID).Delete(&models.WorkloadStatus{}).Error; err != nil {
+		tx.Rollback()
+		if d.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("device repository")
+		}
+		return err
+	}
+
+	if len(heartbeat.Workloads) > 0 {
+		m := mappers.WorkloadStatusesToModel(heartbeat.DeviceID, heartbeat.Timestamp, heartbeat.Workloads)
+		if err := tx.Create(&m).Error; err != nil {
+			tx.Rollback()
+			if d.checkNetworkError(err) {
+				return errService.NewPostgresNotAvailableError("device repository")
+			}
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// GetWorkloadStatuses returns the last workload statuses reported by the device.
+func (d *DeviceRepo) GetWorkloadStatuses(ctx context.Context, deviceID string) ([]entity.WorkloadDeployment, error) {
+	return d.getWorkloadStatuses(ctx, "device_id = ?", deviceID)
+}
+
+// GetManifestWorkloadStatuses returns the last statuses of the manifest's workload reported by all the devices.
+func (d *DeviceRepo) GetManifestWorkloadStatuses(ctx context.Context, manifestID string) ([]entity.WorkloadDeployment, error) {
+	return d.getWorkloadStatuses(ctx, "manifest_id = ?", manifestID)
+}
+
+func (d *DeviceRepo) getWorkloadStatuses(ctx context.Context, query string, args ...interface{}) ([]entity.WorkloadDeployment, error) {
+	if !d.circuitBreaker.IsAvailable() {
+		return []entity.WorkloadDeployment{}, errService.NewPostgresNotAvailableError("device repository")
+	}
+
+	statuses := []models.WorkloadStatus{}
+	if err := d.getDb(ctx).Where(query, args...).Order("device_id, manifest_id").Find(&statuses).Error; err != nil {
+		if d.checkNetworkError(err) {
+			return []entity.WorkloadDeployment{}, errService.NewPostgresNotAvailableError("device repository")
+		}
+		return []entity.WorkloadDeployment{}, err
+	}
+
+	return mappers.WorkloadStatusesToEntity(statuses), nil
+}
+
+// UpdateDeviceState sets the state of the device.
+func (d *DeviceRepo) UpdateDeviceState(ctx context.Context, id string, state entity.DeviceState) error {
+	if !d.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("device repository")
+	}
+
+	tx := d.getDb(ctx).Model(&models.Device{}).Where("id = ?", id).Update("state", state.String())
+	if err := tx.Error; err != nil {
+		if d.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("device repository")
+		}
+		return err
+	}
+
+	if tx.RowsAffected == 0 {
+		return errService.NewResourceNotFoundError("device", id)
+	}
+
+	return nil
+}
+
 func (d *DeviceRepo) GetSet(ctx context.Context, id string) (entity.Set, error) {
 	if !d.circuitBreaker.IsAvailable() {
 		return entity.Set{}, errService.NewPostgresNotAvailableError("device repository")
@@ -143,7 +347,18 @@ func (d *DeviceRepo) GetSet(ctx context.Context, id string) (entity.Set, error)
 		return entity.Set{}, errService.NewResourceNotFoundError("set", id)
 	}
 
-	return mappers.SetToEntity(s, d.manifestReader)
+	set, err := mappers.SetToEntity(s, d.manifestReader)
+	if err != nil {
+		return entity.Set{}, err
+	}
+
+	variables, err := d.getVariables(ctx, entity.SetVariablesTarget, id)
+	if err != nil {
+		return entity.Set{}, err
+	}
+	set.Variables = variables[id]
+
+	return set, nil
 }
 
 func (d *DeviceRepo) GetSets(ctx context.Context) ([]entity.Set, error) {
@@ -164,7 +379,20 @@ func (d *DeviceRepo) GetSets(ctx context.Context) ([]entity.Set, error) {
 		return []entity.Set{}, nil
 	}
 
-	return mappers.SetsToEntity(s, d.manifestReader)
+	sets, err := mappers.SetsToEntity(s, d.manifestReader)
+	if err != nil {
+		return []entity.Set{}, err
+	}
+
+	variables, err := d.getVariables(ctx, entity.SetVariablesTarget)
+	if err != nil {
+		return []entity.Set{}, err
+	}
+	for i := range sets {
+		sets[i].Variables = variables[sets[i].Name]
+	}
+
+	return sets, nil
 }
 
 func (d *DeviceRepo) CreateSet(ctx context.Context, set entity.Set) error {
@@ -224,7 +452,7 @@ func (d *DeviceRepo) GetNamespace(ctx context.Context, id string) (entity.Namesp
 		return entity.Namespace{}, errService.NewResourceNotFoundError("namespace", id)
 	}
 
-	return mappers.NamespaceModelToEntity(n, d.manifestReader)
+	return d.namespaceToEntity(ctx, n)
 }
 
 func (d *DeviceRepo) GetDefaultNamespace(ctx context.Context) (entity.Namespace, error) {
@@ -246,7 +474,7 @@ func (d *DeviceRepo) GetDefaultNamespace(ctx context.Context) (entity.Namespace,
 		return entity.Namespace{}, errService.NewResourceNotFoundErrorWithReason("Default namespace not found")
 	}
 
-	return mappers.NamespaceModelToEntity(n, d.manifestReader)
+	return d.namespaceToEntity(ctx, n)
 }
 
 func (d *DeviceRepo) GetNamespaces(ctx context.Context) ([]entity.Namespace, error) {
@@ -268,7 +496,20 @@ func (d *DeviceRepo) GetNamespaces(ctx context.Context) ([]entity.Namespace, err
 		return []entity.Namespace{}, nil
 	}
 
-	return mappers.NamespacesModelToEntity(n, d.manifestReader)
+	namespaces, err := mappers.NamespacesModelToEntity(n, d.manifestReader)
+	if err != nil {
+		return []entity.Namespace{}, err
+	}
+
+	variables, err := d.getVariables(ctx, entity.NamespaceVariablesTarget)
+	if err != nil {
+		return []entity.Namespace{}, err
+	}
+	for i := range namespaces {
+		namespaces[i].Variables = variables[namespaces[i].Name]
+	}
+
+	return namespaces, nil
 }
 
 func (d *DeviceRepo) CreateNamespace(ctx context.Context, namespace entity.Namespace) error {
@@ -392,6 +633,124 @@ func (d *DeviceRepo) DeleteNamespace(ctx context.Context, id string) error {
 	return tx.Commit().Error
 }
 
+// getLabels returns the labels grouped by device id. If no id is provided, the labels of all devices are returned.
+func (d *DeviceRepo) getLabels(ctx context.Context, ids ...string) (map[string]map[string]string, error) {
+	labels := []models.DeviceLabels{}
+
+	tx := d.getDb(ctx)
+	if len(ids) > 0 {
+		tx = tx.Where("device_id IN ?", ids)
+	}
+
+	if err := tx.Find(&labels).Error; err != nil {
+		if d.checkNetworkError(err) {
+			return nil, errService.NewPostgresNotAvailableError("device repository")
+		}
+		return nil, err
+	}
+
+	return mappers.DeviceLabelsToEntity(labels), nil
+}
+
+// SetVariables replaces all the template variables of the namespace, set or device.
+func (d *DeviceRepo) SetVariables(ctx context.Context, target entity.VariablesTarget, id string, variables map[string]string) error {
+	if !d.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("device repository")
+	}
+
+	tx := d.getDb(ctx).Begin()
+
+	var model interface{}
+	column := "namespace_id"
+	switch target {
+	case entity.DeviceVariablesTarget:
+		model, column = &models.DeviceVariables{}, "device_id"
+	case entity.SetVariablesTarget:
+		model, column = &models.SetVariables{}, "device_set_id"
+	default:
+		model = &models.NamespaceVariables{}
+	}
+
+	if err := tx.Where(column+" = ?", id).Delete(model).Error; err != nil {
+		tx.Rollback()
+		if d.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("device repository")
+		}
+		return err
+	}
+
+	if len(variables) > 0 {
+		if err := tx.Create(mappers.VariablesToModel(target, id, variables)).Error; err != nil {
+			tx.Rollback()
+			if d.checkNetworkError(err) {
+				return errService.NewPostgresNotAvailableError("device repository")
+			}
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// namespaceToEntity maps the namespace and reads its variables.
+func (d *DeviceRepo) namespaceToEntity(ctx context.Context, n []models.NamespaceJoin) (entity.Namespace, error) {
+	namespace, err := mappers.NamespaceModelToEntity(n, d.manifestReader)
+	if err != nil {
+		return entity.Namespace{}, err
+	}
+
+	variables, err := d.getVariables(ctx, entity.NamespaceVariablesTarget, namespace.Name)
+	if err != nil {
+		return entity.Namespace{}, err
+	}
+	namespace.Variables = variables[namespace.Name]
+
+	return namespace, nil
+}
+
+// getVariables returns the variables of the target grouped by id. If no id is provided, the variables of all the
+// namespaces, sets or devices are returned.
+func (d *DeviceRepo) getVariables(ctx context.Context, target entity.VariablesTarget, ids ...string) (map[string]map[string]string, error) {
+	tx := d.getDb(ctx)
+
+	var (
+		err       error
+		variables map[string]map[string]string
+	)
+	switch target {
+	case entity.DeviceVariablesTarget:
+		m := []models.DeviceVariables{}
+		if len(ids) > 0 {
+			tx = tx.Where("device_id IN ?", ids)
+		}
+		err = tx.Find(&m).Error
+		variables = mappers.DeviceVariablesToEntity(m)
+	case entity.SetVariablesTarget:
+		m := []models.SetVariables{}
+		if len(ids) > 0 {
+			tx = tx.Where("device_set_id IN ?", ids)
+		}
+		err = tx.Find(&m).Error
+		variables = mappers.SetVariablesToEntity(m)
+	default:
+		m := []models.NamespaceVariables{}
+		if len(ids) > 0 {
+			tx = tx.Where("namespace_id IN ?", ids)
+		}
+		err = tx.Find(&m).Error
+		variables = mappers.NamespaceVariablesToEntity(m)
+	}
+
+	if err != nil {
+		if d.checkNetworkError(err) {
+			return nil, errService.NewPostgresNotAvailableError("device repository")
+		}
+		return nil, err
+	}
+
+	return variables, nil
+}
+
 func (d *DeviceRepo) checkNetworkError(err error) (isOpen bool) {
 	isOpen = d.circuitBreaker.BreakOnNetworkError(err)
 	if isOpen {
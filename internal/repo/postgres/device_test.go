@@ -578,6 +578,21 @@ var _ = Describe("Device repository", Ordered, func() {
 				Expect([]string{devices[0].ID, devices[1].ID}).Should(ContainElement("device1"))
 			})
 
+			It("successfully replace the variables of a device", func() {
+				tx := gormDB.Exec(`INSERT INTO device (id, enroled, registered, namespace_id) VALUES
+				('device', 'enroled', true, 'namespace1');`)
+				Expect(tx.Error).To(BeNil())
+
+				err := deviceRepo.SetVariables(context.TODO(), entity.DeviceVariablesTarget, "device", map[string]string{"site": "paris", "port": "80"})
+				Expect(err).To(BeNil())
+				err = deviceRepo.SetVariables(context.TODO(), entity.DeviceVariablesTarget, "device", map[string]string{"site": "lyon"})
+				Expect(err).To(BeNil())
+
+				device, err := deviceRepo.GetDevice(context.TODO(), "device")
+				Expect(err).To(BeNil())
+				Expect(device.Variables).To(Equal(map[string]string{"site": "lyon"}))
+			})
+
 			It("successfully creates a device", func() {
 				err := deviceRepo.CreateDevice(context.TODO(), entity.Device{
 					ID:          "device",
@@ -612,6 +627,29 @@ var _ = Describe("Device repository", Ordered, func() {
 				Expect(count).To(Equal(0))
 			})
 
+			It("keeps the enrolment time of a decommissioned device", func() {
+				enroledAt := time.Now().UTC().Truncate(time.Second)
+				device := entity.Device{
+					ID:          "device",
+					EnrolStatus: entity.EnroledStatus,
+					EnroledAt:   enroledAt,
+					NamespaceID: "namespace1",
+				}
+				err := deviceRepo.CreateDevice(context.TODO(), device)
+				Expect(err).To(BeNil())
+
+				device.EnrolStatus = entity.DecommissionedEnrolStatus
+				device.DecommissionedAt = enroledAt.Add(time.Hour)
+				err = deviceRepo.UpdateDevice(context.TODO(), device)
+				Expect(err).To(BeNil())
+
+				d, err := deviceRepo.GetDevice(context.TODO(), "device")
+				Expect(err).To(BeNil())
+				Expect(d.EnrolStatus).To(Equal(entity.DecommissionedEnrolStatus))
+				Expect(d.EnroledAt).To(BeTemporally("~", enroledAt, time.Second))
+				Expect(d.DecommissionedAt).To(BeTemporally("~", enroledAt.Add(time.Hour), time.Second))
+			})
+
 			It("successfully update a device", func() {
 				device := entity.Device{
 					ID:          "device",
@@ -632,6 +670,67 @@ var _ = Describe("Device repository", Ordered, func() {
 				Expect(d.EnrolStatus.String()).To(Equal("enroled"))
 
 			})
+
+			It("successfully replace the workload statuses on heartbeat", func() {
+				tx := gormDB.Exec(`INSERT INTO device (id, enroled, registered, namespace_id) VALUES
+				('device', 'enroled', true, 'namespace1');`)
+				Expect(tx.Error).To(BeNil())
+
+				err := deviceRepo.UpdateHeartbeat(context.TODO(), entity.Heartbeat{
+					DeviceID:  "device",
+					Timestamp: time.Now().UTC(),
+					Workloads: []entity.WorkloadStatus{
+						{Name: "workload", State: entity.RunningWorkloadState},
+						{Name: "workload2", State: entity.CrashedWorkloadState},
+					},
+				})
+				Expect(err).To(BeNil())
+
+				err = deviceRepo.UpdateHeartbeat(context.TODO(), entity.Heartbeat{
+					DeviceID:  "device",
+					Timestamp: time.Now().UTC(),
+					Workloads: []entity.WorkloadStatus{
+						{Name: "workload", State: entity.StoppedWorkloadState},
+					},
+				})
+				Expect(err).To(BeNil())
+
+				statuses, err := deviceRepo.GetWorkloadStatuses(context.TODO(), "device")
+				Expect(err).To(BeNil())
+				Expect(len(statuses)).To(Equal(1))
+				Expect(statuses[0].ManifestID).To(Equal("workload"))
+				Expect(statuses[0].State).To(Equal(entity.StoppedWorkloadState))
+
+				statuses, err = deviceRepo.GetManifestWorkloadStatuses(context.TODO(), "workload2")
+				Expect(err).To(BeNil())
+				Expect(len(statuses)).To(Equal(0))
+			})
+
+			It("keeps the last status of a workload reported twice", func() {
+				tx := gormDB.Exec(`INSERT INTO device (id, enroled, registered, namespace_id) VALUES
+				('device', 'enroled', true, 'namespace1');`)
+				Expect(tx.Error).To(BeNil())
+
+				now := time.Now().UTC()
+				err := deviceRepo.UpdateHeartbeat(context.TODO(), entity.Heartbeat{
+					DeviceID:  "device",
+					Timestamp: now,
+					Workloads: []entity.WorkloadStatus{
+						{Name: "workload", State: entity.RunningWorkloadState},
+						{Name: "workload", State: entity.CrashedWorkloadState},
+					},
+				})
+				Expect(err).To(BeNil())
+
+				statuses, err := deviceRepo.GetWorkloadStatuses(context.TODO(), "device")
+				Expect(err).To(BeNil())
+				Expect(len(statuses)).To(Equal(1))
+				Expect(statuses[0].State).To(Equal(entity.CrashedWorkloadState))
+
+				d, err := deviceRepo.GetDevice(context.TODO(), "device")
+				Expect(err).To(BeNil())
+				Expect(d.LastSeen).To(BeTemporally("~", now, time.Second))
+			})
 		})
 	})
 
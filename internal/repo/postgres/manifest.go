@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -13,6 +14,7 @@ import (
 	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ManifestRepository struct {
@@ -91,6 +93,49 @@ func (m *ManifestRepository) GetManifests(ctx context.Context, repo entity.Repos
 	return output, nil
 }
 
+// GetManifestsByLabels returns the manifests whose label selector matches the labels.
+// The selectors are stored with the manifests at sync time so only the files of the matching manifests are read.
+func (m *ManifestRepository) GetManifestsByLabels(ctx context.Context, labels map[string]string) ([]entity.Manifest, error) {
+	if !m.circuitBreaker.IsAvailable() {
+		return []entity.Manifest{}, errService.NewPostgresNotAvailableError("manifest repository")
+	}
+
+	selectors := []models.Manifest{}
+	tx := m.getDb(ctx).Select("id, label_selector").Where("label_selector IS NOT NULL")
+	if err := tx.Find(&selectors).Error; err != nil {
+		if m.checkNetworkError(err) {
+			return []entity.Manifest{}, errService.NewPostgresNotAvailableError("manifest repository")
+		}
+		return []entity.Manifest{}, err
+	}
+
+	ids := make([]string, 0, len(selectors))
+	for _, s := range selectors {
+		selector, err := mappers.LabelSelectorModelToEntity(s.LabelSelector)
+		if err != nil {
+			zap.S().Warnw("failed to read label selector of manifest", "manifest_id", s.ID, "error", err)
+			continue
+		}
+		if selector != nil && selector.Matches(labels) {
+			ids = append(ids, s.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return []entity.Manifest{}, nil
+	}
+
+	manifests := []models.ManifestJoin{}
+	if err := newManifestQuery(ctx, m.db).WithReferenceIDs(ids).Build().Find(&manifests).Error; err != nil {
+		if m.checkNetworkError(err) {
+			return []entity.Manifest{}, errService.NewPostgresNotAvailableError("manifest repository")
+		}
+		return []entity.Manifest{}, err
+	}
+
+	return mappers.ManifestsToEntities(manifests, m.manifestReader)
+}
+
 func (m *ManifestRepository) InsertManifest(ctx context.Context, manifest entity.Manifest) error {
 	if !m.circuitBreaker.IsAvailable() {
 		return errService.NewPostgresNotAvailableError("manifest repository")
@@ -107,13 +152,25 @@ func (m *ManifestRepository) InsertManifest(ctx context.Context, manifest entity
 
 	model := mappers.ManifestEntityToModel(manifest)
 
-	if err := m.getDb(ctx).Create(&model).Error; err != nil {
+	tx := m.getDb(ctx).Begin()
+
+	if err := tx.Create(&model).Error; err != nil {
+		tx.Rollback()
 		if m.checkNetworkError(err) {
 			return errService.NewPostgresNotAvailableError("manifest repository")
 		}
 		return err
 	}
-	return nil
+
+	if err := m.writeSecrets(tx, manifest); err != nil {
+		tx.Rollback()
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("manifest repository")
+		}
+		return err
+	}
+
+	return tx.Commit().Error
 }
 
 func (m *ManifestRepository) UpdateManifest(ctx context.Context, manifest entity.Manifest) error {
@@ -123,7 +180,10 @@ func (m *ManifestRepository) UpdateManifest(ctx context.Context, manifest entity
 
 	model := mappers.ManifestEntityToModel(manifest)
 
-	if err := m.getDb(ctx).Where("id = ?", model.ID).Save(&model).Error; err != nil {
+	tx := m.getDb(ctx).Begin()
+
+	if err := tx.Where("id = ?", model.ID).Save(&model).Error; err != nil {
+		tx.Rollback()
 		if m.checkNetworkError(err) {
 			return errService.NewPostgresNotAvailableError("manifest repository")
 		}
@@ -133,7 +193,15 @@ func (m *ManifestRepository) UpdateManifest(ctx context.Context, manifest entity
 		return err
 	}
 
-	return nil
+	if err := m.writeSecrets(tx, manifest); err != nil {
+		tx.Rollback()
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("manifest repository")
+		}
+		return err
+	}
+
+	return tx.Commit().Error
 }
 
 func (m *ManifestRepository) DeleteManifest(ctx context.Context, id string) error {
@@ -150,16 +218,137 @@ func (m *ManifestRepository) DeleteManifest(ctx context.Context, id string) erro
 		return nil
 	}
 
-	if err := m.getDb(ctx).Where("id = ?", id).Delete(&models.Manifest{}).Error; err != nil {
+	tx := m.getDb(ctx).Begin()
+
+	if err := tx.Where("id = ?", id).Delete(&models.Manifest{}).Error; err != nil {
+		tx.Rollback()
 		if m.checkNetworkError(err) {
 			return errService.NewPostgresNotAvailableError("manifest repository")
 		}
 		return err
 	}
 
+	if err := deleteUnusedSecrets(tx); err != nil {
+		tx.Rollback()
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("manifest repository")
+		}
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// RenameManifest changes the id of a manifest keeping its relations with the namespaces, sets, devices and secrets.
+func (m *ManifestRepository) RenameManifest(ctx context.Context, oldID, newID string) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("manifest repository")
+	}
+
+	exists, err := m.isExists(ctx, oldID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errService.NewResourceNotFoundError("manifest", oldID)
+	}
+
+	tx := m.getDb(ctx).Begin()
+
+	statements := []string{
+		"INSERT INTO manifest (id, version, repo_id, path, label_selector) SELECT @new, version, repo_id, path, label_selector FROM manifest WHERE id = @old",
+		"UPDATE namespaces_manifests SET manifest_id = @new WHERE manifest_id = @old",
+		"UPDATE sets_manifests SET manifest_id = @new WHERE manifest_id = @old",
+		"UPDATE devices_manifests SET manifest_id = @new WHERE manifest_id = @old",
+		"UPDATE secrets_manifests SET manifest_id = @new WHERE manifest_id = @old",
+		"UPDATE workload_status SET manifest_id = @new WHERE manifest_id = @old",
+		"DELETE FROM manifest WHERE id = @old",
+	}
+
+	for _, stmt := range statements {
+		if err := tx.Exec(stmt, sql.Named("new", newID), sql.Named("old", oldID)).Error; err != nil {
+			tx.Rollback()
+			if m.checkNetworkError(err) {
+				return errService.NewPostgresNotAvailableError("manifest repository")
+			}
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// GetSecrets returns the secrets referenced by the manifests.
+func (m *ManifestRepository) GetSecrets(ctx context.Context) ([]entity.SecretReference, error) {
+	if !m.circuitBreaker.IsAvailable() {
+		return []entity.SecretReference{}, errService.NewPostgresNotAvailableError("manifest repository")
+	}
+
+	secrets := []models.SecretJoin{}
+	tx := m.getDb(ctx).Table("secret").
+		Select("secret.*, secrets_manifests.manifest_id").
+		Joins("LEFT JOIN secrets_manifests ON secrets_manifests.secret_id = secret.id").
+		Order("secret.id, secrets_manifests.manifest_id")
+	if err := tx.Find(&secrets).Error; err != nil {
+		if m.checkNetworkError(err) {
+			return []entity.SecretReference{}, errService.NewPostgresNotAvailableError("manifest repository")
+		}
+		return []entity.SecretReference{}, err
+	}
+
+	return mappers.SecretsToEntities(secrets), nil
+}
+
+// UpdateSecret saves the hashes of the secret.
+func (m *ManifestRepository) UpdateSecret(ctx context.Context, secret entity.SecretReference) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("manifest repository")
+	}
+
+	model := mappers.SecretEntityToModel(secret)
+	tx := m.getDb(ctx).Model(&models.Secret{}).Where("id = ?", model.ID).Updates(map[string]interface{}{
+		"current_hash": model.CurrentHash,
+		"target_hash":  model.TargetHash,
+	})
+	if err := tx.Error; err != nil {
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("manifest repository")
+		}
+		return err
+	}
+
+	if tx.RowsAffected == 0 {
+		return errService.NewResourceNotFoundError("secret", secret.ID)
+	}
+
 	return nil
 }
 
+// writeSecrets replaces the secrets referenced by the manifest. The hashes of the secrets already known are kept.
+func (m *ManifestRepository) writeSecrets(tx *gorm.DB, manifest entity.Manifest) error {
+	if err := tx.Where("manifest_id = ?", manifest.GetID()).Delete(&models.SecretsManifests{}).Error; err != nil {
+		return err
+	}
+
+	if w, ok := manifest.(entity.ManifestV1); ok && len(w.Secrets) > 0 {
+		secrets, relations := mappers.ManifestSecretsToModels(w)
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&secrets).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&relations).Error; err != nil {
+			return err
+		}
+	}
+
+	return deleteUnusedSecrets(tx)
+}
+
+// deleteUnusedSecrets removes the secrets which are not referenced by any manifest.
+func deleteUnusedSecrets(tx *gorm.DB) error {
+	return tx.Where("id NOT IN (?)", tx.Session(&gorm.Session{NewDB: true}).Model(&models.SecretsManifests{}).Select("secret_id")).Delete(&models.Secret{}).Error
+}
+
 func (m *ManifestRepository) CreateRelation(ctx context.Context, relation entity.Relation) error {
 	switch relation.Type {
 	case entity.NamespaceRelationType:
@@ -197,6 +197,22 @@ var _ = Describe("Manifest repository", Ordered, func() {
 			Expect([]string{manifests[0].GetID(), manifests[1].GetID()}).Should(ContainElement("workload"))
 			Expect([]string{manifests[0].GetID(), manifests[1].GetID()}).Should(ContainElement("workload2"))
 		})
+
+		It("successfully retrieve manifests by labels", func() {
+			ierr := gormDB.Exec(fmt.Sprintf(`INSERT INTO manifest (id, version,  repo_id, path, label_selector) VALUES
+			('workload', 'v1', 'id', '%s', '{"MatchLabels":{"env":"prod"}}'),
+			('workload2', 'v1','id','%s', NULL);`, path.Join(folderTmp, workload), path.Join(folderTmp, workload))).Error
+			Expect(ierr).To(BeNil())
+
+			manifests, err := repo.GetManifestsByLabels(context.TODO(), map[string]string{"env": "prod"})
+			Expect(err).To(BeNil())
+			Expect(len(manifests)).To(Equal(1))
+			Expect(manifests[0].GetID()).To(Equal("workload"))
+
+			manifests, err = repo.GetManifestsByLabels(context.TODO(), map[string]string{"env": "dev"})
+			Expect(err).To(BeNil())
+			Expect(manifests).To(BeEmpty())
+		})
 	})
 
 	Context("crud manifests", func() {
@@ -292,6 +308,87 @@ var _ = Describe("Manifest repository", Ordered, func() {
 			Expect(count).To(Equal(0))
 		})
 
+		It("successfully renames a manifest", func() {
+			manifest := entity.ManifestV1{
+				ObjectMeta: entity.ObjectMeta{
+					Id: "workload",
+				},
+				TypeMeta: entity.TypeMeta{
+					Version: entity.ManifestVersionV1,
+				},
+				Repository: entity.Repository{
+					Id: "id",
+				},
+				Path: workload,
+			}
+			err := repo.InsertManifest(context.TODO(), manifest)
+			Expect(err).To(BeNil())
+
+			ierr := gormDB.Exec(`INSERT INTO namespaces_manifests (namespace_id, manifest_id) VALUES ('namespace', 'workload');`).Error
+			Expect(ierr).To(BeNil())
+
+			err = repo.RenameManifest(context.TODO(), "workload", "renamed")
+			Expect(err).To(BeNil())
+
+			m, err := repo.GetManifest(context.TODO(), "renamed")
+			Expect(err).To(BeNil())
+			Expect(m.GetNamespaces()).To(Equal([]string{"namespace"}))
+
+			_, err = repo.GetManifest(context.TODO(), "workload")
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("successfully writes the secrets of a manifest", func() {
+			manifest := entity.ManifestV1{
+				ObjectMeta: entity.ObjectMeta{
+					Id: "workload",
+				},
+				TypeMeta: entity.TypeMeta{
+					Version: entity.ManifestVersionV1,
+				},
+				Repository: entity.Repository{
+					Id: "id",
+				},
+				Path: workload,
+				Secrets: []entity.Secret{
+					{Id: "db", Path: "db", Key: "user"},
+					{Id: "db", Path: "db", Key: "password"},
+				},
+			}
+			err := repo.InsertManifest(context.TODO(), manifest)
+			Expect(err).To(BeNil())
+
+			secrets, err := repo.GetSecrets(context.TODO())
+			Expect(err).To(BeNil())
+			Expect(len(secrets)).To(Equal(2))
+			Expect(secrets[0].ID).To(Equal("db:password"))
+			Expect(secrets[0].Manifests).To(Equal([]string{"workload"}))
+			Expect(secrets[0].CurrentHash).To(BeEmpty())
+
+			secrets[0].CurrentHash = "current"
+			secrets[0].TargetHash = "target"
+			err = repo.UpdateSecret(context.TODO(), secrets[0])
+			Expect(err).To(BeNil())
+
+			manifest.Secrets = manifest.Secrets[1:]
+			err = repo.UpdateManifest(context.TODO(), manifest)
+			Expect(err).To(BeNil())
+
+			secrets, err = repo.GetSecrets(context.TODO())
+			Expect(err).To(BeNil())
+			Expect(len(secrets)).To(Equal(1))
+			Expect(secrets[0].ID).To(Equal("db:password"))
+			Expect(secrets[0].CurrentHash).To(Equal("current"))
+			Expect(secrets[0].TargetHash).To(Equal("target"))
+
+			err = repo.DeleteManifest(context.TODO(), manifest.Id)
+			Expect(err).To(BeNil())
+
+			secrets, err = repo.GetSecrets(context.TODO())
+			Expect(err).To(BeNil())
+			Expect(secrets).To(BeEmpty())
+		})
+
 		Context("relations", func() {
 			It("creates successfully relation between namespace and manifest", func() {
 				err := gormDB.Exec(`INSERT INTO manifest (id, version, repo_id, path) VALUES
@@ -399,6 +496,7 @@ var _ = Describe("Manifest repository", Ordered, func() {
 	AfterEach(func() {
 		// clean the db
 		gormDB.Exec("DELETE FROM manifest;")
+		gormDB.Exec("DELETE FROM secret;")
 		gormDB.Exec("DELETE FROM device;")
 		gormDB.Exec("DELETE FROM namespace;")
 		gormDB.Exec("DELETE FROM device_set;")
@@ -19,7 +19,8 @@ func namespaceQuery(db *gorm.DB) *gorm.DB {
 		Select(`namespace.*,
 			device_set.id as set_id,
 			device.id as device_id, 
-			w.local_path as workload_repo_local_path,w.workload_path as workload_path,w.workload_id as workload_id`).
+			w.local_path as workload_repo_local_path,w.workload_path as workload_path,w.workload_id as workload_id,
+			w.subpath as workload_repo_subpath,w.include_globs as workload_repo_include_globs,w.exclude_globs as workload_repo_exclude_globs`).
 		Joins("LEFT JOIN device ON device.namespace_id = namespace.id").
 		Joins("LEFT JOIN device_set ON device_set.namespace_id = namespace.id").
 		Joins("LEFT JOIN (?) as w ON w.namespace_id = namespace.id", workloadSubQuery)
@@ -37,7 +38,8 @@ func setQuery(db *gorm.DB) *gorm.DB {
 	return db.Table("device_set").
 		Select(`device_set.*,
 			device.id as device_id, 
-			w.local_path as workload_repo_local_path,w.workload_path as workload_path,w.workload_id as workload_id`).
+			w.local_path as workload_repo_local_path,w.workload_path as workload_path,w.workload_id as workload_id,
+			w.subpath as workload_repo_subpath,w.include_globs as workload_repo_include_globs,w.exclude_globs as workload_repo_exclude_globs`).
 		Joins("LEFT JOIN device ON device.device_set_id = device_set.id").
 		Joins("LEFT JOIN namespace ON namespace.id = device_set.namespace_id").
 		Joins("LEFT JOIN (?) as w ON w.set_id = device_set.id", workloadSubQuery)
@@ -55,7 +57,8 @@ func deviceQuery(db *gorm.DB) *gorm.DB {
 	return db.Table("device").
 		Select(`device.*,
 			device_set.id as set_id, 
-			w.local_path as workload_repo_local_path,w.workload_path as workload_path,w.workload_id as workload_id`).
+			w.local_path as workload_repo_local_path,w.workload_path as workload_path,w.workload_id as workload_id,
+			w.subpath as workload_repo_subpath,w.include_globs as workload_repo_include_globs,w.exclude_globs as workload_repo_exclude_globs`).
 		Joins("LEFT JOIN device_set ON device_set.id = device.device_set_id").
 		Joins("LEFT JOIN (?) as w ON w.device_id = device.id", workloadSubQuery)
 }
@@ -68,6 +71,7 @@ func newManifestQuery(ctx context.Context, db *gorm.DB) *manifestQueryBuilder {
 	tx := db.Session(&gorm.Session{SkipHooks: true}).WithContext(ctx).Table("manifest").
 		Select(`manifest.*, devices_manifests.device_id as device_id, sets_manifests.device_set_id as set_id, namespaces_manifests.namespace_id as namespace_id,
 		repo.id as repo_id, repo.url as repo_url, repo.branch as repo_branch, repo.local_path as repo_local_path,
+		repo.subpath as repo_subpath, repo.include_globs as repo_include_globs, repo.exclude_globs as repo_exclude_globs,
 		repo.auth_type as repo_auth_type, repo.auth_secret_path as repo_auth_secret_path,
 		repo.current_head_sha as repo_current_head_sha, repo.target_head_sha as repo_target_head_sha,
 		repo.pull_period_seconds as repo_pull_period_seconds`).
@@ -88,6 +92,11 @@ func (mm *manifestQueryBuilder) WithReferenceID(id string) *manifestQueryBuilder
 	return mm
 }
 
+func (mm *manifestQueryBuilder) WithReferenceIDs(ids []string) *manifestQueryBuilder {
+	mm.tx.Where("manifest.id IN ?", ids)
+	return mm
+}
+
 func (mm *manifestQueryBuilder) WithNamespaceID(id string) *manifestQueryBuilder {
 	mm.tx.Where("namespaces_manifests.namespace_id = ?", id)
 	return mm
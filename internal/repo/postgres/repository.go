@@ -2,7 +2,9 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"time"
 
 	pgclient "github.com/tupyy/tinyedge-controller/internal/clients/pg"
 	"github.com/tupyy/tinyedge-controller/internal/entity"
@@ -111,6 +113,220 @@ func (m *Repository) UpdateRepository(ctx context.Context, r entity.Repository)
 	return nil
 }
 
+// UpdateSettings saves the url, the credentials, the secret paths and the scope of the repository without modifying its sync state
+// except the part dropped by reset. The next sync is saved with the reset so the repository is synced again with its new settings.
+func (m *Repository) UpdateSettings(ctx context.Context, r entity.Repository, reset entity.RepositoryReset) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("repository")
+	}
+
+	model := mappers.RepoEntityToModel(r)
+
+	columns := []string{"url", "auth_type", "auth_secret_path", "webhook_secret_path", "signing_keys_secret_path", "subpath", "include_globs", "exclude_globs"}
+	switch reset {
+	case entity.HeadRepositoryReset:
+		columns = append(columns, "current_head_sha", "next_sync_at")
+	case entity.CloneRepositoryReset:
+		columns = append(columns, "local_path", "current_head_sha", "target_head_sha", "rejected_head_sha", "rejection_reason", "next_sync_at")
+	}
+
+	tx := m.getDb(ctx).Model(&models.Repo{}).Where("id = ?", r.Id).Select(columns).Updates(&model)
+	if err := tx.Error; err != nil {
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("repository")
+		}
+		return err
+	}
+
+	if tx.RowsAffected == 0 {
+		return errService.NewResourceNotFoundError("repository", r.Id)
+	}
+
+	return nil
+}
+
+// UpdateRef saves the git reference followed by the repository without modifying its settings.
+// The target and the rejected heads found for the previous reference are cleared.
+// The next sync is saved too unless it is zero, e.g. because the repository is paused.
+func (m *Repository) UpdateRef(ctx context.Context, r entity.Repository) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("repository")
+	}
+
+	model := mappers.RepoEntityToModel(r)
+	model.TargetHeadSha = sql.NullString{}
+	model.RejectedHeadSha = sql.NullString{}
+	model.RejectionReason = sql.NullString{}
+
+	columns := []string{"branch", "tag_pattern", "commit_sha", "target_head_sha", "rejected_head_sha", "rejection_reason"}
+	if !r.NextSyncAt.IsZero() {
+		columns = append(columns, "next_sync_at")
+	}
+
+	tx := m.getDb(ctx).Model(&models.Repo{}).Where("id = ?", r.Id).Select(columns).Updates(&model)
+	if err := tx.Error; err != nil {
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("repository")
+		}
+		return err
+	}
+
+	if tx.RowsAffected == 0 {
+		return errService.NewResourceNotFoundError("repository", r.Id)
+	}
+
+	return nil
+}
+
+// UpdateSyncState saves the local clone, the heads and the rejected head of the repository without modifying its settings.
+// The default branch found by the clone is saved only if no ref has been set meanwhile.
+// The state is discarded if the url or the scope of the repository has changed meanwhile because it has been read from
+// the previous remote or with the previous scope. The next sync starts again from the state reset by the change.
+func (m *Repository) UpdateSyncState(ctx context.Context, r entity.Repository) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("repository")
+	}
+
+	model := mappers.RepoEntityToModel(r)
+
+	tx := m.getDb(ctx).Begin()
+	result := tx.Model(&models.Repo{}).
+		Where("id = ? AND url = ? AND COALESCE(subpath, '') = ? AND COALESCE(include_globs, '') = ? AND COALESCE(exclude_globs, '') = ?",
+			r.Id, model.URL, model.Subpath.String, model.IncludeGlobs.String, model.ExcludeGlobs.String).
+		Select("local_path", "current_head_sha", "target_head_sha", "rejected_head_sha", "rejection_reason").
+		Updates(&model)
+	if err := result.Error; err != nil {
+		tx.Rollback()
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("repository")
+		}
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		exists, err := m.isExists(ctx, r.Id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errService.NewResourceNotFoundError("repository", r.Id)
+		}
+		zap.S().Debugw("url or scope of the repository changed during the sync. sync state discarded", "repo_id", r.Id)
+		return nil
+	}
+
+	if r.Branch != "" {
+		err := tx.Model(&models.Repo{}).
+			Where("id = ? AND COALESCE(branch, '') = '' AND COALESCE(tag_pattern, '') = '' AND COALESCE(commit_sha, '') = ''", r.Id).
+			Update("branch", r.Branch).Error
+		if err != nil {
+			tx.Rollback()
+			if m.checkNetworkError(err) {
+				return errService.NewPostgresNotAvailableError("repository")
+			}
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// DeleteRepository removes the repository. Its manifests and their relations are removed with it.
+func (m *Repository) DeleteRepository(ctx context.Context, id string) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("repository")
+	}
+
+	tx := m.getDb(ctx).Where("id = ?", id).Delete(&models.Repo{})
+	if err := tx.Error; err != nil {
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("repository")
+		}
+		return err
+	}
+
+	if tx.RowsAffected == 0 {
+		return errService.NewResourceNotFoundError("repository", id)
+	}
+
+	return nil
+}
+
+// UpdatePullPeriod saves the pull period and the next sync of the repository without modifying the other fields.
+func (m *Repository) UpdatePullPeriod(ctx context.Context, id string, period time.Duration, next time.Time) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("repository")
+	}
+
+	tx := m.getDb(ctx).Model(&models.Repo{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"pull_period_seconds": int64(period.Seconds()),
+		"next_sync_at":        sql.NullTime{Valid: !next.IsZero(), Time: next},
+	})
+	if err := tx.Error; err != nil {
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("repository")
+		}
+		return err
+	}
+
+	if tx.RowsAffected == 0 {
+		return errService.NewResourceNotFoundError("repository", id)
+	}
+
+	return nil
+}
+
+// SetNextSync sets the time of the next pull of the repository without modifying the other fields.
+// The time is set only if the next pull is still at previous so a sync requested meanwhile, e.g. by a change of the settings, is kept.
+func (m *Repository) SetNextSync(ctx context.Context, id string, previous, next time.Time) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("repository")
+	}
+
+	tx := m.getDb(ctx).Model(&models.Repo{}).Where("id = ?", id)
+	if previous.IsZero() {
+		tx = tx.Where("next_sync_at IS NULL")
+	} else {
+		tx = tx.Where("next_sync_at = ?", previous)
+	}
+
+	tx = tx.Update("next_sync_at", sql.NullTime{Valid: !next.IsZero(), Time: next})
+	if err := tx.Error; err != nil {
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("repository")
+		}
+		return err
+	}
+
+	if tx.RowsAffected == 0 {
+		exists, err := m.isExists(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errService.NewResourceNotFoundError("repository", id)
+		}
+		zap.S().Debugw("next sync of the repository changed meanwhile. keeping it", "repo_id", id)
+	}
+
+	return nil
+}
+
+func (m *Repository) isExists(ctx context.Context, id string) (bool, error) {
+	var model models.Repo
+	if err := m.getDb(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		if m.checkNetworkError(err) {
+			return false, errService.NewPostgresNotAvailableError("repository")
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func (d *Repository) checkNetworkError(err error) (isOpen bool) {
 	isOpen = d.circuitBreaker.BreakOnNetworkError(err)
 	if isOpen {
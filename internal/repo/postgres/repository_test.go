@@ -11,6 +11,7 @@ import (
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 	models "github.com/tupyy/tinyedge-controller/internal/repo/models/pg"
 	pgRepo "github.com/tupyy/tinyedge-controller/internal/repo/postgres"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
 	"gorm.io/gorm"
 )
 
@@ -103,6 +104,253 @@ var _ = Describe("Repository", Ordered, func() {
 			Expect(r).To(Equal(initialRepo))
 		})
 
+		It("sets successfully the next sync of a repo", func() {
+			initialRepo := entity.Repository{
+				Id:         "repo",
+				AuthType:   entity.NoRepositoryAuthType,
+				Url:        "url",
+				PullPeriod: 2 * time.Second,
+			}
+			err := repo.InsertRepository(context.TODO(), initialRepo)
+			Expect(err).To(BeNil())
+
+			next := time.Now().Add(time.Minute)
+			err = repo.SetNextSync(context.TODO(), "repo", time.Time{}, next)
+			Expect(err).To(BeNil())
+
+			r, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(r.NextSyncAt).To(BeTemporally("~", next, time.Second))
+			Expect(r.Url).To(Equal("url"))
+
+			// the next sync has been changed since it was read so it is kept
+			err = repo.SetNextSync(context.TODO(), "repo", time.Time{}, next.Add(time.Hour))
+			Expect(err).To(BeNil())
+
+			r, err = repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(r.NextSyncAt).To(BeTemporally("~", next, time.Second))
+
+			err = repo.SetNextSync(context.TODO(), "unknown", time.Time{}, next)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("updates the pull period without modifying the sync state", func() {
+			initialRepo := entity.Repository{
+				Id:             "repo",
+				AuthType:       entity.NoRepositoryAuthType,
+				Url:            "url",
+				CurrentHeadSha: "current",
+				PullPeriod:     2 * time.Second,
+			}
+			err := repo.InsertRepository(context.TODO(), initialRepo)
+			Expect(err).To(BeNil())
+
+			next := time.Now()
+			err = repo.UpdatePullPeriod(context.TODO(), "repo", time.Minute, next)
+			Expect(err).To(BeNil())
+
+			r, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(r.PullPeriod).To(Equal(time.Minute))
+			Expect(r.NextSyncAt).To(BeTemporally("~", next, time.Second))
+			Expect(r.CurrentHeadSha).To(Equal("current"))
+
+			err = repo.UpdatePullPeriod(context.TODO(), "repo", 0, time.Time{})
+			Expect(err).To(BeNil())
+
+			r, err = repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(r.IsPaused()).To(BeTrue())
+			Expect(r.NextSyncAt.IsZero()).To(BeTrue())
+
+			err = repo.UpdatePullPeriod(context.TODO(), "unknown", time.Minute, next)
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("stores no next sync for a repository never scheduled", func() {
+			err := repo.InsertRepository(context.TODO(), entity.Repository{Id: "repo", Url: "url", AuthType: entity.NoRepositoryAuthType})
+			Expect(err).To(BeNil())
+
+			r := models.Repo{}
+			err = gormDB.Raw("select * from repo where id = 'repo';").Scan(&r).Error
+			Expect(err).To(BeNil())
+			Expect(r.NextSyncAt.Valid).To(BeFalse())
+
+			e, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(e.NextSyncAt.IsZero()).To(BeTrue())
+		})
+
+		It("saves the sync state without reverting the settings changed meanwhile", func() {
+			initialRepo := entity.Repository{
+				Id:         "repo",
+				AuthType:   entity.NoRepositoryAuthType,
+				Url:        "url",
+				PullPeriod: 2 * time.Second,
+			}
+			err := repo.InsertRepository(context.TODO(), initialRepo)
+			Expect(err).To(BeNil())
+
+			// the settings are changed while the repository is synced
+			changed := initialRepo
+			changed.WebhookSecretPath = "webhook"
+			changed.TagPattern = "v*"
+			err = repo.UpdateRepository(context.TODO(), changed)
+			Expect(err).To(BeNil())
+
+			synced := initialRepo
+			synced.LocalPath = "/test"
+			synced.Branch = "main"
+			synced.CurrentHeadSha = "current"
+			synced.TargetHeadSha = "target"
+			synced.RejectedHeadSha = "rejected"
+			synced.RejectionReason = "unsigned"
+			err = repo.UpdateSyncState(context.TODO(), synced)
+			Expect(err).To(BeNil())
+
+			r, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(r.WebhookSecretPath).To(Equal("webhook"))
+			Expect(r.TagPattern).To(Equal("v*"))
+			Expect(r.Branch).To(BeEmpty())
+			Expect(r.LocalPath).To(Equal("/test"))
+			Expect(r.CurrentHeadSha).To(Equal("current"))
+			Expect(r.TargetHeadSha).To(Equal("target"))
+			Expect(r.RejectedHeadSha).To(Equal("rejected"))
+			Expect(r.RejectionReason).To(Equal("unsigned"))
+
+			err = repo.UpdateSyncState(context.TODO(), entity.Repository{Id: "unknown"})
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("updates the settings of a repository while it is synced", func() {
+			initialRepo := entity.Repository{
+				Id:             "repo",
+				AuthType:       entity.NoRepositoryAuthType,
+				Url:            "url",
+				LocalPath:      "/test",
+				CurrentHeadSha: "current",
+				TargetHeadSha:  "current",
+				PullPeriod:     2 * time.Second,
+				NextSyncAt:     time.Now().Add(time.Minute),
+			}
+			err := repo.InsertRepository(context.TODO(), initialRepo)
+			Expect(err).To(BeNil())
+
+			// the sync reads the repository before the settings are changed
+			synced, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+
+			changed := synced
+			changed.Url = "newurl"
+			changed.LocalPath = ""
+			changed.CurrentHeadSha = ""
+			changed.TargetHeadSha = ""
+			changed.NextSyncAt = time.Now()
+			err = repo.UpdateSettings(context.TODO(), changed, entity.CloneRepositoryReset)
+			Expect(err).To(BeNil())
+
+			// the sync finishes with the state read from the previous remote
+			synced.CurrentHeadSha = "target"
+			synced.TargetHeadSha = "target"
+			err = repo.UpdateSyncState(context.TODO(), synced)
+			Expect(err).To(BeNil())
+			err = repo.SetNextSync(context.TODO(), "repo", synced.NextSyncAt, time.Now().Add(time.Hour))
+			Expect(err).To(BeNil())
+
+			r, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(r.Url).To(Equal("newurl"))
+			Expect(r.LocalPath).To(BeEmpty())
+			Expect(r.CurrentHeadSha).To(BeEmpty())
+			Expect(r.TargetHeadSha).To(BeEmpty())
+			Expect(r.IsSyncDue(time.Now())).To(BeTrue())
+		})
+
+		It("updates the settings of a repository without modifying its sync state", func() {
+			initialRepo := entity.Repository{
+				Id:             "repo",
+				AuthType:       entity.NoRepositoryAuthType,
+				Url:            "url",
+				LocalPath:      "/test",
+				CurrentHeadSha: "current",
+				TargetHeadSha:  "current",
+				PullPeriod:     2 * time.Second,
+			}
+			err := repo.InsertRepository(context.TODO(), initialRepo)
+			Expect(err).To(BeNil())
+
+			changed := initialRepo
+			changed.SigningKeysSecretPath = "keys"
+			changed.CurrentHeadSha = "stale"
+			err = repo.UpdateSettings(context.TODO(), changed, entity.NoRepositoryReset)
+			Expect(err).To(BeNil())
+
+			r, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(r.SigningKeysSecretPath).To(Equal("keys"))
+			Expect(r.CurrentHeadSha).To(Equal("current"))
+
+			err = repo.UpdateSettings(context.TODO(), entity.Repository{Id: "unknown", Url: "url"}, entity.NoRepositoryReset)
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("updates the reference of a repository without reverting a sync finished meanwhile", func() {
+			initialRepo := entity.Repository{
+				Id:             "repo",
+				AuthType:       entity.NoRepositoryAuthType,
+				Url:            "url",
+				Branch:         "main",
+				LocalPath:      "/test",
+				CurrentHeadSha: "current",
+				TargetHeadSha:  "current",
+				PullPeriod:     2 * time.Second,
+			}
+			err := repo.InsertRepository(context.TODO(), initialRepo)
+			Expect(err).To(BeNil())
+
+			changed, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+
+			synced := initialRepo
+			synced.CurrentHeadSha = "new"
+			synced.TargetHeadSha = "new"
+			err = repo.UpdateSyncState(context.TODO(), synced)
+			Expect(err).To(BeNil())
+
+			changed.Branch = ""
+			changed.TagPattern = "v*"
+			changed.NextSyncAt = time.Now()
+			err = repo.UpdateRef(context.TODO(), changed)
+			Expect(err).To(BeNil())
+
+			r, err := repo.GetRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(r.Branch).To(BeEmpty())
+			Expect(r.TagPattern).To(Equal("v*"))
+			Expect(r.CurrentHeadSha).To(Equal("new"))
+			Expect(r.TargetHeadSha).To(BeEmpty())
+			Expect(r.IsSyncDue(time.Now())).To(BeTrue())
+
+			err = repo.UpdateRef(context.TODO(), entity.Repository{Id: "unknown"})
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("deletes a repository", func() {
+			err := repo.InsertRepository(context.TODO(), entity.Repository{Id: "repo", Url: "url", AuthType: entity.NoRepositoryAuthType})
+			Expect(err).To(BeNil())
+
+			err = repo.DeleteRepository(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+
+			_, err = repo.GetRepository(context.TODO(), "repo")
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+
+			err = repo.DeleteRepository(context.TODO(), "repo")
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+
 		It("retrieve successfully a repo", func() {
 			initialRepo := entity.Repository{
 				Id:                    "repo",
@@ -143,6 +391,72 @@ var _ = Describe("Repository", Ordered, func() {
 		})
 	})
 
+	Context("sync history", func() {
+		It("returns the sync runs with their file errors starting with the most recent one", func() {
+			err := repo.InsertRepository(context.TODO(), entity.Repository{Id: "repo", Url: "url", AuthType: entity.NoRepositoryAuthType})
+			Expect(err).To(BeNil())
+
+			started := time.Now().UTC().Truncate(time.Second)
+			err = repo.InsertSyncRun(context.TODO(), entity.SyncRun{
+				ID:           "first",
+				RepositoryID: "repo",
+				StartedAt:    started,
+				FinishedAt:   started.Add(time.Second),
+				ToSha:        "sha1",
+				Manifests:    entity.ManifestChanges{Created: 2},
+			})
+			Expect(err).To(BeNil())
+
+			err = repo.InsertSyncRun(context.TODO(), entity.SyncRun{
+				ID:           "second",
+				RepositoryID: "repo",
+				StartedAt:    started.Add(time.Minute),
+				FinishedAt:   started.Add(time.Minute + time.Second),
+				FromSha:      "sha1",
+				ToSha:        "sha2",
+				Manifests: entity.ManifestChanges{
+					Updated:    1,
+					Deleted:    1,
+					FileErrors: []entity.ManifestFileError{{Path: "broken.manifest.yaml", Reason: "invalid yaml"}},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			runs, err := repo.GetSyncRuns(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(runs).To(HaveLen(2))
+			Expect(runs[0].ID).To(Equal("second"))
+			Expect(runs[0].FromSha).To(Equal("sha1"))
+			Expect(runs[0].Manifests.Deleted).To(Equal(1))
+			Expect(runs[0].Manifests.FileErrors).To(Equal([]entity.ManifestFileError{{Path: "broken.manifest.yaml", Reason: "invalid yaml"}}))
+			Expect(runs[1].ID).To(Equal("first"))
+			Expect(runs[1].Manifests.Created).To(Equal(2))
+			Expect(runs[1].Manifests.FileErrors).To(BeEmpty())
+		})
+
+		It("keeps only the last sync runs of a repository", func() {
+			err := repo.InsertRepository(context.TODO(), entity.Repository{Id: "repo", Url: "url", AuthType: entity.NoRepositoryAuthType})
+			Expect(err).To(BeNil())
+
+			started := time.Now().UTC().Truncate(time.Second)
+			for i := 0; i < 55; i++ {
+				err = repo.InsertSyncRun(context.TODO(), entity.SyncRun{
+					ID:           strconv.Itoa(i),
+					RepositoryID: "repo",
+					StartedAt:    started.Add(time.Duration(i) * time.Minute),
+					FinishedAt:   started.Add(time.Duration(i) * time.Minute),
+				})
+				Expect(err).To(BeNil())
+			}
+
+			runs, err := repo.GetSyncRuns(context.TODO(), "repo")
+			Expect(err).To(BeNil())
+			Expect(runs).To(HaveLen(50))
+			Expect(runs[0].ID).To(Equal("54"))
+			Expect(runs[49].ID).To(Equal("5"))
+		})
+	})
+
 	AfterEach(func() {
 		// clean the db
 		gormDB.Exec("DELETE FROM repo;")
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/internal/repo/models/mappers"
+	models "github.com/tupyy/tinyedge-controller/internal/repo/models/pg"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+)
+
+// maxSyncRuns is the number of sync runs kept for each repository.
+const maxSyncRuns = 50
+
+// InsertSyncRun saves the sync run and removes the oldest runs of the repository above maxSyncRuns.
+func (m *Repository) InsertSyncRun(ctx context.Context, run entity.SyncRun) error {
+	if !m.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("repository")
+	}
+
+	sync, fileErrors := mappers.SyncRunEntityToModel(run)
+
+	tx := m.getDb(ctx).Begin()
+
+	if err := tx.Create(&sync).Error; err != nil {
+		tx.Rollback()
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("repository")
+		}
+		return err
+	}
+
+	if len(fileErrors) > 0 {
+		if err := tx.Create(&fileErrors).Error; err != nil {
+			tx.Rollback()
+			if m.checkNetworkError(err) {
+				return errService.NewPostgresNotAvailableError("repository")
+			}
+			return err
+		}
+	}
+
+	kept := tx.Model(&models.RepoSync{}).Select("id").Where("repo_id = ?", run.RepositoryID).Order("started_at DESC").Limit(maxSyncRuns)
+	if err := tx.Where("repo_id = ? AND id NOT IN (?)", run.RepositoryID, kept).Delete(&models.RepoSync{}).Error; err != nil {
+		tx.Rollback()
+		if m.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("repository")
+		}
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// GetSyncRuns returns the sync runs of the repository starting with the most recent one.
+func (m *Repository) GetSyncRuns(ctx context.Context, repoID string) ([]entity.SyncRun, error) {
+	if !m.circuitBreaker.IsAvailable() {
+		return []entity.SyncRun{}, errService.NewPostgresNotAvailableError("repository")
+	}
+
+	syncs := []models.RepoSync{}
+	if err := m.getDb(ctx).Where("repo_id = ?", repoID).Order("started_at DESC").Find(&syncs).Error; err != nil {
+		if m.checkNetworkError(err) {
+			return []entity.SyncRun{}, errService.NewPostgresNotAvailableError("repository")
+		}
+		return []entity.SyncRun{}, err
+	}
+
+	if len(syncs) == 0 {
+		return []entity.SyncRun{}, nil
+	}
+
+	ids := make([]string, 0, len(syncs))
+	for _, s := range syncs {
+		ids = append(ids, s.ID)
+	}
+
+	fileErrors := []models.RepoSyncFileError{}
+	if err := m.getDb(ctx).Where("sync_id IN ?", ids).Order("path").Find(&fileErrors).Error; err != nil {
+		if m.checkNetworkError(err) {
+			return []entity.SyncRun{}, errService.NewPostgresNotAvailableError("repository")
+		}
+		return []entity.SyncRun{}, err
+	}
+
+	errorsBySync := make(map[string][]models.RepoSyncFileError)
+	for _, f := range fileErrors {
+		errorsBySync[f.SyncID] = append(errorsBySync[f.SyncID], f)
+	}
+
+	runs := make([]entity.SyncRun, 0, len(syncs))
+	for _, s := range syncs {
+		runs = append(runs, mappers.SyncRunModelToEntity(s, errorsBySync[s.ID]))
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pgclient "github.com/tupyy/tinyedge-controller/internal/clients/pg"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/internal/repo/models/mappers"
+	models "github.com/tupyy/tinyedge-controller/internal/repo/models/pg"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type EnrolmentTokenRepo struct {
+	db             *gorm.DB
+	client         pgclient.Client
+	circuitBreaker pgclient.CircuitBreaker
+}
+
+func NewEnrolmentTokenRepo(client pgclient.Client) (*EnrolmentTokenRepo, error) {
+	config := gorm.Config{
+		SkipDefaultTransaction: true, // No need transaction for those use cases.
+	}
+
+	gormDB, err := client.Open(config)
+	if err != nil {
+		return &EnrolmentTokenRepo{}, err
+	}
+
+	return &EnrolmentTokenRepo{gormDB, client, client.GetCircuitBreaker()}, nil
+}
+
+func (e *EnrolmentTokenRepo) GetToken(ctx context.Context, id string) (entity.EnrolmentToken, error) {
+	if !e.circuitBreaker.IsAvailable() {
+		return entity.EnrolmentToken{}, errService.NewPostgresNotAvailableError("enrolment token repository")
+	}
+
+	token := models.EnrolmentToken{}
+
+	if err := e.getDb(ctx).Where("id = ?", id).First(&token).Error; err != nil {
+		if e.checkNetworkError(err) {
+			return entity.EnrolmentToken{}, errService.NewPostgresNotAvailableError("enrolment token repository")
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity.EnrolmentToken{}, errService.NewResourceNotFoundError("enrolment token", id)
+		}
+		return entity.EnrolmentToken{}, err
+	}
+
+	return mappers.EnrolmentTokenModelToEntity(token), nil
+}
+
+func (e *EnrolmentTokenRepo) GetTokens(ctx context.Context) ([]entity.EnrolmentToken, error) {
+	if !e.circuitBreaker.IsAvailable() {
+		return []entity.EnrolmentToken{}, errService.NewPostgresNotAvailableError("enrolment token repository")
+	}
+
+	tokens := []models.EnrolmentToken{}
+
+	if err := e.getDb(ctx).Order("created_at").Find(&tokens).Error; err != nil {
+		if e.checkNetworkError(err) {
+			return []entity.EnrolmentToken{}, errService.NewPostgresNotAvailableError("enrolment token repository")
+		}
+		return []entity.EnrolmentToken{}, err
+	}
+
+	entities := make([]entity.EnrolmentToken, 0, len(tokens))
+	for _, t := range tokens {
+		entities = append(entities, mappers.EnrolmentTokenModelToEntity(t))
+	}
+
+	return entities, nil
+}
+
+func (e *EnrolmentTokenRepo) CreateToken(ctx context.Context, token entity.EnrolmentToken) error {
+	if !e.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("enrolment token repository")
+	}
+
+	model := mappers.EnrolmentTokenEntityToModel(token)
+
+	if err := e.getDb(ctx).Create(&model).Error; err != nil {
+		if e.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("enrolment token repository")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (e *EnrolmentTokenRepo) DeleteToken(ctx context.Context, id string) error {
+	if !e.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("enrolment token repository")
+	}
+
+	if err := e.getDb(ctx).Where("id = ?", id).Delete(&models.EnrolmentToken{}).Error; err != nil {
+		if e.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("enrolment token repository")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ConsumeToken increments the number of uses of the token if the token is neither expired nor exhausted.
+// The check and the increment are done in one statement so a token cannot be used more than max_uses times.
+func (e *EnrolmentTokenRepo) ConsumeToken(ctx context.Context, hash string) (entity.EnrolmentToken, error) {
+	if !e.circuitBreaker.IsAvailable() {
+		return entity.EnrolmentToken{}, errService.NewPostgresNotAvailableError("enrolment token repository")
+	}
+
+	tx := e.getDb(ctx).Model(&models.EnrolmentToken{}).
+		Where("token_hash = ? AND uses < max_uses AND expires_at > ?", hash, time.Now().UTC()).
+		Update("uses", gorm.Expr("uses + 1"))
+	if err := tx.Error; err != nil {
+		if e.checkNetworkError(err) {
+			return entity.EnrolmentToken{}, errService.NewPostgresNotAvailableError("enrolment token repository")
+		}
+		return entity.EnrolmentToken{}, err
+	}
+
+	if tx.RowsAffected == 0 {
+		return entity.EnrolmentToken{}, errService.NewResourceNotFoundErrorWithReason("enrolment token not found, expired or exhausted")
+	}
+
+	token := models.EnrolmentToken{}
+	if err := e.getDb(ctx).Where("token_hash = ?", hash).First(&token).Error; err != nil {
+		if e.checkNetworkError(err) {
+			return entity.EnrolmentToken{}, errService.NewPostgresNotAvailableError("enrolment token repository")
+		}
+		return entity.EnrolmentToken{}, err
+	}
+
+	return mappers.EnrolmentTokenModelToEntity(token), nil
+}
+
+// ReleaseToken decrements the number of uses of the token.
+func (e *EnrolmentTokenRepo) ReleaseToken(ctx context.Context, hash string) error {
+	if !e.circuitBreaker.IsAvailable() {
+		return errService.NewPostgresNotAvailableError("enrolment token repository")
+	}
+
+	err := e.getDb(ctx).Model(&models.EnrolmentToken{}).
+		Where("token_hash = ? AND uses > 0", hash).
+		Update("uses", gorm.Expr("uses - 1")).Error
+	if err != nil {
+		if e.checkNetworkError(err) {
+			return errService.NewPostgresNotAvailableError("enrolment token repository")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (e *EnrolmentTokenRepo) checkNetworkError(err error) (isOpen bool) {
+	isOpen = e.circuitBreaker.BreakOnNetworkError(err)
+	if isOpen {
+		zap.S().Warn("circuit breaker is now open")
+	}
+	return
+}
+
+func (e *EnrolmentTokenRepo) getDb(ctx context.Context) *gorm.DB {
+	return e.db.Session(&gorm.Session{SkipHooks: true}).WithContext(ctx)
+}
@@ -0,0 +1,116 @@
+package postgres_test
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tupyy/tinyedge-controller/internal/clients/pg"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	pgRepo "github.com/tupyy/tinyedge-controller/internal/repo/postgres"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Enrolment token", Ordered, func() {
+	var (
+		pgClient  pg.Client
+		rawClient pg.Client
+		repo      *pgRepo.EnrolmentTokenRepo
+		gormDB    *gorm.DB
+	)
+
+	BeforeAll(func() {
+		var err error
+		port, _ := strconv.Atoi(getEnvVar("POSTGRES_PORT", "5433"))
+		pgClient, err = pg.New(pg.ClientParams{
+			Host:     getEnvVar("POSTGRES_HOST", "localhost"),
+			Port:     uint(port),
+			DBName:   getEnvVar("POSTGRES_DB", "tinyedge"),
+			User:     getEnvVar("POSTGRES_USER", "postgres"),
+			Password: getEnvVar("POSTGRES_PWD", "postgres"),
+		})
+		Expect(err).To(BeNil())
+
+		rawClient, err = pg.New(pg.ClientParams{
+			Host:     getEnvVar("POSTGRES_HOST", "localhost"),
+			Port:     uint(port),
+			DBName:   getEnvVar("POSTGRES_DB", "tinyedge"),
+			User:     getEnvVar("POSTGRES_USER", "postgres"),
+			Password: getEnvVar("POSTGRES_PWD", "postgres"),
+		})
+		Expect(err).To(BeNil())
+
+		repo, err = pgRepo.NewEnrolmentTokenRepo(pgClient)
+		Expect(err).To(BeNil())
+
+		config := gorm.Config{
+			SkipDefaultTransaction: true, // No need transaction for those use cases.
+		}
+
+		gormDB, err = rawClient.Open(config)
+		Expect(err).To(BeNil())
+	})
+
+	BeforeEach(func() {
+		err := gormDB.Exec(`INSERT INTO namespace (id, is_default) VALUES ('namespace', true);`).Error
+		Expect(err).To(BeNil())
+	})
+
+	Context("consume", func() {
+		It("consumes the token until it is exhausted", func() {
+			now := time.Now().UTC()
+			err := repo.CreateToken(context.TODO(), entity.EnrolmentToken{
+				ID:          "id",
+				Hash:        "hash",
+				NamespaceID: "namespace",
+				MaxUses:     2,
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(time.Hour),
+			})
+			Expect(err).To(BeNil())
+
+			t, err := repo.ConsumeToken(context.TODO(), "hash")
+			Expect(err).To(BeNil())
+			Expect(t.ID).To(Equal("id"))
+			Expect(t.NamespaceID).To(Equal("namespace"))
+			Expect(t.Uses).To(Equal(1))
+
+			t, err = repo.ConsumeToken(context.TODO(), "hash")
+			Expect(err).To(BeNil())
+			Expect(t.Uses).To(Equal(2))
+
+			_, err = repo.ConsumeToken(context.TODO(), "hash")
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("does not consume an expired token", func() {
+			now := time.Now().UTC()
+			err := repo.CreateToken(context.TODO(), entity.EnrolmentToken{
+				ID:          "id",
+				Hash:        "hash",
+				NamespaceID: "namespace",
+				MaxUses:     1,
+				CreatedAt:   now.Add(-2 * time.Hour),
+				ExpiresAt:   now.Add(-time.Hour),
+			})
+			Expect(err).To(BeNil())
+
+			_, err = repo.ConsumeToken(context.TODO(), "hash")
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("does not consume an unknown token", func() {
+			_, err := repo.ConsumeToken(context.TODO(), "unknown")
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+	})
+
+	AfterEach(func() {
+		// clean the db
+		gormDB.Exec("DELETE FROM enrolment_token;")
+		gormDB.Exec("DELETE FROM namespace;")
+	})
+})
@@ -113,6 +113,39 @@ func (c *CertficateRepo) SignCSR(ctx context.Context, csr []byte, cn string, ttl
 	return certificate, nil
 }
 
+// GetCRL returns the PEM encoded certificate revocation list of the PKI mount.
+func (c *CertficateRepo) GetCRL(ctx context.Context) ([]byte, error) {
+	pathToRead := fmt.Sprintf("%s/cert/crl", c.certificateMountPath)
+
+	secret, err := c.vault.Client.Logical().ReadWithContext(ctx, pathToRead)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	if secret == nil {
+		return []byte{}, errService.NewResourceNotFoundError("crl", c.certificateMountPath)
+	}
+
+	return extract(secret, "certificate")
+}
+
+// RevokeCertificate revokes the certificate. The serial number is expected to be formatted as colon separated hex pairs.
+func (c *CertficateRepo) RevokeCertificate(ctx context.Context, sn string) error {
+	pathToWrite := fmt.Sprintf("%s/revoke", c.certificateMountPath)
+
+	data := map[string]interface{}{
+		"serial_number": sn,
+	}
+
+	if _, err := c.vault.Client.Logical().WriteWithContext(ctx, pathToWrite, data); err != nil {
+		return err
+	}
+
+	zap.S().Debugw("certificate revoked", "serial_number", sn)
+
+	return nil
+}
+
 func extract(secret *vvault.Secret, key string) ([]byte, error) {
 	data, ok := secret.Data[key]
 	if !ok {
@@ -5,11 +5,14 @@ import (
 	"context"
 	"crypto/sha512"
 	"fmt"
+	"strings"
 
 	"github.com/tupyy/tinyedge-controller/internal/clients/vault"
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 )
 
+const gpgPublicKeyBlockEnd = "-----END PGP PUBLIC KEY BLOCK-----"
+
 type SecretRepository struct {
 	vault      *vault.Vault
 	enginePath string
@@ -48,6 +51,38 @@ func (r *SecretRepository) GetSecret(ctx context.Context, path, key string) (ent
 	return e, nil
 }
 
+// GetSigningKeys returns the keys trusted to sign the commits of a repository. The secret holds the armored gpg public keys
+// under the "gpg_keys" key and the ssh public keys in the authorized_keys format under the "ssh_keys" key.
+func (r *SecretRepository) GetSigningKeys(ctx context.Context, path string) (entity.SigningKeys, error) {
+	secret, err := r.vault.Client.KVv2(r.enginePath).Get(ctx, path)
+	if err != nil {
+		return entity.SigningKeys{}, fmt.Errorf("unable to read secret: %w", err)
+	}
+
+	keys := entity.SigningKeys{}
+	if data, ok := secret.Data["gpg_keys"].(string); ok {
+		for _, block := range strings.SplitAfter(data, gpgPublicKeyBlockEnd) {
+			if strings.Contains(block, gpgPublicKeyBlockEnd) {
+				keys.GPG = append(keys.GPG, strings.TrimSpace(block))
+			}
+		}
+	}
+	if data, ok := secret.Data["ssh_keys"].(string); ok {
+		for _, line := range strings.Split(data, "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" && !strings.HasPrefix(line, "#") {
+				keys.SSH = append(keys.SSH, line)
+			}
+		}
+	}
+
+	if keys.IsEmpty() {
+		return entity.SigningKeys{}, fmt.Errorf("no signing key found in secret %q", path)
+	}
+
+	return keys, nil
+}
+
 func (r *SecretRepository) compuateHash(path, key, value string) string {
 	hash := sha512.New()
 	hash.Write(bytes.NewBufferString(fmt.Sprintf("%s%s%s", path, key, value)).Bytes())
@@ -3,14 +3,19 @@ package servers
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 	"github.com/tupyy/tinyedge-controller/internal/servers/mappers"
+	"github.com/tupyy/tinyedge-controller/internal/services/auth"
 	"github.com/tupyy/tinyedge-controller/internal/services/configuration"
 	"github.com/tupyy/tinyedge-controller/internal/services/device"
 	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
 	"github.com/tupyy/tinyedge-controller/internal/services/manifest"
 	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+	"github.com/tupyy/tinyedge-controller/internal/services/token"
+	"github.com/tupyy/tinyedge-controller/internal/services/workload"
 	"github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
 	pb "github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
 	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
@@ -25,10 +30,13 @@ type AdminServer struct {
 	manifestService   *manifest.Service
 	deviceService     *device.Service
 	confService       *configuration.Service
+	authService       *auth.Service
+	tokenService      *token.Service
+	workloadService   *workload.Service
 }
 
-func NewAdminServer(r *repository.Service, m *manifest.Service, d *device.Service, c *configuration.Service) *AdminServer {
-	return &AdminServer{repositoryService: r, manifestService: m, deviceService: d, confService: c}
+func NewAdminServer(r *repository.Service, m *manifest.Service, d *device.Service, c *configuration.Service, a *auth.Service, t *token.Service, w *workload.Service) *AdminServer {
+	return &AdminServer{repositoryService: r, manifestService: m, deviceService: d, confService: c, authService: a, tokenService: t, workloadService: w}
 }
 
 func (a *AdminServer) GetDevices(ctx context.Context, req *pb.DevicesListRequest) (*pb.DevicesListResponse, error) {
@@ -99,6 +107,147 @@ func (a *AdminServer) UpdateDevice(ctx context.Context, req *pb.UpdateDeviceRequ
 	return mappers.DeviceToProto(device), nil
 }
 
+// GetPendingDevices returns the list of devices waiting for enrolment approval.
+func (a *AdminServer) GetPendingDevices(ctx context.Context, req *pb.ListRequest) (*pb.DevicesListResponse, error) {
+	devices, err := a.deviceService.GetPendingDevices(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	models := make([]*common.Device, 0, len(devices))
+	for _, d := range devices {
+		models = append(models, mappers.DeviceToProto(d))
+	}
+
+	return &pb.DevicesListResponse{
+		Devices: models,
+		Size:    int32(len(models)),
+		Total:   int32(len(models)),
+		Page:    1,
+	}, nil
+}
+
+// ApproveDevice enrols a pending device.
+func (a *AdminServer) ApproveDevice(ctx context.Context, req *pb.ApproveDeviceRequest) (*common.Device, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	device, err := a.deviceService.ApproveDevice(ctx, req.Id, req.GetNamespaceId(), req.GetSetId())
+	if err != nil {
+		switch err.(type) {
+		case errService.ResourseNotFoundError:
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		case errService.InvalidEnrolStatusError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		default:
+			zap.S().Errorw("unable to approve device", "error", err, "device_id", req.Id)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mappers.DeviceToProto(device), nil
+}
+
+// RefuseDevice refuses the enrolment of a pending device.
+func (a *AdminServer) RefuseDevice(ctx context.Context, req *pb.IdRequest) (*common.Device, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	device, err := a.deviceService.RefuseDevice(ctx, req.Id)
+	if err != nil {
+		switch err.(type) {
+		case errService.ResourseNotFoundError:
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		case errService.InvalidEnrolStatusError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		default:
+			zap.S().Errorw("unable to refuse device", "error", err, "device_id", req.Id)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return mappers.DeviceToProto(device), nil
+}
+
+// DecommissionDevice revokes the certificate of the device and removes it.
+func (a *AdminServer) DecommissionDevice(ctx context.Context, req *pb.DecommissionDeviceRequest) (*common.Device, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	device, err := a.deviceService.DecommissionDevice(ctx, req.Id, req.KeepTombstone)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		zap.S().Errorw("unable to decommission device", "error", err, "device_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return mappers.DeviceToProto(device), nil
+}
+
+func (a *AdminServer) UpdateDeviceLabels(ctx context.Context, req *pb.UpdateDeviceLabelsRequest) (*common.Device, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	for k := range req.Labels {
+		if k == "" {
+			return nil, status.Error(codes.InvalidArgument, "label key cannot be empty")
+		}
+	}
+
+	for _, k := range req.RemoveLabels {
+		if k == "" {
+			return nil, status.Error(codes.InvalidArgument, "label key cannot be empty")
+		}
+	}
+
+	device, err := a.deviceService.UpdateDeviceLabels(ctx, req.Id, req.Labels, req.RemoveLabels)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		zap.S().Errorw("unable to update device labels", "error", err, "device_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return mappers.DeviceToProto(device), nil
+}
+
+func (a *AdminServer) UpdateVariables(ctx context.Context, req *pb.UpdateVariablesRequest) (*pb.Variables, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	for k := range req.Variables {
+		if k == "" {
+			return nil, status.Error(codes.InvalidArgument, "variable key cannot be empty")
+		}
+	}
+
+	for _, k := range req.RemoveVariables {
+		if k == "" {
+			return nil, status.Error(codes.InvalidArgument, "variable key cannot be empty")
+		}
+	}
+
+	target := mappers.VariablesTargetFromProto(req.Target)
+	variables, err := a.deviceService.UpdateVariables(ctx, target, req.Id, req.Variables, req.RemoveVariables)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		zap.S().Errorw("unable to update variables", "error", err, "target", target.String(), "id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &pb.Variables{Target: req.Target, Id: req.Id, Variables: variables}, nil
+}
+
 func (a *AdminServer) AddSet(ctx context.Context, req *pb.AddSetRequest) (*common.Set, error) {
 	if req.Id == "" || req.NamespaceId == "" {
 		return nil, status.Error(codes.InvalidArgument, "set name or namespace id is missing")
@@ -315,6 +464,48 @@ func (a *AdminServer) GetManifest(ctx context.Context, req *pb.IdRequest) (*pb.M
 	return mappers.ManifestToProto(manifest), nil
 }
 
+func (a *AdminServer) GetManifestRollout(ctx context.Context, req *pb.IdRequest) (*pb.ManifestRollout, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id must be present")
+	}
+
+	rollout, err := a.workloadService.GetManifestRollout(ctx, req.Id)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		zap.S().Errorw("unable to get manifest rollout", "error", err, "manifest_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return mappers.ManifestRolloutToProto(rollout), nil
+}
+
+func (a *AdminServer) GetDeviceWorkloads(ctx context.Context, req *pb.IdRequest) (*pb.DeviceWorkloadsResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	deployments, err := a.workloadService.GetDeviceWorkloads(ctx, req.Id)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		zap.S().Errorw("unable to get device workloads", "error", err, "device_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &pb.DeviceWorkloadsResponse{
+		DeviceId:  req.Id,
+		Workloads: make([]*pb.WorkloadDeployment, 0, len(deployments)),
+	}
+	for _, d := range deployments {
+		resp.Workloads = append(resp.Workloads, mappers.WorkloadDeploymentToProto(d))
+	}
+
+	return resp, nil
+}
+
 // GetRepositories return a list of repositories
 func (a *AdminServer) GetRepositories(ctx context.Context, req *pb.ListRequest) (*pb.RepositoryListResponse, error) {
 	repos, err := a.repositoryService.GetRepositories(ctx)
@@ -341,20 +532,35 @@ func (a *AdminServer) GetRepositories(ctx context.Context, req *pb.ListRequest)
 
 // AddRepository add a repository
 func (a *AdminServer) AddRepository(ctx context.Context, req *pb.AddRepositoryRequest) (*pb.AddRepositoryResponse, error) {
+	if req.PullPeriod < 0 || req.PullPeriod > math.MaxInt16 {
+		return nil, status.Errorf(codes.InvalidArgument, "pull period must be between 0 and %d seconds", math.MaxInt16)
+	}
+
 	repo := entity.Repository{
-		Url: req.Url,
-		Id:  req.Name,
+		Url:                   req.Url,
+		Id:                    req.Name,
+		WebhookSecretPath:     req.WebhookSecretPath,
+		SigningKeysSecretPath: req.SigningKeysSecretPath,
+		PullPeriod:            entity.DefaultPullPeriod,
+		Branch:                req.Branch,
+		TagPattern:            req.TagPattern,
+		Commit:                req.Commit,
+		Subpath:               req.Subpath,
+		IncludeGlobs:          req.IncludeGlobs,
+		ExcludeGlobs:          req.ExcludeGlobs,
 	}
 
-	switch req.AuthMethod {
-	case "ssh":
-		repo.AuthType = entity.SSHRepositoryAuthType
-		repo.CredentialsSecretPath = req.AuthSecretPath
-	case "token":
-		repo.AuthType = entity.TokenRepositoryAuthType
+	if req.PullPeriod > 0 {
+		repo.PullPeriod = time.Duration(req.PullPeriod) * time.Second
+	}
+
+	authType, ok := repositoryAuthType(req.AuthMethod)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "auth method %q unknown", req.AuthMethod)
+	}
+	repo.AuthType = authType
+	if authType != entity.NoRepositoryAuthType {
 		repo.CredentialsSecretPath = req.AuthSecretPath
-	default:
-		repo.AuthType = entity.NoRepositoryAuthType
 	}
 
 	if err := a.repositoryService.Add(ctx, repo); err != nil {
@@ -366,3 +572,243 @@ func (a *AdminServer) AddRepository(ctx context.Context, req *pb.AddRepositoryRe
 		Name: req.Name,
 	}, nil
 }
+
+// UpdateRepositoryRef changes the branch, the tag pattern or the commit followed by a repository.
+func (a *AdminServer) UpdateRepositoryRef(ctx context.Context, req *pb.UpdateRepositoryRefRequest) (*pb.Repository, error) {
+	repo, err := a.repositoryService.UpdateRef(ctx, req.Id, req.Branch, req.TagPattern, req.Commit)
+	if err != nil {
+		switch {
+		case errService.IsResourceNotFound(err):
+			return nil, status.Errorf(codes.NotFound, "repository %q not found", req.Id)
+		case errService.IsInvalidRepositoryRef(err):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		zap.S().Errorw("unable to update git reference of repository", "error", err, "repo_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return mappers.RepositoryToModel(repo), nil
+}
+
+// UpdateRepositoryPullPeriod changes the pull period of a repository. A period of 0 pauses the repository.
+func (a *AdminServer) UpdateRepositoryPullPeriod(ctx context.Context, req *pb.UpdateRepositoryPullPeriodRequest) (*pb.Repository, error) {
+	if req.PullPeriod < 0 || req.PullPeriod > math.MaxInt16 {
+		return nil, status.Errorf(codes.InvalidArgument, "pull period must be between 0 and %d seconds", math.MaxInt16)
+	}
+
+	repo, err := a.repositoryService.UpdatePullPeriod(ctx, req.Id, time.Duration(req.PullPeriod)*time.Second)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "repository %q not found", req.Id)
+		}
+		zap.S().Errorw("unable to update pull period of repository", "error", err, "repo_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return mappers.RepositoryToModel(repo), nil
+}
+
+// UpdateRepository changes the url, the credentials, the secret paths or the scope of a repository.
+func (a *AdminServer) UpdateRepository(ctx context.Context, req *pb.UpdateRepositoryRequest) (*pb.Repository, error) {
+	repo, err := a.repositoryService.GetRepository(ctx, req.Id)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "repository %q not found", req.Id)
+		}
+		zap.S().Errorw("unable to get repository", "error", err, "repo_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if req.Url != nil {
+		if req.GetUrl() == "" {
+			return nil, status.Error(codes.InvalidArgument, "url cannot be empty")
+		}
+		repo.Url = req.GetUrl()
+	}
+
+	if req.AuthMethod != nil {
+		authType, ok := repositoryAuthType(req.GetAuthMethod())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "auth method %q unknown", req.GetAuthMethod())
+		}
+		repo.AuthType = authType
+		if authType == entity.NoRepositoryAuthType {
+			repo.CredentialsSecretPath = ""
+		}
+	}
+
+	if req.AuthSecretPath != nil {
+		repo.CredentialsSecretPath = req.GetAuthSecretPath()
+	}
+
+	if repo.AuthType != entity.NoRepositoryAuthType && repo.CredentialsSecretPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "auth secret path is required by the auth method")
+	}
+
+	if req.WebhookSecretPath != nil {
+		repo.WebhookSecretPath = req.GetWebhookSecretPath()
+	}
+
+	if req.SigningKeysSecretPath != nil {
+		repo.SigningKeysSecretPath = req.GetSigningKeysSecretPath()
+	}
+
+	if req.Subpath != nil {
+		repo.Subpath = req.GetSubpath()
+	}
+
+	if req.IncludeGlobs != nil {
+		repo.IncludeGlobs = req.IncludeGlobs.Globs
+	}
+
+	if req.ExcludeGlobs != nil {
+		repo.ExcludeGlobs = req.ExcludeGlobs.Globs
+	}
+
+	repo, err = a.repositoryService.UpdateSettings(ctx, repo)
+	if err != nil {
+		switch {
+		case errService.IsResourceNotFound(err):
+			return nil, status.Errorf(codes.NotFound, "repository %q not found", req.Id)
+		case errService.IsInvalidRepositoryScope(err):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		zap.S().Errorw("unable to update repository", "error", err, "repo_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return mappers.RepositoryToModel(repo), nil
+}
+
+// DeleteRepository removes a repository, its manifests and its local clone.
+// Unless force is set, the repository is not deleted if its manifests are still deployed to devices.
+func (a *AdminServer) DeleteRepository(ctx context.Context, req *pb.DeleteRepositoryRequest) (*pb.Repository, error) {
+	repo, err := a.repositoryService.GetRepository(ctx, req.Id)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "repository %q not found", req.Id)
+		}
+		zap.S().Errorw("unable to get repository", "error", err, "repo_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if err := a.manifestService.DeleteManifests(ctx, repo, req.Force); err != nil {
+		if _, ok := err.(errService.DeleteResourceError); ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s. Use force to delete it anyway", err)
+		}
+		zap.S().Errorw("unable to delete manifests of repository", "error", err, "repo_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if err := a.repositoryService.Delete(ctx, repo); err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "repository %q not found", req.Id)
+		}
+		zap.S().Errorw("unable to delete repository", "error", err, "repo_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	zap.S().Infow("repository deleted", "repo_id", repo.Id, "repo_url", repo.Url, "force", req.Force)
+	return mappers.RepositoryToModel(repo), nil
+}
+
+// GetRepositorySyncHistory returns the last sync runs of a repository starting with the most recent one.
+func (a *AdminServer) GetRepositorySyncHistory(ctx context.Context, req *pb.IdRequest) (*pb.RepositorySyncHistory, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository id is required")
+	}
+
+	runs, err := a.repositoryService.GetSyncHistory(ctx, req.Id)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "repository %q not found", req.Id)
+		}
+		zap.S().Errorw("unable to get sync history of repository", "error", err, "repo_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &pb.RepositorySyncHistory{
+		RepositoryId: req.Id,
+		Syncs:        make([]*pb.RepositorySync, 0, len(runs)),
+	}
+	for _, r := range runs {
+		resp.Syncs = append(resp.Syncs, mappers.SyncRunToProto(r))
+	}
+
+	return resp, nil
+}
+
+// repositoryAuthType returns the auth type of the method. An empty method means the repository is public.
+func repositoryAuthType(method string) (entity.RepositoryAuthType, bool) {
+	switch method {
+	case "":
+		return entity.NoRepositoryAuthType, true
+	case "ssh":
+		return entity.SSHRepositoryAuthType, true
+	case "token":
+		return entity.TokenRepositoryAuthType, true
+	case "basic":
+		return entity.BasicRepositoryAuthType, true
+	}
+	return entity.NoRepositoryAuthType, false
+}
+
+// AddEnrolmentToken mints a new enrolment token. The token is returned only in this response.
+func (a *AdminServer) AddEnrolmentToken(ctx context.Context, req *pb.AddEnrolmentTokenRequest) (*pb.EnrolmentToken, error) {
+	if req.MaxUses < 0 || req.Ttl < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max uses and ttl must be positive")
+	}
+
+	secret, t, err := a.tokenService.CreateToken(ctx, req.GetNamespaceId(), req.GetSetId(), int(req.MaxUses), time.Duration(req.Ttl)*time.Second)
+	if err != nil {
+		switch err.(type) {
+		case errService.ResourseNotFoundError:
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		default:
+			zap.S().Errorw("unable to create enrolment token", "error", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	pbToken := mappers.EnrolmentTokenToProto(t)
+	pbToken.Token = secret
+
+	return pbToken, nil
+}
+
+func (a *AdminServer) GetEnrolmentTokens(ctx context.Context, req *pb.ListRequest) (*pb.EnrolmentTokenListResponse, error) {
+	tokens, err := a.tokenService.GetTokens(ctx)
+	if err != nil {
+		zap.S().Errorw("unable to get enrolment tokens", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	models := make([]*pb.EnrolmentToken, 0, len(tokens))
+	for _, t := range tokens {
+		models = append(models, mappers.EnrolmentTokenToProto(t))
+	}
+
+	return &pb.EnrolmentTokenListResponse{
+		Tokens: models,
+		Size:   int32(len(models)),
+		Total:  int32(len(models)),
+		Page:   1,
+	}, nil
+}
+
+func (a *AdminServer) DeleteEnrolmentToken(ctx context.Context, req *pb.IdRequest) (*pb.EnrolmentToken, error) {
+	t, err := a.tokenService.DeleteToken(ctx, req.Id)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		zap.S().Errorw("unable to delete enrolment token", "error", err, "token_id", req.Id)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return mappers.EnrolmentTokenToProto(t), nil
+}
+
+func (a *AdminServer) GetAuthCacheStats(ctx context.Context, req *common.Empty) (*pb.AuthCacheStats, error) {
+	return mappers.AuthCacheStatsToProto(a.authService.CacheStats()), nil
+}
@@ -11,6 +11,8 @@ import (
 	pb "github.com/tupyy/tinyedge-controller/pkg/grpc/edge"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -24,15 +26,18 @@ func NewEdgeServer(edgeService *edge.Service) *EdgeServer {
 }
 
 func (e *EdgeServer) Enrol(ctx context.Context, req *pb.EnrolRequest) (*pb.EnrolResponse, error) {
-	enrolStatus, err := e.edgeService.Enrol(ctx, req.DeviceId)
+	enrolStatus, err := e.edgeService.Enrol(ctx, req.DeviceId, req.Token)
 	if err != nil {
+		if _, ok := err.(errService.InvalidEnrolmentTokenError); ok {
+			return mappers.MapEnrolResponse(entity.RefusedEnrolStatus), status.Errorf(codes.PermissionDenied, "device %q enrol request has been denied: %s", req.DeviceId, err)
+		}
 		zap.S().Errorw("unable to enrol device", "error", err, "device_id", req.DeviceId)
 		return &pb.EnrolResponse{
 			EnrolmentStatus: pb.EnrolmentStatus_REFUSED,
 		}, status.Error(codes.Internal, "internal error")
 	}
 
-	if enrolStatus == entity.RefusedEnrolStatus {
+	if enrolStatus == entity.RefusedEnrolStatus || enrolStatus == entity.DecommissionedEnrolStatus {
 		return mappers.MapEnrolResponse(enrolStatus), status.Errorf(codes.PermissionDenied, "device %q enrol request has been denied", req.DeviceId)
 	}
 
@@ -56,16 +61,116 @@ func (e *EdgeServer) Register(ctx context.Context, req *pb.RegistrationRequest)
 	return &pb.RegistrationResponse{Certificate: string(certificate.CertificatePEM)}, nil
 }
 
+func (e *EdgeServer) RenewCertificate(ctx context.Context, req *pb.RenewCertificateRequest) (*pb.RegistrationResponse, error) {
+	// guarded by the current device certificate
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "missing peer certificates")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "missing peer certificates")
+	}
+
+	deviceID, err := authenticatedDeviceID(ctx, req.DeviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := e.edgeService.RenewCertificate(ctx, deviceID, req.CertificateRequest, tlsInfo.State.PeerCertificates[0])
+	if err != nil {
+		switch err.(type) {
+		case errService.CertificateMismatchError:
+			zap.S().Warnw("certificate renewal refused", "error", err, "device_id", deviceID)
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		case errService.DeviceNotRegisteredError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		case errService.CertificateNotRenewableError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		case errService.ResourseNotFoundError:
+			return nil, status.Errorf(codes.NotFound, "device %q not found", deviceID)
+		}
+
+		zap.S().Errorw("unable to renew certificate", "error", err, "device_id", deviceID)
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	return &pb.RegistrationResponse{Certificate: string(certificate.CertificatePEM)}, nil
+}
+
 func (e *EdgeServer) GetConfiguration(ctx context.Context, req *pb.ConfigurationRequest) (*pb.ConfigurationResponse, error) {
 	// guarded by the real device certificate
-	configuration, err := e.edgeService.GetConfiguration(ctx, req.DeviceId)
+	deviceID, err := authenticatedDeviceID(ctx, req.DeviceId)
 	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := e.edgeService.GetConfiguration(ctx, deviceID, req.Hash)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "device %q not found", deviceID)
+		}
+		zap.S().Errorw("unable to get configuration", "error", err, "device_id", deviceID)
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
 	return mappers.MapConfigurationToProto(configuration), nil
 }
 
+func (e *EdgeServer) WatchConfiguration(req *pb.ConfigurationRequest, stream pb.EdgeService_WatchConfigurationServer) error {
+	// guarded by the real device certificate
+	deviceID, err := authenticatedDeviceID(stream.Context(), req.DeviceId)
+	if err != nil {
+		return err
+	}
+
+	configurations, err := e.edgeService.WatchConfiguration(stream.Context(), deviceID, req.Hash)
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return status.Errorf(codes.NotFound, "device %q not found", deviceID)
+		}
+		zap.S().Errorw("unable to watch configuration", "error", err, "device_id", deviceID)
+		return status.Errorf(codes.Internal, "internal error")
+	}
+
+	for configuration := range configurations {
+		if err := stream.Send(mappers.MapConfigurationToProto(configuration)); err != nil {
+			zap.S().Errorw("unable to send configuration", "error", err, "device_id", deviceID)
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (e *EdgeServer) Heartbeat(ctx context.Context, req *common.HeartbeatInfo) (*common.Empty, error) {
+	deviceID, err := authenticatedDeviceID(ctx, req.DeviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeat := mappers.MapHeartbeatFromProto(req)
+	heartbeat.DeviceID = deviceID
+	if err := e.edgeService.Heartbeat(ctx, heartbeat); err != nil {
+		if errService.IsResourceNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "device %q not found", deviceID)
+		}
+		zap.S().Errorw("unable to save heartbeat", "error", err, "device_id", deviceID)
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
 	return &common.Empty{}, nil
 }
+
+// authenticatedDeviceID returns the id of the device authenticated by the auth interceptor.
+// A request made on behalf of another device is refused.
+func authenticatedDeviceID(ctx context.Context, requestedID string) (string, error) {
+	deviceID, ok := ctx.Value("device_id").(string)
+	if !ok || deviceID == "" {
+		return "", status.Errorf(codes.PermissionDenied, "device is not authenticated")
+	}
+	if requestedID != "" && requestedID != deviceID {
+		return "", status.Errorf(codes.PermissionDenied, "device %q is not allowed to act for device %q", deviceID, requestedID)
+	}
+	return deviceID, nil
+}
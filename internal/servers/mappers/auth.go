@@ -0,0 +1,23 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/services/auth"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+func AuthCacheStatsToProto(s auth.CacheStats) *admin.AuthCacheStats {
+	stats := &admin.AuthCacheStats{
+		Hits:     s.Hits,
+		Misses:   s.Misses,
+		Degraded: s.Degraded,
+		Size:     int32(s.Size),
+	}
+
+	if !s.CRLUpdatedAt.IsZero() {
+		stats.CrlUpdatedAt = s.CRLUpdatedAt.Format(time.RFC3339)
+	}
+
+	return stats
+}
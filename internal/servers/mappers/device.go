@@ -14,6 +14,7 @@ func NamespaceToProto(n entity.Namespace) *admin.Namespace {
 		IsDefault: n.IsDefault,
 		Devices:   n.Devices,
 		Sets:      n.Sets,
+		Variables: n.Variables,
 	}
 }
 
@@ -23,6 +24,7 @@ func SetToProto(s entity.Set) *common.Set {
 		Namespace: s.NamespaceID,
 		Manifests: make([]string, 0, len(s.Workloads)),
 		Devices:   make([]string, 0, len(s.Workloads)),
+		Variables: s.Variables,
 	}
 	for _, m := range s.Workloads {
 		set.Manifests = append(set.Manifests, m.GetID())
@@ -35,17 +37,30 @@ func SetToProto(s entity.Set) *common.Set {
 
 func DeviceToProto(d entity.Device) *common.Device {
 	dp := &common.Device{
-		Id:            d.ID,
-		Namespace:     d.NamespaceID,
-		CertificateSn: d.CertificateSerialNumber,
-		EnrolStatus:   d.EnrolStatus.String(),
-		Registered:    d.Registred,
+		Id:                d.ID,
+		Namespace:         d.NamespaceID,
+		CertificateSn:     d.CertificateSerialNumber,
+		EnrolStatus:       d.EnrolStatus.String(),
+		Registered:        d.Registred,
+		State:             d.State.String(),
+		Uptime:            uint64(d.Uptime.Seconds()),
+		ConfigurationHash: d.ConfigurationHash,
+		Labels:            d.Labels,
+		Variables:         d.Variables,
+	}
+
+	if !d.LastSeen.IsZero() {
+		dp.LastSeen = d.LastSeen.Format(time.RFC3339)
 	}
 
 	if d.EnrolStatus == entity.EnroledStatus {
 		dp.EnroledAt = d.EnroledAt.Format(time.RFC3339)
 	}
 
+	if d.EnrolStatus == entity.DecommissionedEnrolStatus {
+		dp.DecommissionedAt = d.DecommissionedAt.Format(time.RFC3339)
+	}
+
 	if d.Registred {
 		dp.RegisteredAt = d.RegisteredAt.Format(time.RFC3339)
 	}
@@ -56,3 +71,15 @@ func DeviceToProto(d entity.Device) *common.Device {
 
 	return dp
 }
+
+// VariablesTargetFromProto returns the entity target of the variables.
+func VariablesTargetFromProto(t admin.VariablesTarget) entity.VariablesTarget {
+	switch t {
+	case admin.VariablesTarget_DEVICE_TARGET:
+		return entity.DeviceVariablesTarget
+	case admin.VariablesTarget_SET_TARGET:
+		return entity.SetVariablesTarget
+	default:
+		return entity.NamespaceVariablesTarget
+	}
+}
@@ -21,7 +21,7 @@ func MapEnrolResponse(enrolStatus entity.EnrolStatus) *edgepb.EnrolResponse {
 		resp.EnrolmentStatus = edgepb.EnrolmentStatus_ENROLED
 	case entity.PendingEnrolStatus:
 		resp.EnrolmentStatus = edgepb.EnrolmentStatus_PENDING
-	case entity.RefusedEnrolStatus:
+	case entity.RefusedEnrolStatus, entity.DecommissionedEnrolStatus:
 		resp.EnrolmentStatus = edgepb.EnrolmentStatus_REFUSED
 	default:
 		resp.EnrolmentStatus = edgepb.EnrolmentStatus_NOT_ENROLED
@@ -0,0 +1,38 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/common"
+)
+
+func MapHeartbeatFromProto(req *common.HeartbeatInfo) entity.Heartbeat {
+	heartbeat := entity.Heartbeat{
+		DeviceID:          req.DeviceId,
+		Timestamp:         time.Now().UTC(),
+		Uptime:            time.Duration(req.Uptime) * time.Second,
+		ConfigurationHash: req.ConfigurationHash,
+		Workloads:         make([]entity.WorkloadStatus, 0, len(req.Workloads)),
+	}
+
+	for _, w := range req.Workloads {
+		status := entity.WorkloadStatus{
+			Name:        w.Name,
+			LastUpdated: time.Unix(int64(w.LastUpdated), 0).UTC(),
+		}
+		switch w.Status {
+		case common.Status_Running:
+			status.State = entity.RunningWorkloadState
+		case common.Status_Crashed:
+			status.State = entity.CrashedWorkloadState
+		case common.Status_Stopped:
+			status.State = entity.StoppedWorkloadState
+		default:
+			status.State = entity.DeployingWorkloadState
+		}
+		heartbeat.Workloads = append(heartbeat.Workloads, status)
+	}
+
+	return heartbeat
+}
@@ -3,11 +3,13 @@ package mappers
 import (
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 	"github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+	"github.com/tupyy/tinyedge-controller/pkg/models"
 )
 
 func ManifestToProto(m entity.Manifest) *admin.Manifest {
 	manifest := &admin.Manifest{
 		Id:      m.GetID(),
+		Name:    m.GetName(),
 		Version: m.GetVersion().String(),
 		Hash:    m.GetHash(),
 	}
@@ -29,5 +31,27 @@ func ManifestToProto(m entity.Manifest) *admin.Manifest {
 		})
 	}
 
+	manifest.LabelSelector = m.GetLabelSelector().String()
+
+	manifest.Valid = true
+	if w, ok := m.(entity.ManifestV1); ok {
+		manifest.Valid = w.IsValid()
+		manifest.Error = w.ValidationError
+		manifest.Pods = make([]string, 0, len(w.Objects))
+		manifest.Configmaps = make([]string, 0, len(w.Objects))
+		for _, o := range w.Objects {
+			switch o.Kind {
+			case models.PodKind:
+				manifest.Pods = append(manifest.Pods, o.Name)
+			case models.ConfigMapKind:
+				manifest.Configmaps = append(manifest.Configmaps, o.Name)
+			}
+		}
+		manifest.Templates = make([]string, 0, len(w.Templates))
+		for _, t := range w.Templates {
+			manifest.Templates = append(manifest.Templates, t.Name)
+		}
+	}
+
 	return manifest
 }
@@ -1,18 +1,58 @@
 package mappers
 
 import (
+	"time"
+
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 	"github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
 )
 
 func RepositoryToModel(r entity.Repository) *admin.Repository {
-	return &admin.Repository{
-		Id:             r.Id,
-		Url:            r.Url,
-		Branch:         r.Branch,
-		LocalPath:      r.LocalPath,
-		CurrentHeadSha: r.CurrentHeadSha,
-		TargetHeadSha:  r.TargetHeadSha,
-		PullPeriod:     int32(r.PullPeriod),
+	repo := &admin.Repository{
+		Id:              r.Id,
+		Url:             r.Url,
+		Branch:          r.Branch,
+		TagPattern:      r.TagPattern,
+		Commit:          r.Commit,
+		LocalPath:       r.LocalPath,
+		CurrentHeadSha:  r.CurrentHeadSha,
+		TargetHeadSha:   r.TargetHeadSha,
+		PullPeriod:      int32(r.PullPeriod.Seconds()),
+		RejectedHeadSha: r.RejectedHeadSha,
+		RejectionReason: r.RejectionReason,
+		Subpath:         r.Subpath,
+		IncludeGlobs:    r.IncludeGlobs,
+		ExcludeGlobs:    r.ExcludeGlobs,
+	}
+
+	if !r.IsPaused() {
+		// a repository never pulled is pulled at the next tick of the scheduler
+		next := r.NextSyncAt
+		if next.IsZero() {
+			next = time.Now()
+		}
+		repo.NextSyncAt = next.Format(time.RFC3339)
+	}
+
+	return repo
+}
+
+func SyncRunToProto(s entity.SyncRun) *admin.RepositorySync {
+	sync := &admin.RepositorySync{
+		StartedAt:        s.StartedAt.Format(time.RFC3339),
+		FinishedAt:       s.FinishedAt.Format(time.RFC3339),
+		FromSha:          s.FromSha,
+		ToSha:            s.ToSha,
+		ManifestsCreated: int32(s.Manifests.Created),
+		ManifestsUpdated: int32(s.Manifests.Updated),
+		ManifestsDeleted: int32(s.Manifests.Deleted),
+		Error:            s.Error,
+		FileErrors:       make([]*admin.ManifestFileError, 0, len(s.Manifests.FileErrors)),
 	}
+
+	for _, f := range s.Manifests.FileErrors {
+		sync.FileErrors = append(sync.FileErrors, &admin.ManifestFileError{Path: f.Path, Error: f.Reason})
+	}
+
+	return sync
 }
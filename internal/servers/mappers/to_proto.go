@@ -8,10 +8,57 @@ import (
 
 func MapConfigurationToProto(conf entity.DeviceConfiguration) *edgepb.ConfigurationResponse {
 	response := &edgepb.ConfigurationResponse{
-		Hash: conf.Hash,
+		Hash:        conf.Hash,
+		NotModified: conf.NotModified,
 	}
+	if conf.NotModified {
+		return response
+	}
+
 	response.Configuration = &common.Configuration{
 		HeartbeatPeriod: uint32(conf.Configuration.HeartbeatPeriod.Seconds()),
+		LogLevel:        conf.Configuration.LogLevel,
+		Profiles:        make([]*common.Profile, 0, len(conf.Configuration.Profiles)),
+	}
+	for _, p := range conf.Configuration.Profiles {
+		profile := &common.Profile{
+			Name:       p.Name,
+			Conditions: make([]*common.ProfileCondition, 0, len(p.Conditions)),
+		}
+		for _, c := range p.Conditions {
+			profile.Conditions = append(profile.Conditions, &common.ProfileCondition{
+				Name:       c.Name,
+				Expression: c.Expression,
+			})
+		}
+		response.Configuration.Profiles = append(response.Configuration.Profiles, profile)
 	}
+
+	response.Workloads = make([]*edgepb.Workload, 0, len(conf.Workloads))
+	for _, w := range conf.Workloads {
+		workload := &edgepb.Workload{
+			Id:    w.GetID(),
+			Name:  w.GetName(),
+			Hash:  w.GetHash(),
+			Kind:  edgepb.WorkloadKind_POD,
+			Data:  w.Data,
+			Error: w.Error,
+		}
+		if w.Kind == entity.QuadletWorkloadKind {
+			workload.Kind = edgepb.WorkloadKind_QUADLET
+		}
+		switch w.Source {
+		case entity.DeviceWorkloadSource:
+			workload.Source = edgepb.WorkloadSource_DEVICE
+		case entity.LabelWorkloadSource:
+			workload.Source = edgepb.WorkloadSource_LABEL
+		case entity.SetWorkloadSource:
+			workload.Source = edgepb.WorkloadSource_SET
+		default:
+			workload.Source = edgepb.WorkloadSource_NAMESPACE
+		}
+		response.Workloads = append(response.Workloads, workload)
+	}
+
 	return response
 }
@@ -0,0 +1,25 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+func EnrolmentTokenToProto(t entity.EnrolmentToken) *admin.EnrolmentToken {
+	token := &admin.EnrolmentToken{
+		Id:          t.ID,
+		NamespaceId: t.NamespaceID,
+		MaxUses:     int32(t.MaxUses),
+		Uses:        int32(t.Uses),
+		CreatedAt:   t.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:   t.ExpiresAt.Format(time.RFC3339),
+	}
+
+	if t.SetID != nil {
+		token.SetId = *t.SetID
+	}
+
+	return token
+}
@@ -0,0 +1,44 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/pkg/grpc/admin"
+)
+
+func WorkloadDeploymentToProto(d entity.WorkloadDeployment) *admin.WorkloadDeployment {
+	deployment := &admin.WorkloadDeployment{
+		DeviceId:   d.DeviceID,
+		ManifestId: d.ManifestID,
+		State:      d.State.String(),
+		ReportedAt: d.ReportedAt.Format(time.RFC3339),
+		Stale:      d.Stale,
+	}
+
+	if !d.LastUpdated.IsZero() {
+		deployment.LastUpdated = d.LastUpdated.Format(time.RFC3339)
+	}
+
+	return deployment
+}
+
+func ManifestRolloutToProto(r entity.ManifestRollout) *admin.ManifestRollout {
+	rollout := &admin.ManifestRollout{
+		ManifestId:        r.ManifestID,
+		Targeted:          int32(len(r.TargetedDevices)),
+		Deploying:         int32(r.Count(entity.DeployingWorkloadState)),
+		Running:           int32(r.Count(entity.RunningWorkloadState)),
+		Crashed:           int32(r.Count(entity.CrashedWorkloadState)),
+		Stopped:           int32(r.Count(entity.StoppedWorkloadState)),
+		StaleDevices:      r.StaleDevices(),
+		UnreportedDevices: r.UnreportedDevices,
+		Deployments:       make([]*admin.WorkloadDeployment, 0, len(r.Deployments)),
+	}
+
+	for _, d := range r.Deployments {
+		rollout.Deployments = append(rollout.Deployments, WorkloadDeploymentToProto(d))
+	}
+
+	return rollout
+}
@@ -0,0 +1,103 @@
+package servers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	// WebhookPath is the path of the webhook endpoint. The id of the repository follows it: /webhooks/<repo_id>.
+	WebhookPath = "/webhooks/"
+	// maxWebhookPayloadSize is the size of the largest payload sent by GitHub.
+	maxWebhookPayloadSize = 25 << 20
+)
+
+// RepositorySyncer syncs a repository as soon as a push webhook is received.
+// RequestSync must not wait for the sync. The requests received while a repository waits for its sync are served by a single sync.
+type RepositorySyncer interface {
+	RequestSync(id string)
+}
+
+// WebhookServer receives the push webhooks of GitHub, GitLab, Gitea or any server signing its payload like GitHub.
+type WebhookServer struct {
+	repositoryService *repository.Service
+	syncer            RepositorySyncer
+}
+
+func NewWebhookServer(repositoryService *repository.Service, syncer RepositorySyncer) *WebhookServer {
+	return &WebhookServer{repositoryService: repositoryService, syncer: syncer}
+}
+
+func (w *WebhookServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	repoID := strings.Trim(strings.TrimPrefix(req.URL.Path, WebhookPath), "/")
+	if repoID == "" || strings.Contains(repoID, "/") {
+		http.Error(rw, "repository id is missing", http.StatusNotFound)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(rw, req.Body, maxWebhookPayloadSize))
+	if err != nil {
+		http.Error(rw, "unable to read payload", http.StatusBadRequest)
+		return
+	}
+
+	repo, err := w.repositoryService.VerifyWebhook(req.Context(), repoID, payload, webhookSignature(req.Header))
+	if err != nil {
+		switch {
+		case errService.IsResourceNotFound(err):
+			http.Error(rw, "repository not found", http.StatusNotFound)
+		case errService.IsInvalidWebhookSignature(err):
+			zap.S().Warnw("webhook refused", "error", err, "repo_id", repoID, "remote_addr", req.RemoteAddr)
+			http.Error(rw, "invalid signature", http.StatusUnauthorized)
+		default:
+			zap.S().Errorw("unable to verify webhook", "error", err, "repo_id", repoID)
+			http.Error(rw, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// ping events are sent by GitHub and Gitea when the webhook is created. There is nothing to sync.
+	if event := webhookEvent(req.Header); event == "ping" {
+		rw.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// the sync may take longer than the git server waits for the response so it is done in background.
+	w.syncer.RequestSync(repo.Id)
+	zap.S().Debugw("sync requested by webhook", "repo_id", repo.Id)
+
+	rw.WriteHeader(http.StatusAccepted)
+}
+
+// webhookSignature returns the signature of the payload from the headers set by the git server.
+func webhookSignature(header http.Header) entity.WebhookSignature {
+	switch {
+	case header.Get("X-Gitlab-Token") != "":
+		return entity.WebhookSignature{Kind: entity.TokenWebhookSignature, Value: header.Get("X-Gitlab-Token")}
+	case header.Get("X-Gitea-Signature") != "":
+		return entity.WebhookSignature{Kind: entity.HMACWebhookSignature, Value: header.Get("X-Gitea-Signature")}
+	default:
+		// GitHub and generic webhooks
+		return entity.WebhookSignature{Kind: entity.HMACWebhookSignature, Value: header.Get("X-Hub-Signature-256")}
+	}
+}
+
+func webhookEvent(header http.Header) string {
+	for _, h := range []string{"X-GitHub-Event", "X-Gitea-Event", "X-Gitlab-Event"} {
+		if event := header.Get(h); event != "" {
+			return event
+		}
+	}
+	return ""
+}
@@ -8,22 +8,34 @@ import (
 	"github.com/tupyy/tinyedge-controller/internal/services/edge"
 	"github.com/tupyy/tinyedge-controller/internal/services/errors"
 	"github.com/tupyy/tinyedge-controller/internal/services/manifest"
+	"github.com/tupyy/tinyedge-controller/internal/services/notification"
 	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+	"github.com/tupyy/tinyedge-controller/internal/services/secret"
+	"github.com/tupyy/tinyedge-controller/internal/services/token"
+	"github.com/tupyy/tinyedge-controller/internal/services/workload"
 )
 
 type (
-	Manifest                 = manifest.Service
-	Device                   = device.Service
-	Configuration            = configuration.Service
-	Repository               = repository.Service
-	Edge                     = edge.Service
-	Auth                     = auth.Service
-	Certificate              = certificate.Service
-	DeviceNotEnroledError    = errors.DeviceNotEnroledError
-	ResourseNotFoundError    = errors.ResourseNotFoundError
-	ResourceAlreadyExists    = errors.ResourceAlreadyExists
-	PosgresNotAvailableError = errors.PosgresNotAvailableError
-	DeleteResourceError      = errors.DeleteResourceError
+	Manifest                     = manifest.Service
+	Device                       = device.Service
+	Configuration                = configuration.Service
+	Repository                   = repository.Service
+	Edge                         = edge.Service
+	EdgeOptions                  = edge.Options
+	Auth                         = auth.Service
+	Certificate                  = certificate.Service
+	Notification                 = notification.Service
+	Token                        = token.Service
+	Workload                     = workload.Service
+	Secret                       = secret.Service
+	DeviceNotEnroledError        = errors.DeviceNotEnroledError
+	ResourseNotFoundError        = errors.ResourseNotFoundError
+	ResourceAlreadyExists        = errors.ResourceAlreadyExists
+	PosgresNotAvailableError     = errors.PosgresNotAvailableError
+	DeleteResourceError          = errors.DeleteResourceError
+	InvalidEnrolStatusError      = errors.InvalidEnrolStatusError
+	CertificateNotRenewableError = errors.CertificateNotRenewableError
+	InvalidEnrolmentTokenError   = errors.InvalidEnrolmentTokenError
 )
 
 var (
@@ -34,6 +46,10 @@ var (
 	NewEdge          = edge.New
 	NewAuth          = auth.New
 	NewCertificate   = certificate.New
+	NewNotification  = notification.New
+	NewToken         = token.New
+	NewWorkload      = workload.New
+	NewSecret        = secret.New
 
 	// errors
 	NewDeviceNotEnroledError             = errors.NewDeviceNotEnroledError
@@ -45,4 +61,7 @@ var (
 	NewResourceAlreadyExistsErrorWithErr = errors.NewResourceAlreadyExistsErrorWithErr
 	NewPostgresNotAvailableError         = errors.NewPostgresNotAvailableError
 	NewDeleteResourceError               = errors.NewDeleteResourceError
+	NewInvalidEnrolStatusError           = errors.NewInvalidEnrolStatusError
+	NewCertificateNotRenewableError      = errors.NewCertificateNotRenewableError
+	NewInvalidEnrolmentTokenError        = errors.NewInvalidEnrolmentTokenError
 )
@@ -6,17 +6,59 @@ import (
 	"encoding/pem"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
 	"go.uber.org/zap"
 )
 
+// CacheStats holds the counters of the certificate status cache.
+type CacheStats struct {
+	Hits     uint64
+	Misses   uint64
+	Degraded uint64
+	Size     int
+	// CRLUpdatedAt is the time of the last successful fetch of the revocation list.
+	CRLUpdatedAt time.Time
+}
+
+// degradedTTLFactor bounds the age of a cached entry used while vault is unreachable to this many times the cache TTL.
+// Older entries are evicted from the cache.
+const degradedTTLFactor = 12
+
+// certificateStatus is the cached result of a successful lookup of a device certificate.
+type certificateStatus struct {
+	deviceID  string
+	isRevoked bool
+	fetchedAt time.Time
+}
+
 type Service struct {
 	certManager  CertificateReader
 	deviceReader DeviceReader
+	// cacheTTL is the staleness bound of a cached entry. 0 disables the cache.
+	cacheTTL time.Duration
+
+	lock         sync.RWMutex
+	cache        map[string]certificateStatus
+	revoked      map[string]struct{}
+	crlUpdatedAt time.Time
+
+	hits     uint64
+	misses   uint64
+	degraded uint64
 }
 
-func New(certManager CertificateReader, deviceReader DeviceReader) *Service {
-	return &Service{certManager, deviceReader}
+func New(certManager CertificateReader, deviceReader DeviceReader, cacheTTL time.Duration) *Service {
+	return &Service{
+		certManager:  certManager,
+		deviceReader: deviceReader,
+		cacheTTL:     cacheTTL,
+		cache:        make(map[string]certificateStatus),
+		revoked:      make(map[string]struct{}),
+	}
 }
 
 // Auth is a function that perfomers authentication.
@@ -42,6 +84,26 @@ func (s *Service) Auth(ctx context.Context, method string, deviceID string, peer
 		return newCtx, fmt.Errorf("unable to authenticated the device %q. It is forbidden to access method %q with a registration certificate", deviceID, method)
 	}
 
+	presentedSerialNumber := s.getSerialNumber(presentedCertificate)
+	if s.isInRevocationList(presentedSerialNumber) {
+		zap.S().Errorw("unable to authenticate device. the certificate is in the revocation list",
+			"device_id", deviceID,
+			"method", method,
+			"certificate_sn", presentedSerialNumber,
+		)
+		return newCtx, fmt.Errorf("unable to authenticate device %q. The presented certificate is revoked.", deviceID)
+	}
+
+	cachedStatus, found := s.getCachedStatus(presentedSerialNumber, deviceID)
+	if found && time.Since(cachedStatus.fetchedAt) < s.cacheTTL {
+		atomic.AddUint64(&s.hits, 1)
+		if cachedStatus.isRevoked {
+			return newCtx, fmt.Errorf("unable to authenticate device %q. The presented certificate is revoked.", deviceID)
+		}
+		return newCtx, nil
+	}
+	atomic.AddUint64(&s.misses, 1)
+
 	// get the device
 	device, err := s.deviceReader.GetDevice(ctx, deviceID)
 	if err != nil {
@@ -51,6 +113,19 @@ func (s *Service) Auth(ctx context.Context, method string, deviceID string, peer
 	// get the real certificate
 	realCertificate, err := s.certManager.GetCertificate(ctx, device.CertificateSerialNumber)
 	if err != nil {
+		// vault is not reachable. Keep authenticating the device if it was known as good.
+		if !errService.IsResourceNotFound(err) && found && !cachedStatus.isRevoked && time.Since(cachedStatus.fetchedAt) < s.maxDegradedAge() &&
+			strings.EqualFold(device.CertificateSerialNumber, presentedSerialNumber) {
+			atomic.AddUint64(&s.degraded, 1)
+			zap.S().Warnw("unable to get certificate. device authenticated from stale cache",
+				"device_id", deviceID,
+				"method", method,
+				"certificate_sn", presentedSerialNumber,
+				"cached_at", cachedStatus.fetchedAt,
+				"error", err,
+			)
+			return newCtx, nil
+		}
 		return newCtx, fmt.Errorf("unable to get device %q certificate with sn %q: %w", deviceID, device.CertificateSerialNumber, err)
 	}
 
@@ -66,6 +141,12 @@ func (s *Service) Auth(ctx context.Context, method string, deviceID string, peer
 		return newCtx, fmt.Errorf("certificates don't match")
 	}
 
+	s.putCachedStatus(presentedSerialNumber, certificateStatus{
+		deviceID:  deviceID,
+		isRevoked: realCertificate.IsRevoked,
+		fetchedAt: time.Now(),
+	})
+
 	if realCertificate.IsRevoked {
 		zap.S().Errorw("unable to authenticate device. the certificate is revoked",
 			"device_id", deviceID,
@@ -82,6 +163,107 @@ func (s *Service) Auth(ctx context.Context, method string, deviceID string, peer
 	return newCtx, nil
 }
 
+// RefreshRevocationList fetches the certificate revocation list and replaces the local one.
+// Cached entries of revoked certificates are marked as revoked.
+// Entries too old to be used while vault is unreachable are evicted, even if the list cannot be fetched.
+func (s *Service) RefreshRevocationList(ctx context.Context) error {
+	s.evictExpired()
+
+	serialNumbers, err := s.certManager.GetRevokedSerialNumbers(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to refresh revocation list: %w", err)
+	}
+
+	revoked := make(map[string]struct{}, len(serialNumbers))
+	for _, sn := range serialNumbers {
+		revoked[strings.ToLower(sn)] = struct{}{}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.revoked = revoked
+	s.crlUpdatedAt = time.Now()
+	for sn, status := range s.cache {
+		if _, found := revoked[sn]; found {
+			status.isRevoked = true
+			s.cache[sn] = status
+		}
+	}
+
+	return nil
+}
+
+// CacheStats returns the counters of the certificate status cache.
+func (s *Service) CacheStats() CacheStats {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return CacheStats{
+		Hits:         atomic.LoadUint64(&s.hits),
+		Misses:       atomic.LoadUint64(&s.misses),
+		Degraded:     atomic.LoadUint64(&s.degraded),
+		Size:         len(s.cache),
+		CRLUpdatedAt: s.crlUpdatedAt,
+	}
+}
+
+func (s *Service) isInRevocationList(sn string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	_, found := s.revoked[sn]
+	return found
+}
+
+// getCachedStatus returns the cached status of the certificate only if it belongs to the device.
+func (s *Service) getCachedStatus(sn string, deviceID string) (certificateStatus, bool) {
+	if s.cacheTTL == 0 {
+		return certificateStatus{}, false
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	status, found := s.cache[sn]
+	if !found || status.deviceID != deviceID {
+		return certificateStatus{}, false
+	}
+
+	return status, true
+}
+
+func (s *Service) putCachedStatus(sn string, status certificateStatus) {
+	if s.cacheTTL == 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, found := s.revoked[sn]; found {
+		status.isRevoked = true
+	}
+	s.cache[sn] = status
+}
+
+// evictExpired removes the cached entries which cannot be used anymore.
+func (s *Service) evictExpired() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for sn, status := range s.cache {
+		if time.Since(status.fetchedAt) >= s.maxDegradedAge() {
+			delete(s.cache, sn)
+		}
+	}
+}
+
+// maxDegradedAge is the age after which a cached entry is not used anymore, even while vault is unreachable.
+func (s *Service) maxDegradedAge() time.Duration {
+	return degradedTTLFactor * s.cacheTTL
+}
+
 func (s *Service) decodeCertificate(cert []byte) (*x509.Certificate, error) {
 	decodedCertificate, _ := pem.Decode(cert)
 	if decodedCertificate == nil {
@@ -7,6 +7,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -69,7 +70,7 @@ var _ = Describe("Auth", func() {
 	Describe("Registration", func() {
 		It("device access registration endpoint with success", func() {
 			cert, _ := decodeCertificate(bytes.NewBufferString(registrationCertificate).Bytes())
-			service := auth.New(nil, nil)
+			service := auth.New(nil, nil, 0)
 			newCtx, err := service.Auth(context.TODO(), "/EdgeService/Register", "deviceID", []*x509.Certificate{cert})
 			Expect(err).To(BeNil())
 			deviceID := newCtx.Value("device_id")
@@ -78,7 +79,7 @@ var _ = Describe("Auth", func() {
 
 		It("device access enrol endpoint with success", func() {
 			cert, _ := decodeCertificate(bytes.NewBufferString(registrationCertificate).Bytes())
-			service := auth.New(nil, nil)
+			service := auth.New(nil, nil, 0)
 			newCtx, err := service.Auth(context.TODO(), "/EdgeService/Enrol", "deviceID", []*x509.Certificate{cert})
 			Expect(err).To(BeNil())
 			deviceID := newCtx.Value("device_id")
@@ -87,7 +88,7 @@ var _ = Describe("Auth", func() {
 
 		It("access denied when device access other methods with registation certificate", func() {
 			cert, _ := decodeCertificate(bytes.NewBufferString(registrationCertificate).Bytes())
-			service := auth.New(nil, nil)
+			service := auth.New(nil, nil, 0)
 			_, err := service.Auth(context.TODO(), "/EdgeService/Admin", "deviceID", []*x509.Certificate{cert})
 			Expect(err).ToNot(BeNil())
 			Expect(err.Error()).To(ContainSubstring("forbidden to access method"))
@@ -95,7 +96,7 @@ var _ = Describe("Auth", func() {
 
 		It("access denied when device access enrol endpoint with a real certificate", func() {
 			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
-			service := auth.New(nil, nil)
+			service := auth.New(nil, nil, 0)
 			_, err := service.Auth(context.TODO(), "/EdgeService/Enrol", "deviceID", []*x509.Certificate{cert})
 			Expect(err).ToNot(BeNil())
 			Expect(err.Error()).To(ContainSubstring("unable to authenticate"))
@@ -103,7 +104,7 @@ var _ = Describe("Auth", func() {
 
 		It("access denied when device access registration endpoint with a real certificate", func() {
 			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
-			service := auth.New(nil, nil)
+			service := auth.New(nil, nil, 0)
 			_, err := service.Auth(context.TODO(), "/EdgeService/Register", "deviceID", []*x509.Certificate{cert})
 			Expect(err).ToNot(BeNil())
 			Expect(err.Error()).To(ContainSubstring("unable to authenticate"))
@@ -130,7 +131,7 @@ var _ = Describe("Auth", func() {
 			}
 
 			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
-			service := auth.New(certReader, deviceReader)
+			service := auth.New(certReader, deviceReader, 0)
 			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
 			Expect(err).To(BeNil())
 		})
@@ -151,7 +152,7 @@ var _ = Describe("Auth", func() {
 			}
 
 			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
-			service := auth.New(certReader, deviceReader)
+			service := auth.New(certReader, deviceReader, 0)
 			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
 			Expect(err).ToNot(BeNil())
 		})
@@ -169,7 +170,7 @@ var _ = Describe("Auth", func() {
 			}
 
 			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
-			service := auth.New(certReader, deviceReader)
+			service := auth.New(certReader, deviceReader, 0)
 			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
 			Expect(err).ToNot(BeNil())
 		})
@@ -193,7 +194,7 @@ var _ = Describe("Auth", func() {
 			}
 
 			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
-			service := auth.New(certReader, deviceReader)
+			service := auth.New(certReader, deviceReader, 0)
 			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
 			Expect(err).ToNot(BeNil())
 		})
@@ -217,11 +218,153 @@ var _ = Describe("Auth", func() {
 			}
 
 			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
-			service := auth.New(certReader, deviceReader)
+			service := auth.New(certReader, deviceReader, 0)
 			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
 			Expect(err).ToNot(BeNil())
 		})
 	})
+
+	Describe("Certificate cache", func() {
+		var (
+			deviceReader *auth.DeviceReaderMock
+			certReader   *auth.CertificateReaderMock
+			vaultErr     error
+		)
+
+		BeforeEach(func() {
+			vaultErr = nil
+			deviceReader = &auth.DeviceReaderMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{
+						ID:                      "deviceID",
+						CertificateSerialNumber: sn,
+					}, nil
+				},
+			}
+			certReader = &auth.CertificateReaderMock{
+				GetCertificateFunc: func(ctx context.Context, sn string) (entity.CertificateGroup, error) {
+					if vaultErr != nil {
+						return entity.CertificateGroup{}, vaultErr
+					}
+					cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+					return entity.CertificateGroup{
+						Certificate: cert,
+					}, nil
+				},
+				GetRevokedSerialNumbersFunc: func(ctx context.Context) ([]string, error) {
+					return []string{sn}, nil
+				},
+			}
+		})
+
+		It("authenticates from cache without reading vault", func() {
+			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+			service := auth.New(certReader, deviceReader, time.Minute)
+
+			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+			_, err = service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+
+			Expect(len(certReader.GetCertificateCalls())).To(Equal(1))
+			Expect(len(deviceReader.GetDeviceCalls())).To(Equal(1))
+			stats := service.CacheStats()
+			Expect(stats.Hits).To(Equal(uint64(1)))
+			Expect(stats.Misses).To(Equal(uint64(1)))
+		})
+
+		It("does not use the cached entry for another device", func() {
+			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+			service := auth.New(certReader, deviceReader, time.Minute)
+
+			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+			_, _ = service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "otherDevice", []*x509.Certificate{cert})
+
+			Expect(len(deviceReader.GetDeviceCalls())).To(Equal(2))
+			Expect(service.CacheStats().Hits).To(BeZero())
+		})
+
+		It("refreshes stale entries", func() {
+			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+			service := auth.New(certReader, deviceReader, time.Millisecond)
+
+			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+			time.Sleep(5 * time.Millisecond)
+			_, err = service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+
+			Expect(len(certReader.GetCertificateCalls())).To(Equal(2))
+		})
+
+		It("authenticates a known device while vault is unreachable", func() {
+			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+			service := auth.New(certReader, deviceReader, 20*time.Millisecond)
+
+			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+
+			time.Sleep(30 * time.Millisecond)
+			vaultErr = errors.New("connection refused")
+			_, err = service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+			Expect(service.CacheStats().Degraded).To(Equal(uint64(1)))
+		})
+
+		It("denies a known device once its cached entry is too old", func() {
+			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+			service := auth.New(certReader, deviceReader, time.Millisecond)
+
+			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+
+			time.Sleep(20 * time.Millisecond)
+			vaultErr = errors.New("connection refused")
+			_, err = service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).ToNot(BeNil())
+			Expect(service.CacheStats().Degraded).To(BeZero())
+		})
+
+		It("evicts the entries too old to be used", func() {
+			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+			service := auth.New(certReader, deviceReader, time.Millisecond)
+
+			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+			Expect(service.CacheStats().Size).To(Equal(1))
+
+			time.Sleep(20 * time.Millisecond)
+			Expect(service.RefreshRevocationList(context.TODO())).To(Succeed())
+			Expect(service.CacheStats().Size).To(BeZero())
+		})
+
+		It("denies an unknown device while vault is unreachable", func() {
+			vaultErr = errors.New("connection refused")
+			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+			service := auth.New(certReader, deviceReader, time.Minute)
+
+			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).ToNot(BeNil())
+			Expect(service.CacheStats().Degraded).To(BeZero())
+		})
+
+		It("denies a cached certificate once it is in the revocation list", func() {
+			cert, _ := decodeCertificate(bytes.NewBufferString(clientCertificate).Bytes())
+			service := auth.New(certReader, deviceReader, time.Minute)
+
+			_, err := service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).To(BeNil())
+
+			err = service.RefreshRevocationList(context.TODO())
+			Expect(err).To(BeNil())
+
+			_, err = service.Auth(context.TODO(), "/EdgeService/GetConfiguration", "deviceID", []*x509.Certificate{cert})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("revoked"))
+			Expect(service.CacheStats().CRLUpdatedAt.IsZero()).To(BeFalse())
+		})
+	})
 })
 
 func decodeCertificate(cert []byte) (*x509.Certificate, error) {
@@ -22,6 +22,9 @@ var _ CertificateReader = &CertificateReaderMock{}
 //			GetCertificateFunc: func(ctx context.Context, sn string) (entity.CertificateGroup, error) {
 //				panic("mock out the GetCertificate method")
 //			},
+//			GetRevokedSerialNumbersFunc: func(ctx context.Context) ([]string, error) {
+//				panic("mock out the GetRevokedSerialNumbers method")
+//			},
 //		}
 //
 //		// use mockedCertificateReader in code that requires CertificateReader
@@ -32,6 +35,9 @@ type CertificateReaderMock struct {
 	// GetCertificateFunc mocks the GetCertificate method.
 	GetCertificateFunc func(ctx context.Context, sn string) (entity.CertificateGroup, error)
 
+	// GetRevokedSerialNumbersFunc mocks the GetRevokedSerialNumbers method.
+	GetRevokedSerialNumbersFunc func(ctx context.Context) ([]string, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetCertificate holds details about calls to the GetCertificate method.
@@ -41,8 +47,14 @@ type CertificateReaderMock struct {
 			// Sn is the sn argument value.
 			Sn string
 		}
+		// GetRevokedSerialNumbers holds details about calls to the GetRevokedSerialNumbers method.
+		GetRevokedSerialNumbers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 	}
-	lockGetCertificate sync.RWMutex
+	lockGetCertificate          sync.RWMutex
+	lockGetRevokedSerialNumbers sync.RWMutex
 }
 
 // GetCertificate calls GetCertificateFunc.
@@ -80,3 +92,35 @@ func (mock *CertificateReaderMock) GetCertificateCalls() []struct {
 	mock.lockGetCertificate.RUnlock()
 	return calls
 }
+
+// GetRevokedSerialNumbers calls GetRevokedSerialNumbersFunc.
+func (mock *CertificateReaderMock) GetRevokedSerialNumbers(ctx context.Context) ([]string, error) {
+	if mock.GetRevokedSerialNumbersFunc == nil {
+		panic("CertificateReaderMock.GetRevokedSerialNumbersFunc: method is nil but CertificateReader.GetRevokedSerialNumbers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetRevokedSerialNumbers.Lock()
+	mock.calls.GetRevokedSerialNumbers = append(mock.calls.GetRevokedSerialNumbers, callInfo)
+	mock.lockGetRevokedSerialNumbers.Unlock()
+	return mock.GetRevokedSerialNumbersFunc(ctx)
+}
+
+// GetRevokedSerialNumbersCalls gets all the calls that were made to GetRevokedSerialNumbers.
+// Check the length with:
+//
+//	len(mockedCertificateReader.GetRevokedSerialNumbersCalls())
+func (mock *CertificateReaderMock) GetRevokedSerialNumbersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetRevokedSerialNumbers.RLock()
+	calls = mock.calls.GetRevokedSerialNumbers
+	mock.lockGetRevokedSerialNumbers.RUnlock()
+	return calls
+}
@@ -14,4 +14,5 @@ type DeviceReader interface {
 //go:generate moq -out cert_reader_moq.go . CertificateReader
 type CertificateReader interface {
 	GetCertificate(ctx context.Context, sn string) (entity.CertificateGroup, error)
+	GetRevokedSerialNumbers(ctx context.Context) ([]string, error)
 }
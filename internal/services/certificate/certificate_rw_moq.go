@@ -0,0 +1,332 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package certificate
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Ensure, that CertificateReaderWriterMock does implement CertificateReaderWriter.
+// If this is not the case, regenerate this file with moq.
+var _ CertificateReaderWriter = &CertificateReaderWriterMock{}
+
+// CertificateReaderWriterMock is a mock implementation of CertificateReaderWriter.
+//
+// 	func TestSomethingThatUsesCertificateReaderWriter(t *testing.T) {
+//
+// 		// make and configure a mocked CertificateReaderWriter
+// 		mockedCertificateReaderWriter := &CertificateReaderWriterMock{
+// 			GenerateCertificateFunc: func(ctx context.Context, cn string, ttl time.Duration) ([]byte, []byte, []byte, error) {
+// 				panic("mock out the GenerateCertificate method")
+// 			},
+// 			GetCACertificateFunc: func(ctx context.Context) ([]byte, error) {
+// 				panic("mock out the GetCACertificate method")
+// 			},
+// 			GetCRLFunc: func(ctx context.Context) ([]byte, error) {
+// 				panic("mock out the GetCRL method")
+// 			},
+// 			GetCertificateFunc: func(ctx context.Context, serialNumber string) ([]byte, bool, time.Time, error) {
+// 				panic("mock out the GetCertificate method")
+// 			},
+// 			RevokeCertificateFunc: func(ctx context.Context, serialNumber string) error {
+// 				panic("mock out the RevokeCertificate method")
+// 			},
+// 			SignCSRFunc: func(ctx context.Context, csr []byte, cn string, ttl time.Duration) ([]byte, error) {
+// 				panic("mock out the SignCSR method")
+// 			},
+// 		}
+//
+// 		// use mockedCertificateReaderWriter in code that requires CertificateReaderWriter
+// 		// and then make assertions.
+//
+// 	}
+type CertificateReaderWriterMock struct {
+	// GenerateCertificateFunc mocks the GenerateCertificate method.
+	GenerateCertificateFunc func(ctx context.Context, cn string, ttl time.Duration) ([]byte, []byte, []byte, error)
+
+	// GetCACertificateFunc mocks the GetCACertificate method.
+	GetCACertificateFunc func(ctx context.Context) ([]byte, error)
+
+	// GetCRLFunc mocks the GetCRL method.
+	GetCRLFunc func(ctx context.Context) ([]byte, error)
+
+	// GetCertificateFunc mocks the GetCertificate method.
+	GetCertificateFunc func(ctx context.Context, serialNumber string) ([]byte, bool, time.Time, error)
+
+	// RevokeCertificateFunc mocks the RevokeCertificate method.
+	RevokeCertificateFunc func(ctx context.Context, serialNumber string) error
+
+	// SignCSRFunc mocks the SignCSR method.
+	SignCSRFunc func(ctx context.Context, csr []byte, cn string, ttl time.Duration) ([]byte, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GenerateCertificate holds details about calls to the GenerateCertificate method.
+		GenerateCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cn is the cn argument value.
+			Cn string
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+		// GetCACertificate holds details about calls to the GetCACertificate method.
+		GetCACertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetCRL holds details about calls to the GetCRL method.
+		GetCRL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetCertificate holds details about calls to the GetCertificate method.
+		GetCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SerialNumber is the serialNumber argument value.
+			SerialNumber string
+		}
+		// RevokeCertificate holds details about calls to the RevokeCertificate method.
+		RevokeCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SerialNumber is the serialNumber argument value.
+			SerialNumber string
+		}
+		// SignCSR holds details about calls to the SignCSR method.
+		SignCSR []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Csr is the csr argument value.
+			Csr []byte
+			// Cn is the cn argument value.
+			Cn string
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+	}
+	lockGenerateCertificate sync.RWMutex
+	lockGetCACertificate    sync.RWMutex
+	lockGetCRL              sync.RWMutex
+	lockGetCertificate      sync.RWMutex
+	lockRevokeCertificate   sync.RWMutex
+	lockSignCSR             sync.RWMutex
+}
+
+// GenerateCertificate calls GenerateCertificateFunc.
+func (mock *CertificateReaderWriterMock) GenerateCertificate(ctx context.Context, cn string, ttl time.Duration) ([]byte, []byte, []byte, error) {
+	if mock.GenerateCertificateFunc == nil {
+		panic("CertificateReaderWriterMock.GenerateCertificateFunc: method is nil but CertificateReaderWriter.GenerateCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Cn  string
+		TTL time.Duration
+	}{
+		Ctx: ctx,
+		Cn:  cn,
+		TTL: ttl,
+	}
+	mock.lockGenerateCertificate.Lock()
+	mock.calls.GenerateCertificate = append(mock.calls.GenerateCertificate, callInfo)
+	mock.lockGenerateCertificate.Unlock()
+	return mock.GenerateCertificateFunc(ctx, cn, ttl)
+}
+
+// GenerateCertificateCalls gets all the calls that were made to GenerateCertificate.
+// Check the length with:
+//     len(mockedCertificateReaderWriter.GenerateCertificateCalls())
+func (mock *CertificateReaderWriterMock) GenerateCertificateCalls() []struct {
+	Ctx context.Context
+	Cn  string
+	TTL time.Duration
+} {
+	var calls []struct {
+		Ctx context.Context
+		Cn  string
+		TTL time.Duration
+	}
+	mock.lockGenerateCertificate.RLock()
+	calls = mock.calls.GenerateCertificate
+	mock.lockGenerateCertificate.RUnlock()
+	return calls
+}
+
+// GetCACertificate calls GetCACertificateFunc.
+func (mock *CertificateReaderWriterMock) GetCACertificate(ctx context.Context) ([]byte, error) {
+	if mock.GetCACertificateFunc == nil {
+		panic("CertificateReaderWriterMock.GetCACertificateFunc: method is nil but CertificateReaderWriter.GetCACertificate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCACertificate.Lock()
+	mock.calls.GetCACertificate = append(mock.calls.GetCACertificate, callInfo)
+	mock.lockGetCACertificate.Unlock()
+	return mock.GetCACertificateFunc(ctx)
+}
+
+// GetCACertificateCalls gets all the calls that were made to GetCACertificate.
+// Check the length with:
+//     len(mockedCertificateReaderWriter.GetCACertificateCalls())
+func (mock *CertificateReaderWriterMock) GetCACertificateCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetCACertificate.RLock()
+	calls = mock.calls.GetCACertificate
+	mock.lockGetCACertificate.RUnlock()
+	return calls
+}
+
+// GetCRL calls GetCRLFunc.
+func (mock *CertificateReaderWriterMock) GetCRL(ctx context.Context) ([]byte, error) {
+	if mock.GetCRLFunc == nil {
+		panic("CertificateReaderWriterMock.GetCRLFunc: method is nil but CertificateReaderWriter.GetCRL was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCRL.Lock()
+	mock.calls.GetCRL = append(mock.calls.GetCRL, callInfo)
+	mock.lockGetCRL.Unlock()
+	return mock.GetCRLFunc(ctx)
+}
+
+// GetCRLCalls gets all the calls that were made to GetCRL.
+// Check the length with:
+//     len(mockedCertificateReaderWriter.GetCRLCalls())
+func (mock *CertificateReaderWriterMock) GetCRLCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetCRL.RLock()
+	calls = mock.calls.GetCRL
+	mock.lockGetCRL.RUnlock()
+	return calls
+}
+
+// GetCertificate calls GetCertificateFunc.
+func (mock *CertificateReaderWriterMock) GetCertificate(ctx context.Context, serialNumber string) ([]byte, bool, time.Time, error) {
+	if mock.GetCertificateFunc == nil {
+		panic("CertificateReaderWriterMock.GetCertificateFunc: method is nil but CertificateReaderWriter.GetCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		SerialNumber string
+	}{
+		Ctx:          ctx,
+		SerialNumber: serialNumber,
+	}
+	mock.lockGetCertificate.Lock()
+	mock.calls.GetCertificate = append(mock.calls.GetCertificate, callInfo)
+	mock.lockGetCertificate.Unlock()
+	return mock.GetCertificateFunc(ctx, serialNumber)
+}
+
+// GetCertificateCalls gets all the calls that were made to GetCertificate.
+// Check the length with:
+//     len(mockedCertificateReaderWriter.GetCertificateCalls())
+func (mock *CertificateReaderWriterMock) GetCertificateCalls() []struct {
+	Ctx          context.Context
+	SerialNumber string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		SerialNumber string
+	}
+	mock.lockGetCertificate.RLock()
+	calls = mock.calls.GetCertificate
+	mock.lockGetCertificate.RUnlock()
+	return calls
+}
+
+// RevokeCertificate calls RevokeCertificateFunc.
+func (mock *CertificateReaderWriterMock) RevokeCertificate(ctx context.Context, serialNumber string) error {
+	if mock.RevokeCertificateFunc == nil {
+		panic("CertificateReaderWriterMock.RevokeCertificateFunc: method is nil but CertificateReaderWriter.RevokeCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		SerialNumber string
+	}{
+		Ctx:          ctx,
+		SerialNumber: serialNumber,
+	}
+	mock.lockRevokeCertificate.Lock()
+	mock.calls.RevokeCertificate = append(mock.calls.RevokeCertificate, callInfo)
+	mock.lockRevokeCertificate.Unlock()
+	return mock.RevokeCertificateFunc(ctx, serialNumber)
+}
+
+// RevokeCertificateCalls gets all the calls that were made to RevokeCertificate.
+// Check the length with:
+//     len(mockedCertificateReaderWriter.RevokeCertificateCalls())
+func (mock *CertificateReaderWriterMock) RevokeCertificateCalls() []struct {
+	Ctx          context.Context
+	SerialNumber string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		SerialNumber string
+	}
+	mock.lockRevokeCertificate.RLock()
+	calls = mock.calls.RevokeCertificate
+	mock.lockRevokeCertificate.RUnlock()
+	return calls
+}
+
+// SignCSR calls SignCSRFunc.
+func (mock *CertificateReaderWriterMock) SignCSR(ctx context.Context, csr []byte, cn string, ttl time.Duration) ([]byte, error) {
+	if mock.SignCSRFunc == nil {
+		panic("CertificateReaderWriterMock.SignCSRFunc: method is nil but CertificateReaderWriter.SignCSR was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Csr []byte
+		Cn  string
+		TTL time.Duration
+	}{
+		Ctx: ctx,
+		Csr: csr,
+		Cn:  cn,
+		TTL: ttl,
+	}
+	mock.lockSignCSR.Lock()
+	mock.calls.SignCSR = append(mock.calls.SignCSR, callInfo)
+	mock.lockSignCSR.Unlock()
+	return mock.SignCSRFunc(ctx, csr, cn, ttl)
+}
+
+// SignCSRCalls gets all the calls that were made to SignCSR.
+// Check the length with:
+//     len(mockedCertificateReaderWriter.SignCSRCalls())
+func (mock *CertificateReaderWriterMock) SignCSRCalls() []struct {
+	Ctx context.Context
+	Csr []byte
+	Cn  string
+	TTL time.Duration
+} {
+	var calls []struct {
+		Ctx context.Context
+		Csr []byte
+		Cn  string
+		TTL time.Duration
+	}
+	mock.lockSignCSR.RLock()
+	calls = mock.calls.SignCSR
+	mock.lockSignCSR.RUnlock()
+	return calls
+}
@@ -0,0 +1,75 @@
+package certificate_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/services/certificate"
+)
+
+var _ = Describe("Revoked certificates", func() {
+	var (
+		crl     []byte
+		service *certificate.Service
+	)
+
+	BeforeEach(func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).To(BeNil())
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "ca"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).To(BeNil())
+		ca, err := x509.ParseCertificate(der)
+		Expect(err).To(BeNil())
+
+		der, err = x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+			Number: big.NewInt(1),
+			RevokedCertificateEntries: []x509.RevocationListEntry{
+				{SerialNumber: big.NewInt(0x1f2e), RevocationTime: time.Now()},
+				{SerialNumber: big.NewInt(0xab), RevocationTime: time.Now()},
+			},
+			ThisUpdate: time.Now(),
+			NextUpdate: time.Now().Add(time.Hour),
+		}, ca, key)
+		Expect(err).To(BeNil())
+		crl = pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+
+		service = certificate.New(&certificate.CertificateReaderWriterMock{
+			GetCRLFunc: func(ctx context.Context) ([]byte, error) {
+				return crl, nil
+			},
+		})
+	})
+
+	It("returns the serial numbers of the crl", func() {
+		serialNumbers, err := service.GetRevokedSerialNumbers(context.TODO())
+		Expect(err).To(BeNil())
+		Expect(serialNumbers).To(ConsistOf("1f2e", "ab"))
+	})
+
+	It("refuses a malformed crl", func() {
+		crl = pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: []byte("not a crl")})
+
+		_, err := service.GetRevokedSerialNumbers(context.TODO())
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -10,13 +10,16 @@ import (
 type CertificateReader interface {
 	GetCertificate(ctx context.Context, serialNumber string) ([]byte, bool, time.Time, error)
 	GetCACertificate(ctx context.Context) ([]byte, error)
+	GetCRL(ctx context.Context) ([]byte, error)
 }
 
 type CertificateWriter interface {
 	GenerateCertificate(ctx context.Context, cn string, ttl time.Duration) ([]byte, []byte, []byte, error)
 	SignCSR(ctx context.Context, csr []byte, cn string, ttl time.Duration) ([]byte, error)
+	RevokeCertificate(ctx context.Context, serialNumber string) error
 }
 
+//go:generate moq -out certificate_rw_moq.go . CertificateReaderWriter
 type CertificateReaderWriter interface {
 	CertificateReader
 	CertificateWriter
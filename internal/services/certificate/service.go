@@ -26,19 +26,6 @@ func New(certReaderWriter CertificateReaderWriter) *Service {
 }
 
 func (m *Service) GetCertificate(ctx context.Context, serialNumber string) (entity.CertificateGroup, error) {
-	formatSerialNumber := func(sn string) string {
-		var sb strings.Builder
-		for i := 2; true; i += 2 {
-			if i >= len(serialNumber) {
-				fmt.Fprintf(&sb, "%s", sn[i-2:len(serialNumber)])
-				break
-			} else {
-				fmt.Fprintf(&sb, "%s:", sn[i-2:i])
-			}
-		}
-		return sb.String()
-	}
-
 	cert, isRevoked, revokedAt, err := m.repo.GetCertificate(ctx, formatSerialNumber(serialNumber))
 	if err != nil {
 		if errService.IsResourceNotFound(err) {
@@ -92,6 +79,41 @@ func (m *Service) TlsConfig(ctx context.Context, ttl time.Duration) (*tls.Config
 	return &config, nil
 }
 
+// GetRevokedSerialNumbers returns the serial numbers, as lowercase hex strings, of all the certificates present in the CRL.
+func (m *Service) GetRevokedSerialNumbers(ctx context.Context) ([]string, error) {
+	crl, err := m.repo.GetCRL(ctx)
+	if err != nil {
+		return []string{}, fmt.Errorf("unable to read crl: %w", err)
+	}
+
+	// vault returns the crl PEM encoded
+	if block, _ := pem.Decode(crl); block != nil {
+		crl = block.Bytes
+	}
+
+	revocationList, err := x509.ParseRevocationList(crl)
+	if err != nil {
+		return []string{}, fmt.Errorf("unable to parse crl: %w", err)
+	}
+
+	serialNumbers := make([]string, 0, len(revocationList.RevokedCertificateEntries))
+	for _, c := range revocationList.RevokedCertificateEntries {
+		serialNumbers = append(serialNumbers, fmt.Sprintf("%x", c.SerialNumber))
+	}
+
+	return serialNumbers, nil
+}
+
+// RevokeCertificate revokes the certificate with the serial number.
+func (m *Service) RevokeCertificate(ctx context.Context, serialNumber string) error {
+	if err := m.repo.RevokeCertificate(ctx, formatSerialNumber(serialNumber)); err != nil {
+		return fmt.Errorf("unable to revoke certificate %q: %w", serialNumber, err)
+	}
+
+	zap.S().Infow("certificate revoked", "certificate_sn", serialNumber)
+	return nil
+}
+
 // GenerateRegistrationCertificate returns a certificate used by the agent to registered itself.
 func (m *Service) GenerateRegistrationCertificate(ctx context.Context, ttl time.Duration) (entity.CertificateGroup, error) {
 	return m.generateCertificate(ctx, "register.home.net", ttl)
@@ -162,3 +184,17 @@ func (m *Service) decode(cert []byte) (entity.CertificateGroup, error) {
 		CertificatePEM: pemBlock,
 	}, nil
 }
+
+// formatSerialNumber formats the serial number as colon separated pairs of hex digits.
+func formatSerialNumber(sn string) string {
+	var sb strings.Builder
+	for i := 2; true; i += 2 {
+		if i >= len(sn) {
+			fmt.Fprintf(&sb, "%s", sn[i-2:])
+			break
+		} else {
+			fmt.Fprintf(&sb, "%s:", sn[i-2:i])
+		}
+	}
+	return strings.ToLower(sb.String())
+}
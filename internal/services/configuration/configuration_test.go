@@ -1,8 +1,352 @@
 package configuration_test
 
 import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/internal/services/configuration"
+	"github.com/tupyy/tinyedge-controller/pkg/models"
 )
 
 var _ = Describe("ConfigurationResponse", func() {
+	var (
+		deviceReader *configuration.DeviceReaderMock
+		workloads    []entity.Manifest
+	)
+
+	BeforeEach(func() {
+		deviceReader = &configuration.DeviceReaderMock{
+			GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+				return entity.Device{ID: id, NamespaceID: "default"}, nil
+			},
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return entity.Namespace{Name: id}, nil
+			},
+		}
+		workloads = []entity.Manifest{
+			entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "first", Hash: "1"}},
+			entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "second", Hash: "2"}},
+		}
+	})
+
+	getConfiguration := func() entity.DeviceConfiguration {
+		selector := &configuration.ManifestSelectorMock{
+			SelectManifestsFunc: func(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+				return workloads, nil
+			},
+		}
+		conf, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetDeviceConfiguration(context.TODO(), "toto")
+		Expect(err).To(BeNil())
+		return conf
+	}
+
+	It("computes the hash of the configuration", func() {
+		conf := getConfiguration()
+		Expect(conf.Hash).ToNot(BeEmpty())
+		Expect(len(conf.Workloads)).To(Equal(2))
+		Expect(conf.Configuration.HeartbeatPeriod).To(Equal(configuration.DefaultHeartbeatPeriod))
+	})
+
+	It("computes the same hash whatever the order of the workloads", func() {
+		first := getConfiguration()
+		workloads[0], workloads[1] = workloads[1], workloads[0]
+		Expect(getConfiguration().Hash).To(Equal(first.Hash))
+	})
+
+	It("sends the quadlet units in the workload data", func() {
+		container, err := models.ParseQuadlet("web.container", []byte("[Container]\nImage=nginx\n"))
+		Expect(err).To(BeNil())
+		volume, err := models.ParseQuadlet("data.volume", []byte("[Volume]\n"))
+		Expect(err).To(BeNil())
+		workloads = []entity.Manifest{
+			entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "first", Hash: "1"}, Kind: entity.QuadletWorkloadKind, Quadlets: []models.Quadlet{container, volume}},
+		}
+
+		conf := getConfiguration()
+		Expect(len(conf.Workloads)).To(Equal(1))
+		documents := strings.Split(string(conf.Workloads[0].Data), "---\n")
+		Expect(len(documents)).To(Equal(2))
+		Expect(documents[0]).To(ContainSubstring("kind: Quadlet"))
+		Expect(documents[0]).To(ContainSubstring("name: web.container"))
+		Expect(documents[0]).To(ContainSubstring("Image=nginx"))
+		Expect(documents[1]).To(ContainSubstring("name: data.volume"))
+	})
+
+	It("sends the kubernetes objects in the workload data", func() {
+		objects, err := models.Unmarshal([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: conf\n---\napiVersion: v1\nkind: Pod\nmetadata:\n  name: nginx\nspec:\n  containers:\n    - name: nginx\n      image: nginx\n"))
+		Expect(err).To(BeNil())
+		workloads = []entity.Manifest{
+			entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "first", Hash: "1"}, Objects: objects},
+		}
+
+		conf := getConfiguration()
+		documents := strings.Split(string(conf.Workloads[0].Data), "---\n")
+		Expect(len(documents)).To(Equal(2))
+		Expect(documents[0]).To(ContainSubstring("kind: ConfigMap"))
+		Expect(documents[1]).To(ContainSubstring("kind: Pod"))
+	})
+
+	It("computes another hash when a workload changes", func() {
+		first := getConfiguration()
+		workloads[1] = entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "second", Hash: "3"}}
+		Expect(getConfiguration().Hash).ToNot(Equal(first.Hash))
+	})
+})
+
+var _ = Describe("Configuration inheritance", func() {
+	var (
+		namespace    entity.Namespace
+		set          entity.Set
+		device       entity.Device
+		deviceReader *configuration.DeviceReaderMock
+		selector     = &configuration.ManifestSelectorMock{
+			SelectManifestsFunc: func(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+				return []entity.Manifest{}, nil
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		setID := "set"
+		namespace = entity.Namespace{
+			Name: "default",
+			Configuration: &entity.Configuration{
+				HeartbeatPeriod: time.Minute,
+				LogLevel:        "warn",
+				Profiles:        []entity.Profile{{Name: "namespace"}},
+			},
+		}
+		set = entity.Set{Name: setID, NamespaceID: "default"}
+		device = entity.Device{ID: "toto", NamespaceID: "default", SetID: &setID}
+		deviceReader = &configuration.DeviceReaderMock{
+			GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+				return device, nil
+			},
+			GetSetFunc: func(ctx context.Context, id string) (entity.Set, error) {
+				return set, nil
+			},
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return namespace, nil
+			},
+		}
+	})
+
+	It("uses the namespace configuration", func() {
+		conf, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetDeviceConfiguration(context.TODO(), "toto")
+		Expect(err).To(BeNil())
+		Expect(conf.Configuration.HeartbeatPeriod).To(Equal(time.Minute))
+		Expect(conf.Configuration.LogLevel).To(Equal("warn"))
+	})
+
+	It("overrides only the fields set by the set and the device", func() {
+		set.Configuration = &entity.Configuration{LogLevel: "debug", HeartbeatPeriod: 10 * time.Second}
+		device.Configuration = &entity.Configuration{HeartbeatPeriod: 5 * time.Second}
+
+		conf, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetDeviceConfiguration(context.TODO(), "toto")
+		Expect(err).To(BeNil())
+		Expect(conf.Configuration.HeartbeatPeriod).To(Equal(5 * time.Second))
+		Expect(conf.Configuration.LogLevel).To(Equal("debug"))
+		Expect(conf.Configuration.Profiles).To(Equal([]entity.Profile{{Name: "namespace"}}))
+	})
+
+	It("uses the defaults when no configuration targets the device", func() {
+		namespace.Configuration = nil
+
+		conf, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetDeviceConfiguration(context.TODO(), "toto")
+		Expect(err).To(BeNil())
+		Expect(conf.Configuration.HeartbeatPeriod).To(Equal(configuration.DefaultHeartbeatPeriod))
+		Expect(conf.Configuration.LogLevel).To(Equal(configuration.DefaultLogLevel))
+	})
+
+	It("returns the heartbeat period of the device configuration", func() {
+		device.Configuration = &entity.Configuration{HeartbeatPeriod: 5 * time.Second}
+
+		period, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetHeartbeatPeriod(context.TODO(), device)
+		Expect(err).To(BeNil())
+		Expect(period).To(Equal(5 * time.Second))
+	})
+})
+
+var _ = Describe("Workload resolution", func() {
+	workload := func(id, name string) entity.ManifestV1 {
+		return entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: id, Name: name}}
+	}
+
+	var (
+		namespace    entity.Namespace
+		set          entity.Set
+		device       entity.Device
+		selected     []entity.Manifest
+		deviceReader *configuration.DeviceReaderMock
+		selector     *configuration.ManifestSelectorMock
+	)
+
+	BeforeEach(func() {
+		setID := "set"
+		namespace = entity.Namespace{Name: "default", Workloads: []entity.ManifestV1{workload("ns", "ns-workload")}}
+		set = entity.Set{Name: setID, NamespaceID: "default", Workloads: []entity.ManifestV1{workload("set", "set-workload")}}
+		device = entity.Device{ID: "toto", NamespaceID: "default", SetID: &setID, Workloads: []entity.ManifestV1{workload("device", "device-workload")}}
+		selected = []entity.Manifest{workload("label", "label-workload")}
+		deviceReader = &configuration.DeviceReaderMock{
+			GetSetFunc: func(ctx context.Context, id string) (entity.Set, error) {
+				return set, nil
+			},
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return namespace, nil
+			},
+		}
+		selector = &configuration.ManifestSelectorMock{
+			SelectManifestsFunc: func(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+				return selected, nil
+			},
+		}
+	})
+
+	sources := func(workloads []entity.DeviceWorkload) map[string]entity.WorkloadSource {
+		s := make(map[string]entity.WorkloadSource)
+		for _, w := range workloads {
+			s[w.GetID()] = w.Source
+		}
+		return s
+	}
+
+	It("merges the workloads of the namespace, set, labels and device", func() {
+		workloads, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetWorkloads(context.TODO(), device)
+		Expect(err).To(BeNil())
+		Expect(sources(workloads)).To(Equal(map[string]entity.WorkloadSource{
+			"ns":     entity.NamespaceWorkloadSource,
+			"set":    entity.SetWorkloadSource,
+			"label":  entity.LabelWorkloadSource,
+			"device": entity.DeviceWorkloadSource,
+		}))
+	})
+
+	It("keeps the workload with the highest precedence when names conflict", func() {
+		namespace.Workloads = append(namespace.Workloads, workload("ns-nginx", "nginx"))
+		set.Workloads = append(set.Workloads, workload("set-nginx", "nginx"))
+		device.Workloads = append(device.Workloads, workload("device-nginx", "nginx"))
+
+		workloads, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetWorkloads(context.TODO(), device)
+		Expect(err).To(BeNil())
+		s := sources(workloads)
+		Expect(s).To(HaveKeyWithValue("device-nginx", entity.DeviceWorkloadSource))
+		Expect(s).NotTo(HaveKey("ns-nginx"))
+		Expect(s).NotTo(HaveKey("set-nginx"))
+	})
+
+	It("keeps the set workload over the namespace one", func() {
+		namespace.Workloads = append(namespace.Workloads, workload("ns-nginx", "nginx"))
+		set.Workloads = append(set.Workloads, workload("set-nginx", "nginx"))
+
+		workloads, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetWorkloads(context.TODO(), device)
+		Expect(err).To(BeNil())
+		s := sources(workloads)
+		Expect(s).To(HaveKeyWithValue("set-nginx", entity.SetWorkloadSource))
+		Expect(s).NotTo(HaveKey("ns-nginx"))
+	})
+
+	It("reports the highest source when the same manifest targets several levels", func() {
+		device.Workloads = append(device.Workloads, workload("ns", "ns-workload"))
+
+		workloads, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetWorkloads(context.TODO(), device)
+		Expect(err).To(BeNil())
+		Expect(sources(workloads)).To(HaveKeyWithValue("ns", entity.DeviceWorkloadSource))
+		Expect(len(workloads)).To(Equal(4))
+	})
+
+	It("resolves name conflicts at the same level by id", func() {
+		namespace.Workloads = []entity.ManifestV1{workload("b", "nginx"), workload("a", "nginx")}
+
+		workloads, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetWorkloads(context.TODO(), device)
+		Expect(err).To(BeNil())
+		s := sources(workloads)
+		Expect(s).To(HaveKey("a"))
+		Expect(s).NotTo(HaveKey("b"))
+	})
+
+	It("ignores the invalid workloads", func() {
+		invalid := workload("device-nginx", "nginx")
+		invalid.ValidationError = "invalid resource"
+		namespace.Workloads = append(namespace.Workloads, workload("ns-nginx", "nginx"))
+		device.Workloads = append(device.Workloads, invalid)
+
+		workloads, err := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetWorkloads(context.TODO(), device)
+		Expect(err).To(BeNil())
+		s := sources(workloads)
+		Expect(s).NotTo(HaveKey("device-nginx"))
+		Expect(s).To(HaveKeyWithValue("ns-nginx", entity.NamespaceWorkloadSource))
+	})
+})
+
+var _ = Describe("Label selected workloads", func() {
+	var deviceReader *configuration.DeviceReaderMock
+
+	BeforeEach(func() {
+		deviceReader = &configuration.DeviceReaderMock{
+			GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+				return entity.Device{ID: id, NamespaceID: "default", Labels: map[string]string{"env": "prod"}}, nil
+			},
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return entity.Namespace{Name: id}, nil
+			},
+		}
+	})
+
+	It("selects the workloads using the device labels", func() {
+		selector := &configuration.ManifestSelectorMock{
+			SelectManifestsFunc: func(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+				return []entity.Manifest{entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "workload"}}}, nil
+			},
+		}
+		service := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{})
+		_, err := service.GetDeviceConfiguration(context.TODO(), "toto")
+		Expect(err).To(BeNil())
+
+		calls := selector.SelectManifestsCalls()
+		Expect(len(calls)).To(Equal(1))
+		Expect(calls[0].Device.ID).To(Equal("toto"))
+		Expect(calls[0].Device.Labels).To(HaveKeyWithValue("env", "prod"))
+	})
+
+	It("returns error when the workloads cannot be selected", func() {
+		selector := &configuration.ManifestSelectorMock{
+			SelectManifestsFunc: func(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+				return nil, errors.New("error")
+			},
+		}
+		service := configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{})
+		_, err := service.GetDeviceConfiguration(context.TODO(), "toto")
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Heartbeat period", func() {
+	It("returns the default period when the device has no configuration", func() {
+		deviceReader := &configuration.DeviceReaderMock{
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return entity.Namespace{Name: id}, nil
+			},
+		}
+		service := configuration.New(deviceReader, &configuration.ManifestSelectorMock{}, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{})
+		period, err := service.GetHeartbeatPeriod(context.TODO(), entity.Device{ID: "toto", NamespaceID: "default"})
+		Expect(err).To(BeNil())
+		Expect(period).To(Equal(configuration.DefaultHeartbeatPeriod))
+	})
+
+	It("returns error when the namespace cannot be read", func() {
+		deviceReader := &configuration.DeviceReaderMock{
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return entity.Namespace{}, errors.New("error")
+			},
+		}
+		service := configuration.New(deviceReader, &configuration.ManifestSelectorMock{}, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{})
+		_, err := service.GetHeartbeatPeriod(context.TODO(), entity.Device{ID: "toto", NamespaceID: "default"})
+		Expect(err).ToNot(BeNil())
+	})
 })
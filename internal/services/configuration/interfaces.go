@@ -12,3 +12,18 @@ type DeviceReader interface {
 	GetSet(ctx context.Context, id string) (entity.Set, error)
 	GetNamespace(ctx context.Context, id string) (entity.Namespace, error)
 }
+
+//go:generate moq -out manifest_selector_moq.go . ManifestSelector
+type ManifestSelector interface {
+	SelectManifests(ctx context.Context, device entity.Device) ([]entity.Manifest, error)
+}
+
+//go:generate moq -out secret_reader_moq.go . SecretReader
+type SecretReader interface {
+	GetSecret(ctx context.Context, path, key string) (entity.Secret, error)
+}
+
+//go:generate moq -out certificate_reader_moq.go . CertificateReader
+type CertificateReader interface {
+	GetCertificate(ctx context.Context, serialNumber string) (entity.CertificateGroup, error)
+}
@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package configuration
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that ManifestSelectorMock does implement ManifestSelector.
+// If this is not the case, regenerate this file with moq.
+var _ ManifestSelector = &ManifestSelectorMock{}
+
+// ManifestSelectorMock is a mock implementation of ManifestSelector.
+//
+// 	func TestSomethingThatUsesManifestSelector(t *testing.T) {
+//
+// 		// make and configure a mocked ManifestSelector
+// 		mockedManifestSelector := &ManifestSelectorMock{
+// 			SelectManifestsFunc: func(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+// 				panic("mock out the SelectManifests method")
+// 			},
+// 		}
+//
+// 		// use mockedManifestSelector in code that requires ManifestSelector
+// 		// and then make assertions.
+//
+// 	}
+type ManifestSelectorMock struct {
+	// SelectManifestsFunc mocks the SelectManifests method.
+	SelectManifestsFunc func(ctx context.Context, device entity.Device) ([]entity.Manifest, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SelectManifests holds details about calls to the SelectManifests method.
+		SelectManifests []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Device is the device argument value.
+			Device entity.Device
+		}
+	}
+	lockSelectManifests sync.RWMutex
+}
+
+// SelectManifests calls SelectManifestsFunc.
+func (mock *ManifestSelectorMock) SelectManifests(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+	if mock.SelectManifestsFunc == nil {
+		panic("ManifestSelectorMock.SelectManifestsFunc: method is nil but ManifestSelector.SelectManifests was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Device entity.Device
+	}{
+		Ctx:    ctx,
+		Device: device,
+	}
+	mock.lockSelectManifests.Lock()
+	mock.calls.SelectManifests = append(mock.calls.SelectManifests, callInfo)
+	mock.lockSelectManifests.Unlock()
+	return mock.SelectManifestsFunc(ctx, device)
+}
+
+// SelectManifestsCalls gets all the calls that were made to SelectManifests.
+// Check the length with:
+//     len(mockedManifestSelector.SelectManifestsCalls())
+func (mock *ManifestSelectorMock) SelectManifestsCalls() []struct {
+	Ctx    context.Context
+	Device entity.Device
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Device entity.Device
+	}
+	mock.lockSelectManifests.RLock()
+	calls = mock.calls.SelectManifests
+	mock.lockSelectManifests.RUnlock()
+	return calls
+}
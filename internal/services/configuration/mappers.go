@@ -1,13 +1,102 @@
 package configuration
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 )
 
-func createConfigurationResponse(c entity.Configuration, manifests []entity.ManifestV1) entity.DeviceConfiguration {
+func createConfigurationResponse(c entity.Configuration, manifests []entity.DeviceWorkload) entity.DeviceConfiguration {
 	confResponse := entity.DeviceConfiguration{
 		Configuration: c,
+		Workloads:     manifests,
+		Hash:          hash(c, manifests),
 	}
 
 	return confResponse
 }
+
+// merge returns the base configuration with the fields set in override.
+// Profiles are overridden as a whole.
+func merge(base *entity.Configuration, override *entity.Configuration) *entity.Configuration {
+	if override == nil {
+		return base
+	}
+
+	if base == nil {
+		c := *override
+		return &c
+	}
+
+	merged := *base
+	merged.ObjectMeta = override.ObjectMeta
+	if override.HeartbeatPeriod > 0 {
+		merged.HeartbeatPeriod = override.HeartbeatPeriod
+	}
+	if override.LogLevel != "" {
+		merged.LogLevel = override.LogLevel
+	}
+	if override.Profiles != nil {
+		merged.Profiles = override.Profiles
+	}
+
+	return &merged
+}
+
+// hashableConfiguration holds the fields of the configuration sent to the device.
+// Workloads are sorted by id so the hash does not depend on the order in which they are read.
+type hashableConfiguration struct {
+	HeartbeatPeriod int64              `json:"heartbeat_period"`
+	LogLevel        string             `json:"log_level"`
+	Profiles        []entity.Profile   `json:"profiles"`
+	Workloads       []hashableWorkload `json:"workloads"`
+}
+
+type hashableWorkload struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Hash   string `json:"hash"`
+	Source string `json:"source"`
+	// Secrets holds the hashes of the secrets' values so the configuration changes when a secret is updated in Vault.
+	Secrets []string `json:"secrets"`
+	// Rendered is the hash of the resources of a templated workload so the configuration changes with the variables.
+	Rendered string `json:"rendered,omitempty"`
+	// Error is the render error so the configuration changes when the workload is fixed.
+	Error string `json:"error,omitempty"`
+}
+
+// hash returns the sha256 sum of the configuration and workloads.
+func hash(c entity.Configuration, manifests []entity.DeviceWorkload) string {
+	h := hashableConfiguration{
+		HeartbeatPeriod: int64(c.HeartbeatPeriod.Seconds()),
+		LogLevel:        c.LogLevel,
+		Profiles:        c.Profiles,
+		Workloads:       make([]hashableWorkload, 0, len(manifests)),
+	}
+
+	for _, m := range manifests {
+		w := hashableWorkload{ID: m.GetID(), Name: m.GetName(), Hash: m.GetHash(), Source: m.Source.String(), Error: m.Error}
+		for _, s := range m.Secrets {
+			w.Secrets = append(w.Secrets, s.Hash)
+		}
+		if len(m.Templates) > 0 {
+			// the data is not hashed because the encrypted secrets change each time.
+			rendered := sha256.New()
+			for _, o := range m.Objects {
+				rendered.Write(o.Content)
+			}
+			for _, q := range m.Quadlets {
+				rendered.Write(q.Content)
+			}
+			w.Rendered = fmt.Sprintf("%x", rendered.Sum(nil))
+		}
+		h.Workloads = append(h.Workloads, w)
+	}
+	sort.Slice(h.Workloads, func(i, j int) bool { return h.Workloads[i].ID < h.Workloads[j].ID })
+
+	data, _ := json.Marshal(h)
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
@@ -0,0 +1,53 @@
+package configuration
+
+import (
+	"sort"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"go.uber.org/zap"
+)
+
+// workloadResolver merges the workloads coming from the namespace, set, labels and device.
+// Workloads are identified by name or by id if they have no name. Invalid workloads are ignored. Between two workloads with the same name and
+// the same source, the one with the lowest id is kept so the resolution does not depend on the reading order.
+type workloadResolver struct {
+	resolved map[string]entity.DeviceWorkload
+}
+
+func newWorkloadResolver() *workloadResolver {
+	return &workloadResolver{resolved: make(map[string]entity.DeviceWorkload)}
+}
+
+func (r *workloadResolver) add(source entity.WorkloadSource, manifests ...entity.ManifestV1) {
+	for _, m := range manifests {
+		if !m.IsValid() {
+			zap.S().Warnw("invalid workload ignored", "manifest_id", m.GetID(), "error", m.ValidationError)
+			continue
+		}
+
+		key := m.GetName()
+		if key == "" {
+			key = m.GetID()
+		}
+
+		current, found := r.resolved[key]
+		if found && (current.Source > source || (current.Source == source && current.GetID() <= m.GetID())) {
+			continue
+		}
+		if found && current.GetID() != m.GetID() {
+			zap.S().Debugw("workload overridden", "name", key, "manifest_id", current.GetID(), "source", current.Source.String(), "override_manifest_id", m.GetID(), "override_source", source.String())
+		}
+
+		r.resolved[key] = entity.DeviceWorkload{ManifestV1: m, Source: source}
+	}
+}
+
+// workloads returns the resolved workloads sorted by id.
+func (r *workloadResolver) workloads() []entity.DeviceWorkload {
+	workloads := make([]entity.DeviceWorkload, 0, len(r.resolved))
+	for _, w := range r.resolved {
+		workloads = append(workloads, w)
+	}
+	sort.Slice(workloads, func(i, j int) bool { return workloads[i].GetID() < workloads[j].GetID() })
+	return workloads
+}
@@ -0,0 +1,47 @@
+package configuration
+
+import (
+	"bytes"
+	"fmt"
+
+	goyaml "github.com/go-yaml/yaml"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+// createResources sets the data of the workloads to their resources. Kubernetes objects and Quadlet units
+// are sent as one document each. The workloads which cannot be rendered are left without data.
+func createResources(workloads []entity.DeviceWorkload) error {
+	for i := range workloads {
+		if workloads[i].Error != "" {
+			continue
+		}
+		documents := make([][]byte, 0, len(workloads[i].Objects)+len(workloads[i].Quadlets))
+		for _, o := range workloads[i].Objects {
+			documents = append(documents, o.Content)
+		}
+		for _, q := range workloads[i].Quadlets {
+			data, err := goyaml.Marshal(q)
+			if err != nil {
+				return fmt.Errorf("unable to marshal quadlet unit %q of workload %q: %w", q.Name, workloads[i].GetID(), err)
+			}
+			documents = append(documents, data)
+		}
+		workloads[i].Data = bundle(documents...)
+	}
+	return nil
+}
+
+// bundle joins the yaml documents with the "---" separator.
+func bundle(documents ...[]byte) []byte {
+	var buf bytes.Buffer
+	for _, d := range documents {
+		if len(d) == 0 {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(d)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,151 @@
+package configuration
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	goyaml "github.com/go-yaml/yaml"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+const (
+	// EncryptionAnnotation is the annotation set on the Secret resources telling the device how the values are encrypted.
+	EncryptionAnnotation = "tinyedge.io/encryption"
+
+	// RSAEncryption: the value is the 2 bytes big endian length of the encrypted key, the AES-256 key encrypted with
+	// RSA-OAEP (sha256), the GCM nonce and the AES-256-GCM ciphertext.
+	RSAEncryption = "rsa-oaep-sha256+aes-256-gcm"
+	// ECDHEncryption: the value is the uncompressed ephemeral public key, the GCM nonce and the AES-256-GCM ciphertext.
+	// The AES key is the sha256 sum of the ECDH shared secret followed by the ephemeral public key.
+	ECDHEncryption = "ecdh-sha256+aes-256-gcm"
+)
+
+type k8sSecret struct {
+	ApiVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   k8sSecretMetadata `yaml:"metadata"`
+	Type       string            `yaml:"type"`
+	Data       map[string]string `yaml:"data"`
+}
+
+type k8sSecretMetadata struct {
+	Name        string            `yaml:"name"`
+	Annotations map[string]string `yaml:"annotations"`
+}
+
+// createSecretResources returns one Kubernetes Secret per secret name with the values encrypted with the public key.
+// Documents are sorted by name and separated by "---".
+func createSecretResources(secrets []entity.Secret, publicKey any) ([]byte, error) {
+	resources := make(map[string]*k8sSecret)
+	for _, s := range secrets {
+		value, algorithm, err := encrypt(publicKey, []byte(s.Value))
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt secret %q: %w", s.Id, err)
+		}
+
+		r, ok := resources[s.Id]
+		if !ok {
+			r = &k8sSecret{
+				ApiVersion: "v1",
+				Kind:       "Secret",
+				Metadata: k8sSecretMetadata{
+					Name:        s.Id,
+					Annotations: map[string]string{EncryptionAnnotation: algorithm},
+				},
+				Type: "Opaque",
+				Data: make(map[string]string),
+			}
+			resources[s.Id] = r
+		}
+		r.Data[s.Key] = base64.StdEncoding.EncodeToString(value)
+	}
+
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	documents := make([][]byte, 0, len(names))
+	for _, name := range names {
+		data, err := goyaml.Marshal(resources[name])
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, data)
+	}
+
+	return bundle(documents...), nil
+}
+
+// encrypt encrypts the plaintext with a random AES-256 key which is either encrypted with the RSA key or derived with
+// ECDH from the EC key. It returns the ciphertext and the algorithm used.
+func encrypt(publicKey any, plaintext []byte) ([]byte, string, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		aesKey := make([]byte, 32)
+		if _, err := rand.Read(aesKey); err != nil {
+			return nil, "", err
+		}
+		encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, key, aesKey, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		sealed, err := seal(aesKey, plaintext)
+		if err != nil {
+			return nil, "", err
+		}
+
+		out := make([]byte, 2, 2+len(encryptedKey)+len(sealed))
+		binary.BigEndian.PutUint16(out, uint16(len(encryptedKey)))
+		out = append(out, encryptedKey...)
+		return append(out, sealed...), RSAEncryption, nil
+	case *ecdsa.PublicKey:
+		remote, err := key.ECDH()
+		if err != nil {
+			return nil, "", err
+		}
+		ephemeral, err := remote.Curve().GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, "", err
+		}
+		shared, err := ephemeral.ECDH(remote)
+		if err != nil {
+			return nil, "", err
+		}
+		ephemeralPublic := ephemeral.PublicKey().Bytes()
+		aesKey := sha256.Sum256(append(shared, ephemeralPublic...))
+		sealed, err := seal(aesKey[:], plaintext)
+		if err != nil {
+			return nil, "", err
+		}
+		return append(ephemeralPublic, sealed...), ECDHEncryption, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// seal returns the nonce followed by the AES-GCM ciphertext.
+func seal(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
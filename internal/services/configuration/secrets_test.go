@@ -0,0 +1,203 @@
+package configuration_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+
+	goyaml "github.com/go-yaml/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/internal/services/configuration"
+)
+
+var _ = Describe("Workload secrets", func() {
+	var (
+		privateKey   *rsa.PrivateKey
+		publicKey    any
+		values       map[string]string
+		workload     entity.ManifestV1
+		deviceReader *configuration.DeviceReaderMock
+		secretReader *configuration.SecretReaderMock
+		certReader   *configuration.CertificateReaderMock
+	)
+
+	BeforeEach(func() {
+		var err error
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).To(BeNil())
+		publicKey = &privateKey.PublicKey
+
+		values = map[string]string{"db/user": "admin", "db/password": "secret"}
+		workload = entity.ManifestV1{
+			ObjectMeta: entity.ObjectMeta{Id: "workload", Name: "workload", Hash: "1"},
+			Secrets: []entity.Secret{
+				{Id: "db", Path: "db", Key: "user"},
+				{Id: "db", Path: "db", Key: "password"},
+			},
+		}
+
+		deviceReader = &configuration.DeviceReaderMock{
+			GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+				return entity.Device{ID: id, NamespaceID: "default", CertificateSerialNumber: "sn", Workloads: []entity.ManifestV1{workload}}, nil
+			},
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return entity.Namespace{Name: id}, nil
+			},
+		}
+		secretReader = &configuration.SecretReaderMock{
+			GetSecretFunc: func(ctx context.Context, path string, key string) (entity.Secret, error) {
+				value, ok := values[path+"/"+key]
+				if !ok {
+					return entity.Secret{}, errors.New("secret not found")
+				}
+				return entity.Secret{Path: path, Key: key, Value: value, Hash: path + key + value}, nil
+			},
+		}
+		certReader = &configuration.CertificateReaderMock{
+			GetCertificateFunc: func(ctx context.Context, serialNumber string) (entity.CertificateGroup, error) {
+				return entity.CertificateGroup{Certificate: &x509.Certificate{PublicKey: publicKey}}, nil
+			},
+		}
+	})
+
+	getConfiguration := func() (entity.DeviceConfiguration, error) {
+		selector := &configuration.ManifestSelectorMock{
+			SelectManifestsFunc: func(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+				return []entity.Manifest{}, nil
+			},
+		}
+		return configuration.New(deviceReader, selector, secretReader, certReader).GetDeviceConfiguration(context.TODO(), "toto")
+	}
+
+	It("sends the secrets as a Kubernetes Secret encrypted with the device public key", func() {
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(len(conf.Workloads)).To(Equal(1))
+		Expect(certReader.GetCertificateCalls()[0].SerialNumber).To(Equal("sn"))
+
+		var secret struct {
+			Kind     string `yaml:"kind"`
+			Metadata struct {
+				Name        string            `yaml:"name"`
+				Annotations map[string]string `yaml:"annotations"`
+			} `yaml:"metadata"`
+			Data map[string]string `yaml:"data"`
+		}
+		Expect(goyaml.Unmarshal(conf.Workloads[0].Data, &secret)).To(BeNil())
+		Expect(secret.Kind).To(Equal("Secret"))
+		Expect(secret.Metadata.Name).To(Equal("db"))
+		Expect(secret.Metadata.Annotations[configuration.EncryptionAnnotation]).To(Equal(configuration.RSAEncryption))
+		Expect(len(secret.Data)).To(Equal(2))
+
+		for key, value := range map[string]string{"user": "admin", "password": "secret"} {
+			data, err := base64.StdEncoding.DecodeString(secret.Data[key])
+			Expect(err).To(BeNil())
+			Expect(string(decryptRSA(privateKey, data))).To(Equal(value))
+		}
+	})
+
+	It("does not modify the workload of the device", func() {
+		_, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(workload.Secrets[0].Value).To(BeEmpty())
+	})
+
+	It("does not keep the values of the secrets in the configuration", func() {
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(conf.Workloads[0].Secrets).To(HaveLen(2))
+		for _, s := range conf.Workloads[0].Secrets {
+			Expect(s.Id).To(Equal("db"))
+			Expect(s.Hash).ToNot(BeEmpty())
+			Expect(s.Value).To(BeEmpty())
+		}
+	})
+
+	It("computes another hash when a secret changes", func() {
+		first, err := getConfiguration()
+		Expect(err).To(BeNil())
+
+		second, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(second.Hash).To(Equal(first.Hash))
+
+		values["db/password"] = "new-secret"
+		third, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(third.Hash).ToNot(Equal(first.Hash))
+	})
+
+	It("encrypts the secrets with an EC public key", func() {
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).To(BeNil())
+		publicKey = &ecKey.PublicKey
+
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(string(conf.Workloads[0].Data)).To(ContainSubstring(configuration.ECDHEncryption))
+		Expect(string(conf.Workloads[0].Data)).ToNot(ContainSubstring(base64.StdEncoding.EncodeToString([]byte("secret"))))
+	})
+
+	It("sends one Secret per secret name", func() {
+		values["api/token"] = "token"
+		workload.Secrets = append(workload.Secrets, entity.Secret{Id: "api", Path: "api", Key: "token"})
+
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		documents := strings.Split(string(conf.Workloads[0].Data), "---\n")
+		Expect(len(documents)).To(Equal(2))
+		Expect(documents[0]).To(ContainSubstring("name: api"))
+		Expect(documents[1]).To(ContainSubstring("name: db"))
+	})
+
+	It("returns error when a secret cannot be read", func() {
+		delete(values, "db/password")
+		_, err := getConfiguration()
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("returns error when the certificate cannot be read", func() {
+		certReader.GetCertificateFunc = func(ctx context.Context, serialNumber string) (entity.CertificateGroup, error) {
+			return entity.CertificateGroup{}, errors.New("error")
+		}
+		_, err := getConfiguration()
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("does not read the certificate when no workload has secrets", func() {
+		workload.Secrets = nil
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(conf.Workloads[0].Data).To(BeEmpty())
+		Expect(len(certReader.GetCertificateCalls())).To(Equal(0))
+	})
+})
+
+func decryptRSA(privateKey *rsa.PrivateKey, data []byte) []byte {
+	keyLen := int(binary.BigEndian.Uint16(data))
+	aesKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, privateKey, data[2:2+keyLen], nil)
+	Expect(err).To(BeNil())
+
+	block, err := aes.NewCipher(aesKey)
+	Expect(err).To(BeNil())
+	gcm, err := cipher.NewGCM(block)
+	Expect(err).To(BeNil())
+
+	sealed := data[2+keyLen:]
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	Expect(err).To(BeNil())
+	return plaintext
+}
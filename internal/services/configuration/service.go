@@ -2,18 +2,34 @@ package configuration
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/pkg/models"
 	"go.uber.org/zap"
 )
 
+const (
+	// DefaultHeartbeatPeriod is the heartbeat period used when the device has no configuration.
+	DefaultHeartbeatPeriod = 30 * time.Second
+	// DefaultLogLevel is the log level used when the device has no configuration.
+	DefaultLogLevel = "info"
+)
+
 type Service struct {
-	deviceReader DeviceReader
+	deviceReader      DeviceReader
+	manifestSelector  ManifestSelector
+	secretReader      SecretReader
+	certificateReader CertificateReader
 }
 
-func New(deviceReader DeviceReader) *Service {
+func New(deviceReader DeviceReader, manifestSelector ManifestSelector, secretReader SecretReader, certificateReader CertificateReader) *Service {
 	return &Service{
-		deviceReader: deviceReader,
+		deviceReader:      deviceReader,
+		manifestSelector:  manifestSelector,
+		secretReader:      secretReader,
+		certificateReader: certificateReader,
 	}
 }
 
@@ -33,66 +49,195 @@ func (c *Service) GetDeviceConfiguration(ctx context.Context, deviceID string) (
 	if err != nil {
 		return entity.DeviceConfiguration{}, err
 	}
-	manifests, err := c.getWorkloads(ctx, device)
+	manifests, err := c.GetWorkloads(ctx, device)
 	if err != nil {
 		return entity.DeviceConfiguration{}, err
 	}
+	if hasTemplates(manifests) {
+		data, err := c.templateData(ctx, device)
+		if err != nil {
+			return entity.DeviceConfiguration{}, err
+		}
+		renderTemplates(data, manifests)
+	}
+	if err := createResources(manifests); err != nil {
+		return entity.DeviceConfiguration{}, err
+	}
+	if err := c.resolveSecrets(ctx, device, manifests); err != nil {
+		return entity.DeviceConfiguration{}, err
+	}
 
-	var confResponse entity.DeviceConfiguration
-	if configuration != nil {
-		confResponse = createConfigurationResponse(*configuration, manifests)
+	if configuration == nil {
+		configuration = &entity.Configuration{}
+	}
+	if configuration.HeartbeatPeriod == 0 {
+		configuration.HeartbeatPeriod = DefaultHeartbeatPeriod
 	}
+	if configuration.LogLevel == "" {
+		configuration.LogLevel = DefaultLogLevel
+	}
+	confResponse := createConfigurationResponse(*configuration, manifests)
 
 	// err = c.cacheReadWriter.Put(ctx, device.ID, confResponse)
 	// if err != nil {
 	// 	zap.S().Errorw("unable to save configuration to cache", "error", err)
 	// }
 
-	zap.S().Debugw("configuration", "configuration", confResponse)
+	zap.S().Debugw("configuration created", "device_id", device.ID, "hash", confResponse.Hash, "workloads", len(confResponse.Workloads))
 	return confResponse, nil
 
 	// }
 	// return conf, nil
 }
 
+// GetHeartbeatPeriod returns the heartbeat period configured for the device.
+func (c *Service) GetHeartbeatPeriod(ctx context.Context, device entity.Device) (time.Duration, error) {
+	configuration, err := c.getConfiguration(ctx, device)
+	if err != nil {
+		return 0, err
+	}
+
+	if configuration == nil || configuration.HeartbeatPeriod == 0 {
+		return DefaultHeartbeatPeriod, nil
+	}
+
+	return configuration.HeartbeatPeriod, nil
+}
+
+// getConfiguration returns the effective configuration of the device. The configuration of the namespace is overridden
+// by the one of the set which is overridden by the one of the device. Returns nil if no configuration targets the device.
 func (c *Service) getConfiguration(ctx context.Context, device entity.Device) (*entity.Configuration, error) {
-	// if device has no configuration look at the set of the device
+	namespace, err := c.deviceReader.GetNamespace(ctx, device.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	configuration := merge(nil, namespace.Configuration)
+
 	if device.SetID != nil {
-		_, err := c.deviceReader.GetSet(ctx, *device.SetID)
+		set, err := c.deviceReader.GetSet(ctx, *device.SetID)
 		if err != nil {
 			return nil, err
 		}
-		return nil, nil
+		configuration = merge(configuration, set.Configuration)
 	}
 
-	// if the device has no set or the set has no configuration just grab the configuration from namespace
-	_, err := c.deviceReader.GetNamespace(ctx, device.NamespaceID)
+	return merge(configuration, device.Configuration), nil
+}
+
+// GetWorkloads returns the union of the workloads targeting the namespace, the set and the device plus the ones
+// selected by the device's labels. When two workloads have the same name, the one with the highest WorkloadSource wins.
+func (c *Service) GetWorkloads(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error) {
+	resolver := newWorkloadResolver()
+
+	namespace, err := c.deviceReader.GetNamespace(ctx, device.NamespaceID)
 	if err != nil {
-		return nil, err
+		return []entity.DeviceWorkload{}, err
 	}
+	resolver.add(entity.NamespaceWorkloadSource, namespace.Workloads...)
 
-	// namespace always has a configuration
-	return nil, nil
+	if device.SetID != nil {
+		set, err := c.deviceReader.GetSet(ctx, *device.SetID)
+		if err != nil {
+			return []entity.DeviceWorkload{}, err
+		}
+		resolver.add(entity.SetWorkloadSource, set.Workloads...)
+	}
+
+	selected, err := c.manifestSelector.SelectManifests(ctx, device)
+	if err != nil {
+		return []entity.DeviceWorkload{}, err
+	}
+	for _, m := range selected {
+		if w, ok := m.(entity.ManifestV1); ok {
+			resolver.add(entity.LabelWorkloadSource, w)
+		}
+	}
+
+	resolver.add(entity.DeviceWorkloadSource, device.Workloads...)
+
+	return resolver.workloads(), nil
 }
 
-func (c *Service) getWorkloads(ctx context.Context, device entity.Device) ([]entity.ManifestV1, error) {
-	if len(device.Workloads) > 0 {
-		return device.Workloads, nil
+// templateData returns the data used to render the templated resources of the device's workloads.
+// The variables of the device override the ones of the set which override the ones of the namespace.
+func (c *Service) templateData(ctx context.Context, device entity.Device) (models.TemplateData, error) {
+	data := models.TemplateData{
+		DeviceID:  device.ID,
+		Namespace: device.NamespaceID,
+		Labels:    make(map[string]string, len(device.Labels)),
+		Vars:      make(map[string]string),
+	}
+	for k, v := range device.Labels {
+		data.Labels[k] = v
+	}
+
+	namespace, err := c.deviceReader.GetNamespace(ctx, device.NamespaceID)
+	if err != nil {
+		return models.TemplateData{}, err
+	}
+	for k, v := range namespace.Variables {
+		data.Vars[k] = v
 	}
 
 	if device.SetID != nil {
-		sets, err := c.deviceReader.GetSet(ctx, *device.SetID)
+		set, err := c.deviceReader.GetSet(ctx, *device.SetID)
 		if err != nil {
-			return []entity.ManifestV1{}, err
+			return models.TemplateData{}, err
 		}
-		if len(sets.Workloads) > 0 {
-			return sets.Workloads, nil
+		data.Set = set.Name
+		for k, v := range set.Variables {
+			data.Vars[k] = v
 		}
 	}
 
-	namespace, err := c.deviceReader.GetNamespace(ctx, device.NamespaceID)
-	if err != nil {
-		return []entity.ManifestV1{}, err
+	for k, v := range device.Variables {
+		data.Vars[k] = v
 	}
-	return namespace.Workloads, nil
+
+	return data, nil
+}
+
+// resolveSecrets reads the values of the workloads' secrets from Vault and adds to the workloads' data the
+// Kubernetes Secrets encrypted with the public key of the device's certificate.
+func (c *Service) resolveSecrets(ctx context.Context, device entity.Device, workloads []entity.DeviceWorkload) error {
+	var publicKey any
+	for i := range workloads {
+		// a workload which cannot be rendered is sent without data so its secrets are not needed.
+		if len(workloads[i].Secrets) == 0 || workloads[i].Error != "" {
+			continue
+		}
+
+		if publicKey == nil {
+			certificate, err := c.certificateReader.GetCertificate(ctx, device.CertificateSerialNumber)
+			if err != nil {
+				return fmt.Errorf("unable to read certificate of device %q: %w", device.ID, err)
+			}
+			publicKey = certificate.Certificate.PublicKey
+		}
+
+		// the secrets are shared with the manifest read from the repository so they are copied before being filled in.
+		secrets := make([]entity.Secret, 0, len(workloads[i].Secrets))
+		for _, s := range workloads[i].Secrets {
+			secret, err := c.secretReader.GetSecret(ctx, s.Path, s.Key)
+			if err != nil {
+				return fmt.Errorf("unable to read secret %q of workload %q: %w", s.Id, workloads[i].GetID(), err)
+			}
+			secret.Id = s.Id
+			secrets = append(secrets, secret)
+		}
+
+		data, err := createSecretResources(secrets, publicKey)
+		if err != nil {
+			return fmt.Errorf("unable to create secrets of workload %q: %w", workloads[i].GetID(), err)
+		}
+		workloads[i].Data = bundle(workloads[i].Data, data)
+
+		// only the encrypted values leave this function. The workload keeps the hashes to compute the configuration hash.
+		workloads[i].Secrets = make([]entity.Secret, 0, len(secrets))
+		for _, s := range secrets {
+			workloads[i].Secrets = append(workloads[i].Secrets, entity.Secret{Id: s.Id, Hash: s.Hash})
+		}
+	}
+
+	return nil
 }
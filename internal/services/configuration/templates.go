@@ -0,0 +1,90 @@
+package configuration
+
+import (
+	"fmt"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/pkg/models"
+	"go.uber.org/zap"
+)
+
+// renderTemplates renders the templated resources of the workloads with the data of the device and adds the resulting
+// Kubernetes objects or Quadlet units to the workloads. The rendered resources are validated as the other resources.
+// A workload which cannot be rendered gets the render error so the other workloads are still delivered to the device.
+func renderTemplates(data models.TemplateData, workloads []entity.DeviceWorkload) {
+	for i := range workloads {
+		if len(workloads[i].Templates) == 0 {
+			continue
+		}
+
+		if err := renderWorkload(data, &workloads[i]); err != nil {
+			zap.S().Warnw("unable to render workload", "error", err, "device_id", data.DeviceID, "workload_id", workloads[i].GetID())
+			workloads[i].Error = err.Error()
+		}
+	}
+}
+
+func renderWorkload(data models.TemplateData, workload *entity.DeviceWorkload) error {
+	// objects and units are shared with the manifest read from the repository so they are copied before being extended.
+	objects := append([]models.Object{}, workload.Objects...)
+	quadlets := append([]models.Quadlet{}, workload.Quadlets...)
+	for _, t := range workload.Templates {
+		content, err := t.Render(data)
+		if err != nil {
+			return errService.NewTemplateRenderError(data.DeviceID, workload.GetID(), err.Error())
+		}
+
+		if workload.Kind == entity.QuadletWorkloadKind {
+			q, err := models.ParseQuadlet(t.Name, content)
+			if err != nil {
+				return errService.NewTemplateRenderError(data.DeviceID, workload.GetID(), fmt.Sprintf("invalid quadlet unit %q: %s", t.Name, err))
+			}
+			quadlets = append(quadlets, q)
+			continue
+		}
+
+		oo, err := models.Unmarshal(content)
+		if err != nil {
+			return errService.NewTemplateRenderError(data.DeviceID, workload.GetID(), fmt.Sprintf("invalid resource %q: %s", t.Name, err))
+		}
+		objects = append(objects, oo...)
+	}
+
+	if err := checkDuplicates(objects, quadlets); err != nil {
+		return errService.NewTemplateRenderError(data.DeviceID, workload.GetID(), err.Error())
+	}
+
+	workload.Objects = objects
+	workload.Quadlets = quadlets
+	return nil
+}
+
+// checkDuplicates returns an error if two objects of the same kind or two units have the same name.
+func checkDuplicates(objects []models.Object, quadlets []models.Quadlet) error {
+	names := make(map[string]struct{}, len(objects)+len(quadlets))
+	for _, o := range objects {
+		key := fmt.Sprintf("%s/%s", o.Kind, o.Name)
+		if _, found := names[key]; found {
+			return fmt.Errorf("%s %q is defined twice", o.Kind, o.Name)
+		}
+		names[key] = struct{}{}
+	}
+	for _, q := range quadlets {
+		if _, found := names[q.Name]; found {
+			return fmt.Errorf("two quadlet units are named %q", q.Name)
+		}
+		names[q.Name] = struct{}{}
+	}
+	return nil
+}
+
+// hasTemplates returns true if at least one workload has templated resources.
+func hasTemplates(workloads []entity.DeviceWorkload) bool {
+	for _, w := range workloads {
+		if len(w.Templates) > 0 {
+			return true
+		}
+	}
+	return false
+}
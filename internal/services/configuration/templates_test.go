@@ -0,0 +1,186 @@
+package configuration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/internal/services/configuration"
+	"github.com/tupyy/tinyedge-controller/pkg/models"
+)
+
+var _ = Describe("Templated workloads", func() {
+	const podTemplate = `apiVersion: v1
+kind: Pod
+metadata:
+  name: web-{{ .DeviceID }}
+  labels:
+    zone: {{ .Labels.zone }}
+spec:
+  containers:
+    - name: web
+      image: nginx
+      env:
+        - name: SITE
+          value: {{ .Vars.site }}
+        - name: PORT
+          value: "{{ .Vars.port }}"
+        - name: LOCATION
+          value: {{ .Namespace }}/{{ .Set }}
+`
+
+	var (
+		namespace    entity.Namespace
+		set          entity.Set
+		device       entity.Device
+		workload     entity.ManifestV1
+		deviceReader *configuration.DeviceReaderMock
+	)
+
+	BeforeEach(func() {
+		t, err := models.ParseTemplate("pod.yaml.tmpl", []byte(podTemplate))
+		Expect(err).To(BeNil())
+		workload = entity.ManifestV1{
+			ObjectMeta: entity.ObjectMeta{Id: "workload", Name: "workload", Hash: "1"},
+			Templates:  []models.Template{t},
+		}
+
+		setID := "set"
+		namespace = entity.Namespace{Name: "default", Variables: map[string]string{"site": "paris", "port": "80"}}
+		set = entity.Set{Name: setID, NamespaceID: "default", Variables: map[string]string{"port": "8080"}}
+		device = entity.Device{ID: "toto", NamespaceID: "default", SetID: &setID, Labels: map[string]string{"zone": "a"}}
+		deviceReader = &configuration.DeviceReaderMock{
+			GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+				d := device
+				d.Workloads = []entity.ManifestV1{workload}
+				return d, nil
+			},
+			GetSetFunc: func(ctx context.Context, id string) (entity.Set, error) {
+				return set, nil
+			},
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return namespace, nil
+			},
+		}
+	})
+
+	getConfiguration := func() (entity.DeviceConfiguration, error) {
+		selector := &configuration.ManifestSelectorMock{
+			SelectManifestsFunc: func(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+				return []entity.Manifest{}, nil
+			},
+		}
+		return configuration.New(deviceReader, selector, &configuration.SecretReaderMock{}, &configuration.CertificateReaderMock{}).GetDeviceConfiguration(context.TODO(), "toto")
+	}
+
+	It("renders the resources with the device data and the merged variables", func() {
+		device.Variables = map[string]string{"site": "lyon"}
+
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(len(conf.Workloads)).To(Equal(1))
+		Expect(len(conf.Workloads[0].Objects)).To(Equal(1))
+		Expect(conf.Workloads[0].Objects[0].Name).To(Equal("web-toto"))
+
+		data := string(conf.Workloads[0].Data)
+		Expect(data).To(ContainSubstring("zone: a"))
+		Expect(data).To(ContainSubstring("value: lyon"))
+		Expect(data).To(ContainSubstring(`value: "8080"`))
+		Expect(data).To(ContainSubstring("value: default/set"))
+	})
+
+	It("does not modify the workload of the device", func() {
+		_, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(workload.Objects).To(BeEmpty())
+	})
+
+	It("computes another hash when a variable changes", func() {
+		first, err := getConfiguration()
+		Expect(err).To(BeNil())
+
+		second, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(second.Hash).To(Equal(first.Hash))
+
+		set.Variables = map[string]string{"port": "9090"}
+		third, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(third.Hash).ToNot(Equal(first.Hash))
+	})
+
+	It("reports the render error on the workload when a variable is missing", func() {
+		namespace.Variables = map[string]string{"port": "80"}
+
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(len(conf.Workloads)).To(Equal(1))
+		Expect(conf.Workloads[0].Error).To(ContainSubstring("site"))
+		Expect(conf.Workloads[0].Data).To(BeEmpty())
+	})
+
+	It("reports the render error on the workload when the rendered resource is invalid", func() {
+		t, err := models.ParseTemplate("pod.yaml.tmpl", []byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: {{ .Vars.site }}\n"))
+		Expect(err).To(BeNil())
+		workload.Templates = []models.Template{t}
+
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(conf.Workloads[0].Error).ToNot(BeEmpty())
+		Expect(conf.Workloads[0].Data).To(BeEmpty())
+	})
+
+	It("delivers the other workloads when a workload cannot be rendered", func() {
+		objects, err := models.Unmarshal([]byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: db\nspec:\n  containers:\n    - name: db\n      image: postgres\n"))
+		Expect(err).To(BeNil())
+		other := entity.ManifestV1{
+			ObjectMeta: entity.ObjectMeta{Id: "other", Name: "other", Hash: "1"},
+			Objects:    objects,
+		}
+		deviceReader.GetDeviceFunc = func(ctx context.Context, id string) (entity.Device, error) {
+			d := device
+			d.Workloads = []entity.ManifestV1{workload, other}
+			return d, nil
+		}
+		namespace.Variables = map[string]string{"port": "80"}
+
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(len(conf.Workloads)).To(Equal(2))
+		for _, w := range conf.Workloads {
+			if w.GetID() == "other" {
+				Expect(w.Error).To(BeEmpty())
+				Expect(string(w.Data)).To(ContainSubstring("name: db"))
+				continue
+			}
+			Expect(w.Error).ToNot(BeEmpty())
+			Expect(w.Data).To(BeEmpty())
+		}
+	})
+
+	It("computes another hash when the workload can be rendered again", func() {
+		namespace.Variables = map[string]string{"port": "80"}
+		failed, err := getConfiguration()
+		Expect(err).To(BeNil())
+
+		namespace.Variables = map[string]string{"site": "paris", "port": "80"}
+		fixed, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(fixed.Hash).ToNot(Equal(failed.Hash))
+	})
+
+	It("renders quadlet units", func() {
+		t, err := models.ParseTemplate("units/web.container.tmpl", []byte("[Container]\nImage=nginx\nPublishPort={{ .Vars.port }}:80\n"))
+		Expect(err).To(BeNil())
+		workload.Kind = entity.QuadletWorkloadKind
+		workload.Templates = []models.Template{t}
+
+		conf, err := getConfiguration()
+		Expect(err).To(BeNil())
+		Expect(len(conf.Workloads[0].Quadlets)).To(Equal(1))
+		Expect(conf.Workloads[0].Quadlets[0].Name).To(Equal("web.container"))
+		Expect(conf.Workloads[0].Quadlets[0].Get("Container", "PublishPort")).To(Equal("8080:80"))
+	})
+})
@@ -0,0 +1,80 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package device
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that CertificateRevokerMock does implement CertificateRevoker.
+// If this is not the case, regenerate this file with moq.
+var _ CertificateRevoker = &CertificateRevokerMock{}
+
+// CertificateRevokerMock is a mock implementation of CertificateRevoker.
+//
+// 	func TestSomethingThatUsesCertificateRevoker(t *testing.T) {
+//
+// 		// make and configure a mocked CertificateRevoker
+// 		mockedCertificateRevoker := &CertificateRevokerMock{
+// 			RevokeCertificateFunc: func(ctx context.Context, serialNumber string) error {
+// 				panic("mock out the RevokeCertificate method")
+// 			},
+// 		}
+//
+// 		// use mockedCertificateRevoker in code that requires CertificateRevoker
+// 		// and then make assertions.
+//
+// 	}
+type CertificateRevokerMock struct {
+	// RevokeCertificateFunc mocks the RevokeCertificate method.
+	RevokeCertificateFunc func(ctx context.Context, serialNumber string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// RevokeCertificate holds details about calls to the RevokeCertificate method.
+		RevokeCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SerialNumber is the serialNumber argument value.
+			SerialNumber string
+		}
+	}
+	lockRevokeCertificate sync.RWMutex
+}
+
+// RevokeCertificate calls RevokeCertificateFunc.
+func (mock *CertificateRevokerMock) RevokeCertificate(ctx context.Context, serialNumber string) error {
+	if mock.RevokeCertificateFunc == nil {
+		panic("CertificateRevokerMock.RevokeCertificateFunc: method is nil but CertificateRevoker.RevokeCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		SerialNumber string
+	}{
+		Ctx:          ctx,
+		SerialNumber: serialNumber,
+	}
+	mock.lockRevokeCertificate.Lock()
+	mock.calls.RevokeCertificate = append(mock.calls.RevokeCertificate, callInfo)
+	mock.lockRevokeCertificate.Unlock()
+	return mock.RevokeCertificateFunc(ctx, serialNumber)
+}
+
+// RevokeCertificateCalls gets all the calls that were made to RevokeCertificate.
+// Check the length with:
+//     len(mockedCertificateRevoker.RevokeCertificateCalls())
+func (mock *CertificateRevokerMock) RevokeCertificateCalls() []struct {
+	Ctx          context.Context
+	SerialNumber string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		SerialNumber string
+	}
+	mock.lockRevokeCertificate.RLock()
+	calls = mock.calls.RevokeCertificate
+	mock.lockRevokeCertificate.RUnlock()
+	return calls
+}
@@ -28,6 +28,12 @@ var _ DeviceReaderWriter = &DeviceReaderWriterMock{}
 // 			CreateSetFunc: func(ctx context.Context, set entity.Set) error {
 // 				panic("mock out the CreateSet method")
 // 			},
+// 			DeleteDeviceFunc: func(ctx context.Context, id string) error {
+// 				panic("mock out the DeleteDevice method")
+// 			},
+// 			DeleteDeviceRelationsFunc: func(ctx context.Context, id string) error {
+// 				panic("mock out the DeleteDeviceRelations method")
+// 			},
 // 			DeleteNamespaceFunc: func(ctx context.Context, id string) error {
 // 				panic("mock out the DeleteNamespace method")
 // 			},
@@ -55,9 +61,18 @@ var _ DeviceReaderWriter = &DeviceReaderWriterMock{}
 // 			GetSetsFunc: func(ctx context.Context) ([]entity.Set, error) {
 // 				panic("mock out the GetSets method")
 // 			},
+// 			SetDeviceLabelsFunc: func(ctx context.Context, id string, labels map[string]string) error {
+// 				panic("mock out the SetDeviceLabels method")
+// 			},
+// 			SetVariablesFunc: func(ctx context.Context, target entity.VariablesTarget, id string, variables map[string]string) error {
+// 				panic("mock out the SetVariables method")
+// 			},
 // 			UpdateDeviceFunc: func(ctx context.Context, device entity.Device) error {
 // 				panic("mock out the UpdateDevice method")
 // 			},
+// 			UpdateDeviceStateFunc: func(ctx context.Context, id string, state entity.DeviceState) error {
+// 				panic("mock out the UpdateDeviceState method")
+// 			},
 // 			UpdateNamespaceFunc: func(ctx context.Context, namespace entity.Namespace) error {
 // 				panic("mock out the UpdateNamespace method")
 // 			},
@@ -77,6 +92,12 @@ type DeviceReaderWriterMock struct {
 	// CreateSetFunc mocks the CreateSet method.
 	CreateSetFunc func(ctx context.Context, set entity.Set) error
 
+	// DeleteDeviceFunc mocks the DeleteDevice method.
+	DeleteDeviceFunc func(ctx context.Context, id string) error
+
+	// DeleteDeviceRelationsFunc mocks the DeleteDeviceRelations method.
+	DeleteDeviceRelationsFunc func(ctx context.Context, id string) error
+
 	// DeleteNamespaceFunc mocks the DeleteNamespace method.
 	DeleteNamespaceFunc func(ctx context.Context, id string) error
 
@@ -104,9 +125,18 @@ type DeviceReaderWriterMock struct {
 	// GetSetsFunc mocks the GetSets method.
 	GetSetsFunc func(ctx context.Context) ([]entity.Set, error)
 
+	// SetDeviceLabelsFunc mocks the SetDeviceLabels method.
+	SetDeviceLabelsFunc func(ctx context.Context, id string, labels map[string]string) error
+
+	// SetVariablesFunc mocks the SetVariables method.
+	SetVariablesFunc func(ctx context.Context, target entity.VariablesTarget, id string, variables map[string]string) error
+
 	// UpdateDeviceFunc mocks the UpdateDevice method.
 	UpdateDeviceFunc func(ctx context.Context, device entity.Device) error
 
+	// UpdateDeviceStateFunc mocks the UpdateDeviceState method.
+	UpdateDeviceStateFunc func(ctx context.Context, id string, state entity.DeviceState) error
+
 	// UpdateNamespaceFunc mocks the UpdateNamespace method.
 	UpdateNamespaceFunc func(ctx context.Context, namespace entity.Namespace) error
 
@@ -133,6 +163,20 @@ type DeviceReaderWriterMock struct {
 			// Set is the set argument value.
 			Set entity.Set
 		}
+		// DeleteDevice holds details about calls to the DeleteDevice method.
+		DeleteDevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteDeviceRelations holds details about calls to the DeleteDeviceRelations method.
+		DeleteDeviceRelations []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
 		// DeleteNamespace holds details about calls to the DeleteNamespace method.
 		DeleteNamespace []struct {
 			// Ctx is the ctx argument value.
@@ -188,6 +232,26 @@ type DeviceReaderWriterMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// SetDeviceLabels holds details about calls to the SetDeviceLabels method.
+		SetDeviceLabels []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Labels is the labels argument value.
+			Labels map[string]string
+		}
+		// SetVariables holds details about calls to the SetVariables method.
+		SetVariables []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Target is the target argument value.
+			Target entity.VariablesTarget
+			// ID is the id argument value.
+			ID string
+			// Variables is the variables argument value.
+			Variables map[string]string
+		}
 		// UpdateDevice holds details about calls to the UpdateDevice method.
 		UpdateDevice []struct {
 			// Ctx is the ctx argument value.
@@ -195,6 +259,15 @@ type DeviceReaderWriterMock struct {
 			// Device is the device argument value.
 			Device entity.Device
 		}
+		// UpdateDeviceState holds details about calls to the UpdateDeviceState method.
+		UpdateDeviceState []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// State is the state argument value.
+			State entity.DeviceState
+		}
 		// UpdateNamespace holds details about calls to the UpdateNamespace method.
 		UpdateNamespace []struct {
 			// Ctx is the ctx argument value.
@@ -203,20 +276,25 @@ type DeviceReaderWriterMock struct {
 			Namespace entity.Namespace
 		}
 	}
-	lockCreateDevice        sync.RWMutex
-	lockCreateNamespace     sync.RWMutex
-	lockCreateSet           sync.RWMutex
-	lockDeleteNamespace     sync.RWMutex
-	lockDeleteSet           sync.RWMutex
-	lockGetDefaultNamespace sync.RWMutex
-	lockGetDevice           sync.RWMutex
-	lockGetDevices          sync.RWMutex
-	lockGetNamespace        sync.RWMutex
-	lockGetNamespaces       sync.RWMutex
-	lockGetSet              sync.RWMutex
-	lockGetSets             sync.RWMutex
-	lockUpdateDevice        sync.RWMutex
-	lockUpdateNamespace     sync.RWMutex
+	lockCreateDevice          sync.RWMutex
+	lockCreateNamespace       sync.RWMutex
+	lockCreateSet             sync.RWMutex
+	lockDeleteDevice          sync.RWMutex
+	lockDeleteDeviceRelations sync.RWMutex
+	lockDeleteNamespace       sync.RWMutex
+	lockDeleteSet             sync.RWMutex
+	lockGetDefaultNamespace   sync.RWMutex
+	lockGetDevice             sync.RWMutex
+	lockGetDevices            sync.RWMutex
+	lockGetNamespace          sync.RWMutex
+	lockGetNamespaces         sync.RWMutex
+	lockGetSet                sync.RWMutex
+	lockGetSets               sync.RWMutex
+	lockSetDeviceLabels       sync.RWMutex
+	lockSetVariables          sync.RWMutex
+	lockUpdateDevice          sync.RWMutex
+	lockUpdateDeviceState     sync.RWMutex
+	lockUpdateNamespace       sync.RWMutex
 }
 
 // CreateDevice calls CreateDeviceFunc.
@@ -324,6 +402,76 @@ func (mock *DeviceReaderWriterMock) CreateSetCalls() []struct {
 	return calls
 }
 
+// DeleteDevice calls DeleteDeviceFunc.
+func (mock *DeviceReaderWriterMock) DeleteDevice(ctx context.Context, id string) error {
+	if mock.DeleteDeviceFunc == nil {
+		panic("DeviceReaderWriterMock.DeleteDeviceFunc: method is nil but DeviceReaderWriter.DeleteDevice was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteDevice.Lock()
+	mock.calls.DeleteDevice = append(mock.calls.DeleteDevice, callInfo)
+	mock.lockDeleteDevice.Unlock()
+	return mock.DeleteDeviceFunc(ctx, id)
+}
+
+// DeleteDeviceCalls gets all the calls that were made to DeleteDevice.
+// Check the length with:
+//     len(mockedDeviceReaderWriter.DeleteDeviceCalls())
+func (mock *DeviceReaderWriterMock) DeleteDeviceCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteDevice.RLock()
+	calls = mock.calls.DeleteDevice
+	mock.lockDeleteDevice.RUnlock()
+	return calls
+}
+
+// DeleteDeviceRelations calls DeleteDeviceRelationsFunc.
+func (mock *DeviceReaderWriterMock) DeleteDeviceRelations(ctx context.Context, id string) error {
+	if mock.DeleteDeviceRelationsFunc == nil {
+		panic("DeviceReaderWriterMock.DeleteDeviceRelationsFunc: method is nil but DeviceReaderWriter.DeleteDeviceRelations was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteDeviceRelations.Lock()
+	mock.calls.DeleteDeviceRelations = append(mock.calls.DeleteDeviceRelations, callInfo)
+	mock.lockDeleteDeviceRelations.Unlock()
+	return mock.DeleteDeviceRelationsFunc(ctx, id)
+}
+
+// DeleteDeviceRelationsCalls gets all the calls that were made to DeleteDeviceRelations.
+// Check the length with:
+//     len(mockedDeviceReaderWriter.DeleteDeviceRelationsCalls())
+func (mock *DeviceReaderWriterMock) DeleteDeviceRelationsCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteDeviceRelations.RLock()
+	calls = mock.calls.DeleteDeviceRelations
+	mock.lockDeleteDeviceRelations.RUnlock()
+	return calls
+}
+
 // DeleteNamespace calls DeleteNamespaceFunc.
 func (mock *DeviceReaderWriterMock) DeleteNamespace(ctx context.Context, id string) error {
 	if mock.DeleteNamespaceFunc == nil {
@@ -623,6 +771,88 @@ func (mock *DeviceReaderWriterMock) GetSetsCalls() []struct {
 	return calls
 }
 
+// SetDeviceLabels calls SetDeviceLabelsFunc.
+func (mock *DeviceReaderWriterMock) SetDeviceLabels(ctx context.Context, id string, labels map[string]string) error {
+	if mock.SetDeviceLabelsFunc == nil {
+		panic("DeviceReaderWriterMock.SetDeviceLabelsFunc: method is nil but DeviceReaderWriter.SetDeviceLabels was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     string
+		Labels map[string]string
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Labels: labels,
+	}
+	mock.lockSetDeviceLabels.Lock()
+	mock.calls.SetDeviceLabels = append(mock.calls.SetDeviceLabels, callInfo)
+	mock.lockSetDeviceLabels.Unlock()
+	return mock.SetDeviceLabelsFunc(ctx, id, labels)
+}
+
+// SetDeviceLabelsCalls gets all the calls that were made to SetDeviceLabels.
+// Check the length with:
+//     len(mockedDeviceReaderWriter.SetDeviceLabelsCalls())
+func (mock *DeviceReaderWriterMock) SetDeviceLabelsCalls() []struct {
+	Ctx    context.Context
+	ID     string
+	Labels map[string]string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     string
+		Labels map[string]string
+	}
+	mock.lockSetDeviceLabels.RLock()
+	calls = mock.calls.SetDeviceLabels
+	mock.lockSetDeviceLabels.RUnlock()
+	return calls
+}
+
+// SetVariables calls SetVariablesFunc.
+func (mock *DeviceReaderWriterMock) SetVariables(ctx context.Context, target entity.VariablesTarget, id string, variables map[string]string) error {
+	if mock.SetVariablesFunc == nil {
+		panic("DeviceReaderWriterMock.SetVariablesFunc: method is nil but DeviceReaderWriter.SetVariables was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Target    entity.VariablesTarget
+		ID        string
+		Variables map[string]string
+	}{
+		Ctx:       ctx,
+		Target:    target,
+		ID:        id,
+		Variables: variables,
+	}
+	mock.lockSetVariables.Lock()
+	mock.calls.SetVariables = append(mock.calls.SetVariables, callInfo)
+	mock.lockSetVariables.Unlock()
+	return mock.SetVariablesFunc(ctx, target, id, variables)
+}
+
+// SetVariablesCalls gets all the calls that were made to SetVariables.
+// Check the length with:
+//     len(mockedDeviceReaderWriter.SetVariablesCalls())
+func (mock *DeviceReaderWriterMock) SetVariablesCalls() []struct {
+	Ctx       context.Context
+	Target    entity.VariablesTarget
+	ID        string
+	Variables map[string]string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Target    entity.VariablesTarget
+		ID        string
+		Variables map[string]string
+	}
+	mock.lockSetVariables.RLock()
+	calls = mock.calls.SetVariables
+	mock.lockSetVariables.RUnlock()
+	return calls
+}
+
 // UpdateDevice calls UpdateDeviceFunc.
 func (mock *DeviceReaderWriterMock) UpdateDevice(ctx context.Context, device entity.Device) error {
 	if mock.UpdateDeviceFunc == nil {
@@ -658,6 +888,45 @@ func (mock *DeviceReaderWriterMock) UpdateDeviceCalls() []struct {
 	return calls
 }
 
+// UpdateDeviceState calls UpdateDeviceStateFunc.
+func (mock *DeviceReaderWriterMock) UpdateDeviceState(ctx context.Context, id string, state entity.DeviceState) error {
+	if mock.UpdateDeviceStateFunc == nil {
+		panic("DeviceReaderWriterMock.UpdateDeviceStateFunc: method is nil but DeviceReaderWriter.UpdateDeviceState was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ID    string
+		State entity.DeviceState
+	}{
+		Ctx:   ctx,
+		ID:    id,
+		State: state,
+	}
+	mock.lockUpdateDeviceState.Lock()
+	mock.calls.UpdateDeviceState = append(mock.calls.UpdateDeviceState, callInfo)
+	mock.lockUpdateDeviceState.Unlock()
+	return mock.UpdateDeviceStateFunc(ctx, id, state)
+}
+
+// UpdateDeviceStateCalls gets all the calls that were made to UpdateDeviceState.
+// Check the length with:
+//     len(mockedDeviceReaderWriter.UpdateDeviceStateCalls())
+func (mock *DeviceReaderWriterMock) UpdateDeviceStateCalls() []struct {
+	Ctx   context.Context
+	ID    string
+	State entity.DeviceState
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ID    string
+		State entity.DeviceState
+	}
+	mock.lockUpdateDeviceState.RLock()
+	calls = mock.calls.UpdateDeviceState
+	mock.lockUpdateDeviceState.RUnlock()
+	return calls
+}
+
 // UpdateNamespace calls UpdateNamespaceFunc.
 func (mock *DeviceReaderWriterMock) UpdateNamespace(ctx context.Context, namespace entity.Namespace) error {
 	if mock.UpdateNamespaceFunc == nil {
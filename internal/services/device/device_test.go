@@ -3,6 +3,7 @@ package device_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -12,6 +13,11 @@ import (
 )
 
 var _ = Describe("Device", func() {
+	notifier := &device.NotifierMock{
+		NotifyDevicesFunc: func(deviceIDs ...string) {},
+	}
+	certRevoker := &device.CertificateRevokerMock{}
+
 	Describe("Delete namespace", func() {
 		It("correctly delete the default namespace", func() {
 			deviceReaderWriter := &device.DeviceReaderWriterMock{
@@ -42,7 +48,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			namespace, err := service.DeleteNamespace(context.TODO(), "default")
 			Expect(err).To(BeNil())
 			Expect(namespace.Name).To(Equal("default"))
@@ -91,7 +97,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			namespace, err := service.DeleteNamespace(context.TODO(), "default")
 			Expect(err).To(BeNil())
 			Expect(namespace.Name).To(Equal("default"))
@@ -129,7 +135,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			_, err := service.DeleteNamespace(context.TODO(), "default")
 			Expect(err).ToNot(BeNil())
 			Expect(err.Error()).To(ContainSubstring("cannot delete the last namespace"))
@@ -154,7 +160,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			_, err := service.DeleteNamespace(context.TODO(), "default")
 			Expect(err).ToNot(BeNil())
 		})
@@ -185,7 +191,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			_, err := service.DeleteNamespace(context.TODO(), "default")
 			Expect(err).ToNot(BeNil())
 		})
@@ -219,7 +225,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			_, err := service.DeleteNamespace(context.TODO(), "default")
 			Expect(err).ToNot(BeNil())
 		})
@@ -235,7 +241,7 @@ var _ = Describe("Device", func() {
 					return entity.Namespace{}, errService.NewResourceNotFoundError("namespace", id)
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateNamespace(context.TODO(), entity.Namespace{Name: "default"})
 			Expect(err).To(BeNil())
 		})
@@ -248,7 +254,7 @@ var _ = Describe("Device", func() {
 					return entity.Namespace{}, nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateNamespace(context.TODO(), entity.Namespace{Name: "default"})
 			Expect(err).ToNot(BeNil())
 			Expect(err).To(BeAssignableToTypeOf(errService.ResourceAlreadyExists{}))
@@ -262,7 +268,7 @@ var _ = Describe("Device", func() {
 					return entity.Namespace{}, errors.New("unknown")
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateNamespace(context.TODO(), entity.Namespace{Name: "default"})
 			Expect(err).ToNot(BeNil())
 		})
@@ -275,7 +281,7 @@ var _ = Describe("Device", func() {
 					return entity.Namespace{}, nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateNamespace(context.TODO(), entity.Namespace{Name: "default"})
 			Expect(err).ToNot(BeNil())
 		})
@@ -294,7 +300,7 @@ var _ = Describe("Device", func() {
 					return entity.Namespace{}, nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateSet(context.TODO(), entity.Set{Name: "default", NamespaceID: "default"})
 			Expect(err).To(BeNil())
 			Expect(len(deviceReaderWriter.CreateSetCalls())).To(Equal(1))
@@ -305,7 +311,7 @@ var _ = Describe("Device", func() {
 					return entity.Set{}, nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateSet(context.TODO(), entity.Set{Name: "default"})
 			Expect(err).ToNot(BeNil())
 			Expect(err).To(BeAssignableToTypeOf(errService.ResourceAlreadyExists{}))
@@ -316,7 +322,7 @@ var _ = Describe("Device", func() {
 					return entity.Set{}, errService.NewResourceNotFoundError("set", id)
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateSet(context.TODO(), entity.Set{Name: "default"})
 			Expect(err).ToNot(BeNil())
 			Expect(err.Error()).To(ContainSubstring("namespace is missing"))
@@ -330,7 +336,7 @@ var _ = Describe("Device", func() {
 					return entity.Namespace{}, errService.NewResourceNotFoundError("namespace", id)
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateSet(context.TODO(), entity.Set{Name: "default"})
 			Expect(err).ToNot(BeNil())
 		})
@@ -346,7 +352,7 @@ var _ = Describe("Device", func() {
 					return entity.Namespace{}, nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.CreateSet(context.TODO(), entity.Set{Name: "default", NamespaceID: "default"})
 			Expect(err).ToNot(BeNil())
 		})
@@ -362,7 +368,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			_, err := service.DeleteSet(context.TODO(), "id")
 			Expect(err).To(BeNil())
 		})
@@ -375,7 +381,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			_, err := service.DeleteSet(context.TODO(), "id")
 			Expect(err).ToNot(BeNil())
 		})
@@ -388,7 +394,7 @@ var _ = Describe("Device", func() {
 					return errors.New("error")
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			_, err := service.DeleteSet(context.TODO(), "id")
 			Expect(err).ToNot(BeNil())
 		})
@@ -400,7 +406,7 @@ var _ = Describe("Device", func() {
 					return nil
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.UpdateDevice(context.TODO(), entity.Device{})
 			Expect(err).To(BeNil())
 		})
@@ -410,9 +416,294 @@ var _ = Describe("Device", func() {
 					return errors.New("error")
 				},
 			}
-			service := device.New(deviceReaderWriter)
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
 			err := service.UpdateDevice(context.TODO(), entity.Device{})
 			Expect(err).ToNot(BeNil())
 		})
 	})
+	Describe("Enrolment approval", func() {
+		It("lists only pending devices", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDevicesFunc: func(ctx context.Context) ([]entity.Device, error) {
+					return []entity.Device{
+						{ID: "enroled", EnrolStatus: entity.EnroledStatus},
+						{ID: "pending", EnrolStatus: entity.PendingEnrolStatus},
+						{ID: "refused", EnrolStatus: entity.RefusedEnrolStatus},
+					}, nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			devices, err := service.GetPendingDevices(context.TODO())
+			Expect(err).To(BeNil())
+			Expect(len(devices)).To(Equal(1))
+			Expect(devices[0].ID).To(Equal("pending"))
+		})
+		It("approves a pending device into the set's namespace", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, NamespaceID: "default", EnrolStatus: entity.PendingEnrolStatus}, nil
+				},
+				GetSetFunc: func(ctx context.Context, id string) (entity.Set, error) {
+					return entity.Set{Name: id, NamespaceID: "lab"}, nil
+				},
+				UpdateDeviceFunc: func(ctx context.Context, device entity.Device) error {
+					return nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			d, err := service.ApproveDevice(context.TODO(), "toto", "", "set")
+			Expect(err).To(BeNil())
+			Expect(d.EnrolStatus).To(Equal(entity.EnroledStatus))
+
+			calls := deviceReaderWriter.UpdateDeviceCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].Device.EnrolStatus).To(Equal(entity.EnroledStatus))
+			Expect(calls[0].Device.NamespaceID).To(Equal("lab"))
+			Expect(*calls[0].Device.SetID).To(Equal("set"))
+		})
+		It("cannot approve a device into a set from another namespace", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, NamespaceID: "default", EnrolStatus: entity.PendingEnrolStatus}, nil
+				},
+				GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+					return entity.Namespace{Name: id}, nil
+				},
+				GetSetFunc: func(ctx context.Context, id string) (entity.Set, error) {
+					return entity.Set{Name: id, NamespaceID: "lab"}, nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			_, err := service.ApproveDevice(context.TODO(), "toto", "default", "set")
+			Expect(err).ToNot(BeNil())
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+			Expect(len(deviceReaderWriter.UpdateDeviceCalls())).To(Equal(0))
+		})
+		It("cannot approve an enroled device", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, EnrolStatus: entity.EnroledStatus}, nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			_, err := service.ApproveDevice(context.TODO(), "toto", "", "")
+			Expect(err).ToNot(BeNil())
+			_, ok := err.(errService.InvalidEnrolStatusError)
+			Expect(ok).To(BeTrue())
+		})
+		It("refuses a pending device", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, EnrolStatus: entity.PendingEnrolStatus}, nil
+				},
+				UpdateDeviceFunc: func(ctx context.Context, device entity.Device) error {
+					return nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			d, err := service.RefuseDevice(context.TODO(), "toto")
+			Expect(err).To(BeNil())
+			Expect(d.EnrolStatus).To(Equal(entity.RefusedEnrolStatus))
+			Expect(len(deviceReaderWriter.UpdateDeviceCalls())).To(Equal(1))
+		})
+	})
+	Describe("Update device state", func() {
+		It("device is late", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				UpdateDeviceStateFunc: func(ctx context.Context, id string, state entity.DeviceState) error {
+					return nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			d := entity.Device{ID: "toto", State: entity.OnlineDeviceState, LastSeen: time.Now().UTC().Add(-30 * time.Second)}
+			state, err := service.UpdateDeviceState(context.TODO(), d, 10*time.Second)
+			Expect(err).To(BeNil())
+			Expect(state).To(Equal(entity.LateDeviceState))
+
+			calls := deviceReaderWriter.UpdateDeviceStateCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].State).To(Equal(entity.LateDeviceState))
+		})
+		It("device is offline", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				UpdateDeviceStateFunc: func(ctx context.Context, id string, state entity.DeviceState) error {
+					return nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			d := entity.Device{ID: "toto", State: entity.LateDeviceState, LastSeen: time.Now().UTC().Add(-time.Minute)}
+			state, err := service.UpdateDeviceState(context.TODO(), d, 10*time.Second)
+			Expect(err).To(BeNil())
+			Expect(state).To(Equal(entity.OfflineDeviceState))
+		})
+		It("state is not saved when unchanged", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			d := entity.Device{ID: "toto", State: entity.OnlineDeviceState, LastSeen: time.Now().UTC()}
+			state, err := service.UpdateDeviceState(context.TODO(), d, 10*time.Second)
+			Expect(err).To(BeNil())
+			Expect(state).To(Equal(entity.OnlineDeviceState))
+			Expect(len(deviceReaderWriter.UpdateDeviceStateCalls())).To(Equal(0))
+		})
+		It("device never seen is offline", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			state, err := service.UpdateDeviceState(context.TODO(), entity.Device{ID: "toto"}, 10*time.Second)
+			Expect(err).To(BeNil())
+			Expect(state).To(Equal(entity.OfflineDeviceState))
+		})
+	})
+	Describe("Decommission device", func() {
+		It("revokes the certificate and deletes the device", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, Registred: true, CertificateSerialNumber: "sn"}, nil
+				},
+				DeleteDeviceFunc: func(ctx context.Context, id string) error {
+					return nil
+				},
+			}
+			revoker := &device.CertificateRevokerMock{
+				RevokeCertificateFunc: func(ctx context.Context, serialNumber string) error {
+					return nil
+				},
+			}
+			service := device.New(deviceReaderWriter, revoker, notifier)
+			_, err := service.DecommissionDevice(context.TODO(), "toto", false)
+			Expect(err).To(BeNil())
+			Expect(len(revoker.RevokeCertificateCalls())).To(Equal(1))
+			Expect(revoker.RevokeCertificateCalls()[0].SerialNumber).To(Equal("sn"))
+			Expect(len(deviceReaderWriter.DeleteDeviceCalls())).To(Equal(1))
+		})
+		It("keeps a tombstone", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					set := "set"
+					return entity.Device{ID: id, Registred: true, CertificateSerialNumber: "sn", SetID: &set}, nil
+				},
+				DeleteDeviceRelationsFunc: func(ctx context.Context, id string) error {
+					return nil
+				},
+				UpdateDeviceFunc: func(ctx context.Context, device entity.Device) error {
+					return nil
+				},
+			}
+			revoker := &device.CertificateRevokerMock{
+				RevokeCertificateFunc: func(ctx context.Context, serialNumber string) error {
+					return nil
+				},
+			}
+			service := device.New(deviceReaderWriter, revoker, notifier)
+			d, err := service.DecommissionDevice(context.TODO(), "toto", true)
+			Expect(err).To(BeNil())
+			Expect(d.EnrolStatus).To(Equal(entity.DecommissionedEnrolStatus))
+			Expect(len(deviceReaderWriter.DeleteDeviceRelationsCalls())).To(Equal(1))
+
+			calls := deviceReaderWriter.UpdateDeviceCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].Device.SetID).To(BeNil())
+			Expect(calls[0].Device.CertificateSerialNumber).To(Equal("sn"))
+			Expect(calls[0].Device.DecommissionedAt.IsZero()).To(BeFalse())
+		})
+		It("does not remove the device when the certificate cannot be revoked", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, Registred: true, CertificateSerialNumber: "sn"}, nil
+				},
+			}
+			revoker := &device.CertificateRevokerMock{
+				RevokeCertificateFunc: func(ctx context.Context, serialNumber string) error {
+					return errors.New("vault not available")
+				},
+			}
+			service := device.New(deviceReaderWriter, revoker, notifier)
+			_, err := service.DecommissionDevice(context.TODO(), "toto", false)
+			Expect(err).ToNot(BeNil())
+			Expect(len(deviceReaderWriter.DeleteDeviceCalls())).To(Equal(0))
+		})
+		It("deletes a device without certificate", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, EnrolStatus: entity.PendingEnrolStatus}, nil
+				},
+				DeleteDeviceFunc: func(ctx context.Context, id string) error {
+					return nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			_, err := service.DecommissionDevice(context.TODO(), "toto", false)
+			Expect(err).To(BeNil())
+			Expect(len(deviceReaderWriter.DeleteDeviceCalls())).To(Equal(1))
+		})
+	})
+
+	Describe("Labels", func() {
+		It("merges and removes labels", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, Labels: map[string]string{"env": "dev", "zone": "a"}}, nil
+				},
+				SetDeviceLabelsFunc: func(ctx context.Context, id string, labels map[string]string) error {
+					return nil
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			d, err := service.UpdateDeviceLabels(context.TODO(), "toto", map[string]string{"env": "prod", "arch": "arm64"}, []string{"zone"})
+			Expect(err).To(BeNil())
+			Expect(d.Labels).To(Equal(map[string]string{"env": "prod", "arch": "arm64"}))
+
+			calls := deviceReaderWriter.SetDeviceLabelsCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].Labels).To(Equal(map[string]string{"env": "prod", "arch": "arm64"}))
+		})
+		It("returns error when the device is not found", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{}, errors.New("not found")
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			_, err := service.UpdateDeviceLabels(context.TODO(), "toto", map[string]string{"env": "prod"}, nil)
+			Expect(err).ToNot(BeNil())
+			Expect(len(deviceReaderWriter.SetDeviceLabelsCalls())).To(Equal(0))
+		})
+	})
+
+	Describe("Variables", func() {
+		It("merges and removes the variables of a set and notifies its devices", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetSetFunc: func(ctx context.Context, id string) (entity.Set, error) {
+					return entity.Set{Name: id, Devices: []string{"toto", "titi"}, Variables: map[string]string{"site": "paris", "port": "80"}}, nil
+				},
+				SetVariablesFunc: func(ctx context.Context, target entity.VariablesTarget, id string, variables map[string]string) error {
+					return nil
+				},
+			}
+			setNotifier := &device.NotifierMock{
+				NotifyDevicesFunc: func(deviceIDs ...string) {},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, setNotifier)
+			variables, err := service.UpdateVariables(context.TODO(), entity.SetVariablesTarget, "set", map[string]string{"site": "lyon"}, []string{"port"})
+			Expect(err).To(BeNil())
+			Expect(variables).To(Equal(map[string]string{"site": "lyon"}))
+
+			calls := deviceReaderWriter.SetVariablesCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].Target).To(Equal(entity.SetVariablesTarget))
+			Expect(calls[0].ID).To(Equal("set"))
+			Expect(calls[0].Variables).To(Equal(map[string]string{"site": "lyon"}))
+			Expect(setNotifier.NotifyDevicesCalls()[0].DeviceIDs).To(Equal([]string{"toto", "titi"}))
+		})
+		It("returns error when the namespace is not found", func() {
+			deviceReaderWriter := &device.DeviceReaderWriterMock{
+				GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+					return entity.Namespace{}, errService.NewResourceNotFoundError("namespace", id)
+				},
+			}
+			service := device.New(deviceReaderWriter, certRevoker, notifier)
+			_, err := service.UpdateVariables(context.TODO(), entity.NamespaceVariablesTarget, "default", map[string]string{"site": "lyon"}, nil)
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+			Expect(len(deviceReaderWriter.SetVariablesCalls())).To(Equal(0))
+		})
+	})
 })
@@ -21,6 +21,11 @@ type DeviceReader interface {
 type DeviceWriter interface {
 	CreateDevice(ctx context.Context, device entity.Device) error
 	UpdateDevice(ctx context.Context, device entity.Device) error
+	DeleteDevice(ctx context.Context, id string) error
+	DeleteDeviceRelations(ctx context.Context, id string) error
+	UpdateDeviceState(ctx context.Context, id string, state entity.DeviceState) error
+	SetDeviceLabels(ctx context.Context, id string, labels map[string]string) error
+	SetVariables(ctx context.Context, target entity.VariablesTarget, id string, variables map[string]string) error
 	CreateSet(ctx context.Context, set entity.Set) error
 	DeleteSet(ctx context.Context, id string) error
 	DeleteNamespace(ctx context.Context, id string) error
@@ -33,3 +38,13 @@ type DeviceReaderWriter interface {
 	DeviceReader
 	DeviceWriter
 }
+
+//go:generate moq -out notifier_moq.go . Notifier
+type Notifier interface {
+	NotifyDevices(deviceIDs ...string)
+}
+
+//go:generate moq -out certificate_revoker_moq.go . CertificateRevoker
+type CertificateRevoker interface {
+	RevokeCertificate(ctx context.Context, serialNumber string) error
+}
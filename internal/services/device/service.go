@@ -3,18 +3,28 @@ package device
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
 	"go.uber.org/zap"
 )
 
+const (
+	// lateHeartbeatFactor is the number of heartbeat periods after which a device is considered late.
+	lateHeartbeatFactor = 2
+	// offlineHeartbeatFactor is the number of heartbeat periods after which a device is considered offline.
+	offlineHeartbeatFactor = 5
+)
+
 type Service struct {
 	pgDeviceRepo DeviceReaderWriter
+	certRevoker  CertificateRevoker
+	notifier     Notifier
 }
 
-func New(pgDeviceRepo DeviceReaderWriter) *Service {
-	return &Service{pgDeviceRepo: pgDeviceRepo}
+func New(pgDeviceRepo DeviceReaderWriter, certRevoker CertificateRevoker, notifier Notifier) *Service {
+	return &Service{pgDeviceRepo: pgDeviceRepo, certRevoker: certRevoker, notifier: notifier}
 }
 
 func (w *Service) GetNamespaces(ctx context.Context) ([]entity.Namespace, error) {
@@ -141,15 +151,248 @@ func (w *Service) GetDevices(ctx context.Context) ([]entity.Device, error) {
 	return w.pgDeviceRepo.GetDevices(ctx)
 }
 
+// GetPendingDevices returns the devices waiting for an enrolment decision.
+func (w *Service) GetPendingDevices(ctx context.Context) ([]entity.Device, error) {
+	devices, err := w.pgDeviceRepo.GetDevices(ctx)
+	if err != nil {
+		return []entity.Device{}, err
+	}
+
+	pending := make([]entity.Device, 0, len(devices))
+	for _, d := range devices {
+		if d.EnrolStatus == entity.PendingEnrolStatus {
+			pending = append(pending, d)
+		}
+	}
+
+	return pending, nil
+}
+
+// ApproveDevice enrols a pending or refused device. If namespaceID or setID are not empty the device is moved
+// into the namespace and/or set. When only the set is provided, the device is moved into the set's namespace.
+func (w *Service) ApproveDevice(ctx context.Context, id string, namespaceID string, setID string) (entity.Device, error) {
+	device, err := w.GetDevice(ctx, id)
+	if err != nil {
+		return entity.Device{}, err
+	}
+
+	if device.EnrolStatus != entity.PendingEnrolStatus && device.EnrolStatus != entity.RefusedEnrolStatus {
+		return entity.Device{}, errService.NewInvalidEnrolStatusError(id, device.EnrolStatus.String())
+	}
+
+	if namespaceID != "" {
+		if _, err := w.GetNamespace(ctx, namespaceID); err != nil {
+			return entity.Device{}, err
+		}
+		device.NamespaceID = namespaceID
+	}
+
+	if setID != "" {
+		set, err := w.GetSet(ctx, setID)
+		if err != nil {
+			return entity.Device{}, err
+		}
+		if namespaceID != "" && set.NamespaceID != namespaceID {
+			return entity.Device{}, errService.NewResourceNotFoundErrorWithReason(fmt.Sprintf("set %q not found in namespace %q", setID, namespaceID))
+		}
+		device.NamespaceID = set.NamespaceID
+		device.SetID = &set.Name
+	}
+
+	device.EnrolStatus = entity.EnroledStatus
+	device.EnroledAt = time.Now().UTC()
+
+	if err := w.pgDeviceRepo.UpdateDevice(ctx, device); err != nil {
+		return entity.Device{}, err
+	}
+
+	w.notifier.NotifyDevices(device.ID)
+
+	zap.S().Infow("device enrolment approved", "device_id", id, "namespace_id", device.NamespaceID, "set_id", setID)
+	return device, nil
+}
+
+// RefuseDevice refuses the enrolment of a pending device.
+func (w *Service) RefuseDevice(ctx context.Context, id string) (entity.Device, error) {
+	device, err := w.GetDevice(ctx, id)
+	if err != nil {
+		return entity.Device{}, err
+	}
+
+	if device.EnrolStatus != entity.PendingEnrolStatus {
+		return entity.Device{}, errService.NewInvalidEnrolStatusError(id, device.EnrolStatus.String())
+	}
+
+	device.EnrolStatus = entity.RefusedEnrolStatus
+
+	if err := w.pgDeviceRepo.UpdateDevice(ctx, device); err != nil {
+		return entity.Device{}, err
+	}
+
+	zap.S().Infow("device enrolment refused", "device_id", id)
+	return device, nil
+}
+
 func (w *Service) UpdateDevice(ctx context.Context, device entity.Device) error {
 	err := w.pgDeviceRepo.UpdateDevice(ctx, device)
 	if err != nil {
 		return err
 	}
+	w.notifier.NotifyDevices(device.ID)
 	zap.S().Infof("Device %q updated.", device.ID)
 	return nil
 }
 
+// UpdateDeviceLabels adds or overwrites the labels of the device and removes the labels whose keys are in removedKeys.
+// The device is notified because its workloads may change.
+func (w *Service) UpdateDeviceLabels(ctx context.Context, id string, labels map[string]string, removedKeys []string) (entity.Device, error) {
+	device, err := w.GetDevice(ctx, id)
+	if err != nil {
+		return entity.Device{}, err
+	}
+
+	newLabels := make(map[string]string, len(device.Labels)+len(labels))
+	for k, v := range device.Labels {
+		newLabels[k] = v
+	}
+	for k, v := range labels {
+		newLabels[k] = v
+	}
+	for _, k := range removedKeys {
+		delete(newLabels, k)
+	}
+
+	if err := w.pgDeviceRepo.SetDeviceLabels(ctx, id, newLabels); err != nil {
+		return entity.Device{}, err
+	}
+	device.Labels = newLabels
+
+	w.notifier.NotifyDevices(device.ID)
+
+	zap.S().Infow("device labels updated", "device_id", id, "labels", newLabels)
+	return device, nil
+}
+
+// UpdateVariables adds or overwrites the template variables of the namespace, set or device and removes the variables
+// whose keys are in removedKeys. The devices of the namespace or set are notified because their workloads may change.
+func (w *Service) UpdateVariables(ctx context.Context, target entity.VariablesTarget, id string, variables map[string]string, removedKeys []string) (map[string]string, error) {
+	var (
+		current map[string]string
+		devices []string
+	)
+	switch target {
+	case entity.DeviceVariablesTarget:
+		device, err := w.GetDevice(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		current, devices = device.Variables, []string{device.ID}
+	case entity.SetVariablesTarget:
+		set, err := w.GetSet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		current, devices = set.Variables, set.Devices
+	default:
+		namespace, err := w.GetNamespace(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		current, devices = namespace.Variables, namespace.Devices
+	}
+
+	newVariables := make(map[string]string, len(current)+len(variables))
+	for k, v := range current {
+		newVariables[k] = v
+	}
+	for k, v := range variables {
+		newVariables[k] = v
+	}
+	for _, k := range removedKeys {
+		delete(newVariables, k)
+	}
+
+	if err := w.pgDeviceRepo.SetVariables(ctx, target, id, newVariables); err != nil {
+		return nil, err
+	}
+
+	w.notifier.NotifyDevices(devices...)
+
+	zap.S().Infow("variables updated", "target", target.String(), "id", id, "variables", newVariables)
+	return newVariables, nil
+}
+
+// DecommissionDevice revokes the certificate of the device and removes the device.
+// If keepTombstone is true, the device is kept as decommissioned for audit purposes and only its relations with manifests are removed.
+func (w *Service) DecommissionDevice(ctx context.Context, id string, keepTombstone bool) (entity.Device, error) {
+	device, err := w.GetDevice(ctx, id)
+	if err != nil {
+		return entity.Device{}, err
+	}
+
+	// the device must not be removed while its certificate is still valid
+	if device.CertificateSerialNumber != "" && device.EnrolStatus != entity.DecommissionedEnrolStatus {
+		if err := w.certRevoker.RevokeCertificate(ctx, device.CertificateSerialNumber); err != nil {
+			return entity.Device{}, err
+		}
+	}
+
+	if !keepTombstone {
+		// relations are removed by cascade
+		if err := w.pgDeviceRepo.DeleteDevice(ctx, id); err != nil {
+			return entity.Device{}, err
+		}
+		w.notifier.NotifyDevices(id)
+		zap.S().Infow("device decommissioned and deleted", "device_id", id, "certificate_sn", device.CertificateSerialNumber)
+		return device, nil
+	}
+
+	if err := w.pgDeviceRepo.DeleteDeviceRelations(ctx, id); err != nil {
+		return entity.Device{}, err
+	}
+
+	if device.EnrolStatus != entity.DecommissionedEnrolStatus {
+		device.EnrolStatus = entity.DecommissionedEnrolStatus
+		device.DecommissionedAt = time.Now().UTC()
+	}
+	device.SetID = nil
+	device.Workloads = []entity.ManifestV1{}
+
+	if err := w.pgDeviceRepo.UpdateDevice(ctx, device); err != nil {
+		return entity.Device{}, err
+	}
+
+	w.notifier.NotifyDevices(id)
+	zap.S().Infow("device decommissioned", "device_id", id, "certificate_sn", device.CertificateSerialNumber)
+	return device, nil
+}
+
+// UpdateDeviceState computes the state of the device from the time elapsed since its last heartbeat and
+// saves it if it changed.
+func (w *Service) UpdateDeviceState(ctx context.Context, device entity.Device, heartbeatPeriod time.Duration) (entity.DeviceState, error) {
+	state := entity.OfflineDeviceState
+	if !device.LastSeen.IsZero() {
+		elapsed := time.Now().UTC().Sub(device.LastSeen)
+		switch {
+		case elapsed <= lateHeartbeatFactor*heartbeatPeriod:
+			state = entity.OnlineDeviceState
+		case elapsed <= offlineHeartbeatFactor*heartbeatPeriod:
+			state = entity.LateDeviceState
+		}
+	}
+
+	if state == device.State {
+		return state, nil
+	}
+
+	if err := w.pgDeviceRepo.UpdateDeviceState(ctx, device.ID, state); err != nil {
+		return device.State, err
+	}
+
+	zap.S().Infow("device state changed", "device_id", device.ID, "old_state", device.State.String(), "state", state.String())
+	return state, nil
+}
+
 func (w *Service) CreateNamespace(ctx context.Context, namespace entity.Namespace) error {
 	_, err := w.GetNamespace(ctx, namespace.Name)
 	if err == nil {
@@ -20,6 +20,9 @@ var _ CertificateWriter = &CertificateWriterMock{}
 //
 // 		// make and configure a mocked CertificateWriter
 // 		mockedCertificateWriter := &CertificateWriterMock{
+// 			RevokeCertificateFunc: func(ctx context.Context, serialNumber string) error {
+// 				panic("mock out the RevokeCertificate method")
+// 			},
 // 			SignCSRFunc: func(ctx context.Context, csr []byte, cn string, ttl time.Duration) (entity.CertificateGroup, error) {
 // 				panic("mock out the SignCSR method")
 // 			},
@@ -30,11 +33,21 @@ var _ CertificateWriter = &CertificateWriterMock{}
 //
 // 	}
 type CertificateWriterMock struct {
+	// RevokeCertificateFunc mocks the RevokeCertificate method.
+	RevokeCertificateFunc func(ctx context.Context, serialNumber string) error
+
 	// SignCSRFunc mocks the SignCSR method.
 	SignCSRFunc func(ctx context.Context, csr []byte, cn string, ttl time.Duration) (entity.CertificateGroup, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// RevokeCertificate holds details about calls to the RevokeCertificate method.
+		RevokeCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SerialNumber is the serialNumber argument value.
+			SerialNumber string
+		}
 		// SignCSR holds details about calls to the SignCSR method.
 		SignCSR []struct {
 			// Ctx is the ctx argument value.
@@ -47,7 +60,43 @@ type CertificateWriterMock struct {
 			TTL time.Duration
 		}
 	}
-	lockSignCSR sync.RWMutex
+	lockRevokeCertificate sync.RWMutex
+	lockSignCSR           sync.RWMutex
+}
+
+// RevokeCertificate calls RevokeCertificateFunc.
+func (mock *CertificateWriterMock) RevokeCertificate(ctx context.Context, serialNumber string) error {
+	if mock.RevokeCertificateFunc == nil {
+		panic("CertificateWriterMock.RevokeCertificateFunc: method is nil but CertificateWriter.RevokeCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		SerialNumber string
+	}{
+		Ctx:          ctx,
+		SerialNumber: serialNumber,
+	}
+	mock.lockRevokeCertificate.Lock()
+	mock.calls.RevokeCertificate = append(mock.calls.RevokeCertificate, callInfo)
+	mock.lockRevokeCertificate.Unlock()
+	return mock.RevokeCertificateFunc(ctx, serialNumber)
+}
+
+// RevokeCertificateCalls gets all the calls that were made to RevokeCertificate.
+// Check the length with:
+//     len(mockedCertificateWriter.RevokeCertificateCalls())
+func (mock *CertificateWriterMock) RevokeCertificateCalls() []struct {
+	Ctx          context.Context
+	SerialNumber string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		SerialNumber string
+	}
+	mock.lockRevokeCertificate.RLock()
+	calls = mock.calls.RevokeCertificate
+	mock.lockRevokeCertificate.RUnlock()
+	return calls
 }
 
 // SignCSR calls SignCSRFunc.
@@ -25,9 +25,15 @@ var _ DeviceReaderWriter = &DeviceReaderWriterMock{}
 // 			GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
 // 				panic("mock out the GetDevice method")
 // 			},
+// 			UpdateCertificateSerialNumberFunc: func(ctx context.Context, id string, oldSerialNumber string, newSerialNumber string) error {
+// 				panic("mock out the UpdateCertificateSerialNumber method")
+// 			},
 // 			UpdateDeviceFunc: func(ctx context.Context, device entity.Device) error {
 // 				panic("mock out the UpdateDevice method")
 // 			},
+// 			UpdateHeartbeatFunc: func(ctx context.Context, heartbeat entity.Heartbeat) error {
+// 				panic("mock out the UpdateHeartbeat method")
+// 			},
 // 		}
 //
 // 		// use mockedDeviceReaderWriter in code that requires DeviceReaderWriter
@@ -41,9 +47,15 @@ type DeviceReaderWriterMock struct {
 	// GetDeviceFunc mocks the GetDevice method.
 	GetDeviceFunc func(ctx context.Context, id string) (entity.Device, error)
 
+	// UpdateCertificateSerialNumberFunc mocks the UpdateCertificateSerialNumber method.
+	UpdateCertificateSerialNumberFunc func(ctx context.Context, id string, oldSerialNumber string, newSerialNumber string) error
+
 	// UpdateDeviceFunc mocks the UpdateDevice method.
 	UpdateDeviceFunc func(ctx context.Context, device entity.Device) error
 
+	// UpdateHeartbeatFunc mocks the UpdateHeartbeat method.
+	UpdateHeartbeatFunc func(ctx context.Context, heartbeat entity.Heartbeat) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// CreateDevice holds details about calls to the CreateDevice method.
@@ -60,6 +72,17 @@ type DeviceReaderWriterMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// UpdateCertificateSerialNumber holds details about calls to the UpdateCertificateSerialNumber method.
+		UpdateCertificateSerialNumber []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// OldSerialNumber is the oldSerialNumber argument value.
+			OldSerialNumber string
+			// NewSerialNumber is the newSerialNumber argument value.
+			NewSerialNumber string
+		}
 		// UpdateDevice holds details about calls to the UpdateDevice method.
 		UpdateDevice []struct {
 			// Ctx is the ctx argument value.
@@ -67,10 +90,19 @@ type DeviceReaderWriterMock struct {
 			// Device is the device argument value.
 			Device entity.Device
 		}
+		// UpdateHeartbeat holds details about calls to the UpdateHeartbeat method.
+		UpdateHeartbeat []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Heartbeat is the heartbeat argument value.
+			Heartbeat entity.Heartbeat
+		}
 	}
-	lockCreateDevice sync.RWMutex
-	lockGetDevice    sync.RWMutex
-	lockUpdateDevice sync.RWMutex
+	lockCreateDevice                  sync.RWMutex
+	lockGetDevice                     sync.RWMutex
+	lockUpdateCertificateSerialNumber sync.RWMutex
+	lockUpdateDevice                  sync.RWMutex
+	lockUpdateHeartbeat               sync.RWMutex
 }
 
 // CreateDevice calls CreateDeviceFunc.
@@ -143,6 +175,49 @@ func (mock *DeviceReaderWriterMock) GetDeviceCalls() []struct {
 	return calls
 }
 
+// UpdateCertificateSerialNumber calls UpdateCertificateSerialNumberFunc.
+func (mock *DeviceReaderWriterMock) UpdateCertificateSerialNumber(ctx context.Context, id string, oldSerialNumber string, newSerialNumber string) error {
+	if mock.UpdateCertificateSerialNumberFunc == nil {
+		panic("DeviceReaderWriterMock.UpdateCertificateSerialNumberFunc: method is nil but DeviceReaderWriter.UpdateCertificateSerialNumber was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		ID              string
+		OldSerialNumber string
+		NewSerialNumber string
+	}{
+		Ctx:             ctx,
+		ID:              id,
+		OldSerialNumber: oldSerialNumber,
+		NewSerialNumber: newSerialNumber,
+	}
+	mock.lockUpdateCertificateSerialNumber.Lock()
+	mock.calls.UpdateCertificateSerialNumber = append(mock.calls.UpdateCertificateSerialNumber, callInfo)
+	mock.lockUpdateCertificateSerialNumber.Unlock()
+	return mock.UpdateCertificateSerialNumberFunc(ctx, id, oldSerialNumber, newSerialNumber)
+}
+
+// UpdateCertificateSerialNumberCalls gets all the calls that were made to UpdateCertificateSerialNumber.
+// Check the length with:
+//     len(mockedDeviceReaderWriter.UpdateCertificateSerialNumberCalls())
+func (mock *DeviceReaderWriterMock) UpdateCertificateSerialNumberCalls() []struct {
+	Ctx             context.Context
+	ID              string
+	OldSerialNumber string
+	NewSerialNumber string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		ID              string
+		OldSerialNumber string
+		NewSerialNumber string
+	}
+	mock.lockUpdateCertificateSerialNumber.RLock()
+	calls = mock.calls.UpdateCertificateSerialNumber
+	mock.lockUpdateCertificateSerialNumber.RUnlock()
+	return calls
+}
+
 // UpdateDevice calls UpdateDeviceFunc.
 func (mock *DeviceReaderWriterMock) UpdateDevice(ctx context.Context, device entity.Device) error {
 	if mock.UpdateDeviceFunc == nil {
@@ -177,3 +252,38 @@ func (mock *DeviceReaderWriterMock) UpdateDeviceCalls() []struct {
 	mock.lockUpdateDevice.RUnlock()
 	return calls
 }
+
+// UpdateHeartbeat calls UpdateHeartbeatFunc.
+func (mock *DeviceReaderWriterMock) UpdateHeartbeat(ctx context.Context, heartbeat entity.Heartbeat) error {
+	if mock.UpdateHeartbeatFunc == nil {
+		panic("DeviceReaderWriterMock.UpdateHeartbeatFunc: method is nil but DeviceReaderWriter.UpdateHeartbeat was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Heartbeat entity.Heartbeat
+	}{
+		Ctx:       ctx,
+		Heartbeat: heartbeat,
+	}
+	mock.lockUpdateHeartbeat.Lock()
+	mock.calls.UpdateHeartbeat = append(mock.calls.UpdateHeartbeat, callInfo)
+	mock.lockUpdateHeartbeat.Unlock()
+	return mock.UpdateHeartbeatFunc(ctx, heartbeat)
+}
+
+// UpdateHeartbeatCalls gets all the calls that were made to UpdateHeartbeat.
+// Check the length with:
+//     len(mockedDeviceReaderWriter.UpdateHeartbeatCalls())
+func (mock *DeviceReaderWriterMock) UpdateHeartbeatCalls() []struct {
+	Ctx       context.Context
+	Heartbeat entity.Heartbeat
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Heartbeat entity.Heartbeat
+	}
+	mock.lockUpdateHeartbeat.RLock()
+	calls = mock.calls.UpdateHeartbeat
+	mock.lockUpdateHeartbeat.RUnlock()
+	return calls
+}
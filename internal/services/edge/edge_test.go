@@ -3,8 +3,10 @@ package edge_test
 import (
 	"context"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"errors"
+	"math/big"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -45,6 +47,8 @@ var _ = Describe("Edge", func() {
 	var (
 		configureReader *edge.ConfigurationReaderMock
 		certWriter      *edge.CertificateWriterMock
+		subscriber      *edge.SubscriberMock
+		tokenConsumer   *edge.TokenConsumerMock
 	)
 
 	Describe("Enrol", func() {
@@ -58,8 +62,8 @@ var _ = Describe("Edge", func() {
 				},
 			}
 
-			service := edge.New(deviceReadWriter, configureReader, certWriter)
-			status, err := service.Enrol(context.TODO(), "deviceID")
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			status, err := service.Enrol(context.TODO(), "deviceID", "")
 			Expect(err).To(BeNil())
 			Expect(status).To(Equal(entity.EnroledStatus))
 			calls := deviceReadWriter.CreateDeviceCalls()
@@ -83,8 +87,8 @@ var _ = Describe("Edge", func() {
 				},
 			}
 
-			service := edge.New(deviceReadWriter, configureReader, certWriter)
-			status, err := service.Enrol(context.TODO(), "deviceID")
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			status, err := service.Enrol(context.TODO(), "deviceID", "")
 			Expect(err).To(BeNil())
 			Expect(status).To(Equal(entity.EnroledStatus))
 			calls := deviceReadWriter.CreateDeviceCalls()
@@ -98,8 +102,8 @@ var _ = Describe("Edge", func() {
 				},
 			}
 
-			service := edge.New(deviceReadWriter, configureReader, certWriter)
-			status, err := service.Enrol(context.TODO(), "deviceID")
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			status, err := service.Enrol(context.TODO(), "deviceID", "")
 			Expect(err).NotTo(BeNil())
 			Expect(status).To(Equal(entity.NotEnroledStatus))
 			calls := deviceReadWriter.CreateDeviceCalls()
@@ -116,13 +120,179 @@ var _ = Describe("Edge", func() {
 				},
 			}
 
-			service := edge.New(deviceReadWriter, configureReader, certWriter)
-			status, err := service.Enrol(context.TODO(), "deviceID")
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			status, err := service.Enrol(context.TODO(), "deviceID", "")
 			Expect(err).NotTo(BeNil())
 			Expect(status).To(Equal(entity.NotEnroledStatus))
 			calls := deviceReadWriter.CreateDeviceCalls()
 			Expect(len(calls)).To(Equal(1))
 		})
+
+		It("device is pending when auto enrolment is disabled", func() {
+			deviceReadWriter := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{}, errService.NewResourceNotFoundError("device", id)
+				},
+				CreateDeviceFunc: func(ctx context.Context, device entity.Device) error {
+					return nil
+				},
+			}
+
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{})
+			status, err := service.Enrol(context.TODO(), "deviceID", "")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(entity.PendingEnrolStatus))
+			calls := deviceReadWriter.CreateDeviceCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].Device.EnrolStatus).To(Equal(entity.PendingEnrolStatus))
+		})
+
+		It("refused device stays refused", func() {
+			deviceReadWriter := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{
+						ID:          "deviceID",
+						EnrolStatus: entity.RefusedEnrolStatus,
+					}, nil
+				},
+			}
+
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{})
+			status, err := service.Enrol(context.TODO(), "deviceID", "")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(entity.RefusedEnrolStatus))
+			Expect(len(deviceReadWriter.CreateDeviceCalls())).To(Equal(0))
+		})
+
+		It("device with a valid token is placed in the token's namespace and set", func() {
+			setID := "set"
+			deviceReadWriter := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{}, errService.NewResourceNotFoundError("device", id)
+				},
+				CreateDeviceFunc: func(ctx context.Context, device entity.Device) error {
+					return nil
+				},
+			}
+			tokenConsumer := &edge.TokenConsumerMock{
+				ConsumeTokenFunc: func(ctx context.Context, token string) (entity.EnrolmentToken, error) {
+					return entity.EnrolmentToken{NamespaceID: "namespace", SetID: &setID}, nil
+				},
+			}
+
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{})
+			status, err := service.Enrol(context.TODO(), "deviceID", "token")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(entity.EnroledStatus))
+			Expect(tokenConsumer.ConsumeTokenCalls()[0].Token).To(Equal("token"))
+			calls := deviceReadWriter.CreateDeviceCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].Device.EnrolStatus).To(Equal(entity.EnroledStatus))
+			Expect(calls[0].Device.NamespaceID).To(Equal("namespace"))
+			Expect(*calls[0].Device.SetID).To(Equal("set"))
+		})
+
+		It("device with an invalid token is not created", func() {
+			deviceReadWriter := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{}, errService.NewResourceNotFoundError("device", id)
+				},
+			}
+			tokenConsumer := &edge.TokenConsumerMock{
+				ConsumeTokenFunc: func(ctx context.Context, token string) (entity.EnrolmentToken, error) {
+					return entity.EnrolmentToken{}, errService.NewInvalidEnrolmentTokenError("expired")
+				},
+			}
+
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			status, err := service.Enrol(context.TODO(), "deviceID", "token")
+			Expect(err).NotTo(BeNil())
+			Expect(err).To(BeAssignableToTypeOf(errService.InvalidEnrolmentTokenError{}))
+			Expect(status).To(Equal(entity.NotEnroledStatus))
+			Expect(len(deviceReadWriter.CreateDeviceCalls())).To(Equal(0))
+		})
+
+		It("pending device is enroled with a valid token", func() {
+			deviceReadWriter := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{
+						ID:          "deviceID",
+						NamespaceID: "default",
+						EnrolStatus: entity.PendingEnrolStatus,
+					}, nil
+				},
+				UpdateDeviceFunc: func(ctx context.Context, device entity.Device) error {
+					return nil
+				},
+			}
+			tokenConsumer := &edge.TokenConsumerMock{
+				ConsumeTokenFunc: func(ctx context.Context, token string) (entity.EnrolmentToken, error) {
+					return entity.EnrolmentToken{NamespaceID: "namespace"}, nil
+				},
+			}
+
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{})
+			status, err := service.Enrol(context.TODO(), "deviceID", "token")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(entity.EnroledStatus))
+			calls := deviceReadWriter.UpdateDeviceCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].Device.NamespaceID).To(Equal("namespace"))
+			Expect(calls[0].Device.SetID).To(BeNil())
+		})
+
+		It("device without token is refused when tokens are required", func() {
+			deviceReadWriter := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{}, errService.NewResourceNotFoundError("device", id)
+				},
+			}
+
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true, RequireEnrolmentToken: true})
+			status, err := service.Enrol(context.TODO(), "deviceID", "")
+			Expect(err).To(BeAssignableToTypeOf(errService.InvalidEnrolmentTokenError{}))
+			Expect(status).To(Equal(entity.NotEnroledStatus))
+			Expect(len(deviceReadWriter.CreateDeviceCalls())).To(Equal(0))
+		})
+
+		It("enroled device without token is accepted when tokens are required", func() {
+			deviceReadWriter := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, EnrolStatus: entity.EnroledStatus}, nil
+				},
+			}
+
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true, RequireEnrolmentToken: true})
+			status, err := service.Enrol(context.TODO(), "deviceID", "")
+			Expect(err).To(BeNil())
+			Expect(status).To(Equal(entity.EnroledStatus))
+		})
+
+		It("token is released when the device cannot be created", func() {
+			deviceReadWriter := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{}, errService.NewResourceNotFoundError("device", id)
+				},
+				CreateDeviceFunc: func(ctx context.Context, device entity.Device) error {
+					return errors.New("connection refused")
+				},
+			}
+			tokenConsumer := &edge.TokenConsumerMock{
+				ConsumeTokenFunc: func(ctx context.Context, token string) (entity.EnrolmentToken, error) {
+					return entity.EnrolmentToken{NamespaceID: "namespace"}, nil
+				},
+				ReleaseTokenFunc: func(ctx context.Context, token string) error {
+					return nil
+				},
+			}
+
+			service := edge.New(deviceReadWriter, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{RequireEnrolmentToken: true})
+			status, err := service.Enrol(context.TODO(), "deviceID", "token")
+			Expect(err).ToNot(BeNil())
+			Expect(status).To(Equal(entity.NotEnroledStatus))
+			Expect(tokenConsumer.ReleaseTokenCalls()).To(HaveLen(1))
+			Expect(tokenConsumer.ReleaseTokenCalls()[0].Token).To(Equal("token"))
+		})
 	})
 
 	Describe("Register", func() {
@@ -154,7 +324,7 @@ var _ = Describe("Edge", func() {
 					return certificate, nil
 				},
 			}
-			service := edge.New(deviceRW, configureReader, certWriter)
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
 			csr := "csr"
 			certificate, err := service.Register(context.TODO(), "deviceID", csr)
 			Expect(err).To(BeNil())
@@ -192,7 +362,7 @@ var _ = Describe("Edge", func() {
 					return certificate, nil
 				},
 			}
-			service := edge.New(deviceRW, configureReader, certWriter)
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
 			csr := "csr"
 			_, err := service.Register(context.TODO(), "deviceID", csr)
 			Expect(err).NotTo(BeNil())
@@ -216,7 +386,7 @@ var _ = Describe("Edge", func() {
 					return entity.CertificateGroup{}, errors.New("unknown error")
 				},
 			}
-			service := edge.New(deviceRW, configureReader, certWriter)
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
 			csr := "csr"
 			_, err := service.Register(context.TODO(), "deviceID", csr)
 			Expect(err).NotTo(BeNil())
@@ -232,7 +402,7 @@ var _ = Describe("Edge", func() {
 					return errors.New("unknown error")
 				},
 			}
-			service := edge.New(deviceRW, configureReader, certWriter)
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
 			csr := "csr"
 			_, err := service.Register(context.TODO(), "deviceID", csr)
 			Expect(err).NotTo(BeNil())
@@ -248,7 +418,7 @@ var _ = Describe("Edge", func() {
 					}, nil
 				},
 			}
-			service := edge.New(deviceRW, configureReader, certWriter)
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
 			csr := "csr"
 			_, err := service.Register(context.TODO(), "deviceID", csr)
 			Expect(err).NotTo(BeNil())
@@ -266,7 +436,7 @@ var _ = Describe("Edge", func() {
 					}, nil
 				},
 			}
-			service := edge.New(deviceRW, configureReader, certWriter)
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
 			isRegisterd, err := service.IsRegistered(context.TODO(), "deviceID")
 			Expect(err).To(BeNil())
 			Expect(isRegisterd).To(BeTrue())
@@ -281,10 +451,292 @@ var _ = Describe("Edge", func() {
 					}, nil
 				},
 			}
-			service := edge.New(deviceRW, configureReader, certWriter)
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
 			isRegisterd, err := service.IsRegistered(context.TODO(), "deviceID")
 			Expect(err).To(BeNil())
 			Expect(isRegisterd).To(BeFalse())
 		})
 	})
+
+	Describe("Heartbeat", func() {
+		It("saves the heartbeat", func() {
+			deviceRW := &edge.DeviceReaderWriterMock{
+				UpdateHeartbeatFunc: func(ctx context.Context, heartbeat entity.Heartbeat) error {
+					return nil
+				},
+			}
+
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			err := service.Heartbeat(context.TODO(), entity.Heartbeat{DeviceID: "deviceID", Uptime: 10 * time.Second, ConfigurationHash: "hash"})
+			Expect(err).To(BeNil())
+
+			calls := deviceRW.UpdateHeartbeatCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].Heartbeat.DeviceID).To(Equal("deviceID"))
+			Expect(calls[0].Heartbeat.ConfigurationHash).To(Equal("hash"))
+			Expect(calls[0].Heartbeat.Timestamp.IsZero()).To(BeFalse())
+		})
+
+		It("device not found", func() {
+			deviceRW := &edge.DeviceReaderWriterMock{
+				UpdateHeartbeatFunc: func(ctx context.Context, heartbeat entity.Heartbeat) error {
+					return errService.NewResourceNotFoundError("device", heartbeat.DeviceID)
+				},
+			}
+
+			service := edge.New(deviceRW, configureReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			err := service.Heartbeat(context.TODO(), entity.Heartbeat{DeviceID: "deviceID"})
+			Expect(err).NotTo(BeNil())
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("GetConfiguration", func() {
+		confReader := &edge.ConfigurationReaderMock{
+			GetDeviceConfigurationFunc: func(ctx context.Context, id string) (entity.DeviceConfiguration, error) {
+				return entity.DeviceConfiguration{
+					Hash:          "hash",
+					Configuration: entity.Configuration{HeartbeatPeriod: time.Second},
+					Workloads:     []entity.DeviceWorkload{{ManifestV1: entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "workload"}}}},
+				}, nil
+			},
+		}
+
+		It("returns the full configuration when the device has another hash", func() {
+			service := edge.New(&edge.DeviceReaderWriterMock{}, confReader, certWriter, subscriber, tokenConsumer, edge.Options{})
+			conf, err := service.GetConfiguration(context.TODO(), "deviceID", "old")
+			Expect(err).To(BeNil())
+			Expect(conf.NotModified).To(BeFalse())
+			Expect(len(conf.Workloads)).To(Equal(1))
+		})
+
+		It("returns only the hash when the device already has the configuration", func() {
+			service := edge.New(&edge.DeviceReaderWriterMock{}, confReader, certWriter, subscriber, tokenConsumer, edge.Options{})
+			conf, err := service.GetConfiguration(context.TODO(), "deviceID", "hash")
+			Expect(err).To(BeNil())
+			Expect(conf.NotModified).To(BeTrue())
+			Expect(conf.Hash).To(Equal("hash"))
+			Expect(conf.Workloads).To(BeEmpty())
+		})
+	})
+
+	Describe("WatchConfiguration", func() {
+		It("sends the configuration when it changes", func() {
+			notifications := make(chan struct{}, 1)
+			subscriber := &edge.SubscriberMock{
+				SubscribeFunc: func(deviceID string) (<-chan struct{}, func()) {
+					return notifications, func() {}
+				},
+			}
+			hashes := []string{"first", "first", "second"}
+			confReader := &edge.ConfigurationReaderMock{
+				GetDeviceConfigurationFunc: func(ctx context.Context, id string) (entity.DeviceConfiguration, error) {
+					hash := hashes[0]
+					if len(hashes) > 1 {
+						hashes = hashes[1:]
+					}
+					return entity.DeviceConfiguration{Hash: hash}, nil
+				},
+			}
+
+			ctx, cancel := context.WithCancel(context.TODO())
+			service := edge.New(&edge.DeviceReaderWriterMock{}, confReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			confs, err := service.WatchConfiguration(ctx, "deviceID", "")
+			Expect(err).To(BeNil())
+			Expect((<-confs).Hash).To(Equal("first"))
+
+			// same configuration is not sent
+			notifications <- struct{}{}
+			Consistently(confs, 100*time.Millisecond).ShouldNot(Receive())
+
+			notifications <- struct{}{}
+			Eventually(confs).Should(Receive(WithTransform(func(c entity.DeviceConfiguration) string { return c.Hash }, Equal("second"))))
+
+			cancel()
+			Eventually(confs).Should(BeClosed())
+		})
+
+		It("marks the first configuration as not modified when the device already has it", func() {
+			subscriber := &edge.SubscriberMock{
+				SubscribeFunc: func(deviceID string) (<-chan struct{}, func()) {
+					return make(chan struct{}), func() {}
+				},
+			}
+			confReader := &edge.ConfigurationReaderMock{
+				GetDeviceConfigurationFunc: func(ctx context.Context, id string) (entity.DeviceConfiguration, error) {
+					return entity.DeviceConfiguration{Hash: "hash"}, nil
+				},
+			}
+
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			service := edge.New(&edge.DeviceReaderWriterMock{}, confReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			confs, err := service.WatchConfiguration(ctx, "deviceID", "hash")
+			Expect(err).To(BeNil())
+			Expect((<-confs).NotModified).To(BeTrue())
+		})
+
+		It("sends a change made while the first configuration is read", func() {
+			notifications := make(chan struct{}, 1)
+			subscriber := &edge.SubscriberMock{
+				SubscribeFunc: func(deviceID string) (<-chan struct{}, func()) {
+					return notifications, func() {}
+				},
+			}
+			hashes := []string{"first", "second"}
+			confReader := &edge.ConfigurationReaderMock{
+				GetDeviceConfigurationFunc: func(ctx context.Context, id string) (entity.DeviceConfiguration, error) {
+					hash := hashes[0]
+					if len(hashes) > 1 {
+						// the configuration changes right after being read
+						hashes = hashes[1:]
+						notifications <- struct{}{}
+					}
+					return entity.DeviceConfiguration{Hash: hash}, nil
+				},
+			}
+
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			service := edge.New(&edge.DeviceReaderWriterMock{}, confReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			confs, err := service.WatchConfiguration(ctx, "deviceID", "")
+			Expect(err).To(BeNil())
+			Expect((<-confs).Hash).To(Equal("first"))
+			Eventually(confs).Should(Receive(WithTransform(func(c entity.DeviceConfiguration) string { return c.Hash }, Equal("second"))))
+		})
+
+		It("returns error when the configuration cannot be read", func() {
+			unsubscribed := false
+			subscriber := &edge.SubscriberMock{
+				SubscribeFunc: func(deviceID string) (<-chan struct{}, func()) {
+					return make(chan struct{}), func() { unsubscribed = true }
+				},
+			}
+			confReader := &edge.ConfigurationReaderMock{
+				GetDeviceConfigurationFunc: func(ctx context.Context, id string) (entity.DeviceConfiguration, error) {
+					return entity.DeviceConfiguration{}, errService.NewResourceNotFoundError("device", id)
+				},
+			}
+
+			service := edge.New(&edge.DeviceReaderWriterMock{}, confReader, certWriter, subscriber, tokenConsumer, edge.Options{AutoEnrolment: true})
+			_, err := service.WatchConfiguration(context.TODO(), "deviceID", "")
+			Expect(err).NotTo(BeNil())
+			Expect(unsubscribed).To(BeTrue())
+		})
+	})
+
+	Describe("RenewCertificate", func() {
+		var signer *edge.CertificateWriterMock
+
+		// current returns the certificate presented by the device.
+		current := func(cn string, notAfter time.Time) *x509.Certificate {
+			return &x509.Certificate{SerialNumber: big.NewInt(0xa1b), Subject: pkix.Name{CommonName: cn}, NotAfter: notAfter}
+		}
+
+		BeforeEach(func() {
+			signer = &edge.CertificateWriterMock{
+				SignCSRFunc: func(ctx context.Context, csr []byte, cn string, ttl time.Duration) (entity.CertificateGroup, error) {
+					block, _ := pem.Decode([]byte(certificate))
+					cert, err := x509.ParseCertificate(block.Bytes)
+					if err != nil {
+						panic("failed to parse certificate: " + err.Error())
+					}
+					return entity.CertificateGroup{Certificate: cert, CertificatePEM: []byte(certificate)}, nil
+				},
+				RevokeCertificateFunc: func(ctx context.Context, serialNumber string) error {
+					return nil
+				},
+			}
+		})
+
+		It("renews the certificate and revokes the old one", func() {
+			deviceRW := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, Registred: true, CertificateSerialNumber: "a1b"}, nil
+				},
+				UpdateCertificateSerialNumberFunc: func(ctx context.Context, id, oldSerialNumber, newSerialNumber string) error {
+					return nil
+				},
+			}
+
+			service := edge.New(deviceRW, configureReader, signer, subscriber, tokenConsumer, edge.Options{RenewalWindow: 24 * time.Hour, RevokeRenewedCertificate: true})
+			cert, err := service.RenewCertificate(context.TODO(), "deviceID", "csr", current("deviceID.home.net", time.Now().Add(time.Hour)))
+			Expect(err).To(BeNil())
+
+			calls := deviceRW.UpdateCertificateSerialNumberCalls()
+			Expect(len(calls)).To(Equal(1))
+			Expect(calls[0].OldSerialNumber).To(Equal("a1b"))
+			Expect(calls[0].NewSerialNumber).To(Equal(cert.GetSerialNumber()))
+
+			Expect(signer.SignCSRCalls()[0].TTL).To(Equal(edge.DefaultCertificateTTL))
+			Expect(len(signer.RevokeCertificateCalls())).To(Equal(1))
+			Expect(signer.RevokeCertificateCalls()[0].SerialNumber).To(Equal("a1b"))
+		})
+
+		It("does not renew the certificate outside the renewal window", func() {
+			deviceRW := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, Registred: true, CertificateSerialNumber: "a1b"}, nil
+				},
+			}
+
+			service := edge.New(deviceRW, configureReader, signer, subscriber, tokenConsumer, edge.Options{RenewalWindow: 24 * time.Hour})
+			_, err := service.RenewCertificate(context.TODO(), "deviceID", "csr", current("deviceID.home.net", time.Now().Add(48*time.Hour)))
+			Expect(err).NotTo(BeNil())
+			_, ok := err.(errService.CertificateNotRenewableError)
+			Expect(ok).To(BeTrue())
+			Expect(len(signer.SignCSRCalls())).To(Equal(0))
+		})
+
+		It("device is not registered", func() {
+			deviceRW := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id}, nil
+				},
+			}
+
+			service := edge.New(deviceRW, configureReader, signer, subscriber, tokenConsumer, edge.Options{})
+			_, err := service.RenewCertificate(context.TODO(), "deviceID", "csr", current("deviceID.home.net", time.Now()))
+			Expect(err).NotTo(BeNil())
+			_, ok := err.(errService.DeviceNotRegisteredError)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("keeps the old certificate when the serial number cannot be updated", func() {
+			deviceRW := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, Registred: true, CertificateSerialNumber: "a1b"}, nil
+				},
+				UpdateCertificateSerialNumberFunc: func(ctx context.Context, id, oldSerialNumber, newSerialNumber string) error {
+					return errors.New("already replaced")
+				},
+			}
+
+			service := edge.New(deviceRW, configureReader, signer, subscriber, tokenConsumer, edge.Options{RevokeRenewedCertificate: true})
+			_, err := service.RenewCertificate(context.TODO(), "deviceID", "csr", current("deviceID.home.net", time.Now()))
+			Expect(err).NotTo(BeNil())
+			Expect(len(signer.RevokeCertificateCalls())).To(Equal(0))
+		})
+
+		It("refuses to renew the certificate of another device", func() {
+			deviceRW := &edge.DeviceReaderWriterMock{
+				GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+					return entity.Device{ID: id, Registred: true, CertificateSerialNumber: "ffff"}, nil
+				},
+			}
+
+			service := edge.New(deviceRW, configureReader, signer, subscriber, tokenConsumer, edge.Options{})
+			_, err := service.RenewCertificate(context.TODO(), "victim", "csr", current("attacker.home.net", time.Now()))
+			Expect(err).NotTo(BeNil())
+			_, ok := err.(errService.CertificateMismatchError)
+			Expect(ok).To(BeTrue())
+
+			_, err = service.RenewCertificate(context.TODO(), "victim", "csr", current("victim.home.net", time.Now()))
+			_, ok = err.(errService.CertificateMismatchError)
+			Expect(ok).To(BeTrue())
+
+			Expect(signer.SignCSRCalls()).To(BeEmpty())
+			Expect(deviceRW.UpdateCertificateSerialNumberCalls()).To(BeEmpty())
+		})
+	})
 })
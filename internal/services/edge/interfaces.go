@@ -16,6 +16,8 @@ type DeviceReader interface {
 type DeviceWriter interface {
 	CreateDevice(ctx context.Context, device entity.Device) error
 	UpdateDevice(ctx context.Context, device entity.Device) error
+	UpdateHeartbeat(ctx context.Context, heartbeat entity.Heartbeat) error
+	UpdateCertificateSerialNumber(ctx context.Context, id string, oldSerialNumber string, newSerialNumber string) error
 }
 
 //go:generate moq -out device_rw_moq.go . DeviceReaderWriter
@@ -32,4 +34,16 @@ type ConfigurationReader interface {
 //go:generate moq -out certficate_writer_moq.go . CertificateWriter
 type CertificateWriter interface {
 	SignCSR(ctx context.Context, csr []byte, cn string, ttl time.Duration) (entity.CertificateGroup, error)
+	RevokeCertificate(ctx context.Context, serialNumber string) error
+}
+
+//go:generate moq -out subscriber_moq.go . Subscriber
+type Subscriber interface {
+	Subscribe(deviceID string) (<-chan struct{}, func())
+}
+
+//go:generate moq -out token_consumer_moq.go . TokenConsumer
+type TokenConsumer interface {
+	ConsumeToken(ctx context.Context, token string) (entity.EnrolmentToken, error)
+	ReleaseToken(ctx context.Context, token string) error
 }
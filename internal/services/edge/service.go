@@ -3,7 +3,9 @@ package edge
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
@@ -17,24 +19,50 @@ const (
 	defaultNamespace      = "default"
 )
 
+// Options holds the enrolment and certificate parameters of the service.
+type Options struct {
+	// AutoEnrolment set to true to enrol unknown devices without waiting for approval.
+	AutoEnrolment bool
+	// RequireEnrolmentToken set to true to refuse the enrolment of devices without a valid enrolment token.
+	RequireEnrolmentToken bool
+	// CertificateTTL is the ttl of the device certificates. If 0, DefaultCertificateTTL is used.
+	CertificateTTL time.Duration
+	// RenewalWindow is the period before expiry during which a device can renew its certificate.
+	// If 0, the certificate can be renewed at any time.
+	RenewalWindow time.Duration
+	// RevokeRenewedCertificate set to true to revoke the old certificate once the new one is signed.
+	RevokeRenewedCertificate bool
+}
+
 type Service struct {
 	deviceReaderWriter DeviceReaderWriter
 	confReader         ConfigurationReader
 	certWriter         CertificateWriter
+	subscriber         Subscriber
+	tokenConsumer      TokenConsumer
+	opts               Options
 }
 
-func New(dr DeviceReaderWriter, confReader ConfigurationReader, certWriter CertificateWriter) *Service {
-	return &Service{dr, confReader, certWriter}
+func New(dr DeviceReaderWriter, confReader ConfigurationReader, certWriter CertificateWriter, subscriber Subscriber, tokenConsumer TokenConsumer, opts Options) *Service {
+	if opts.CertificateTTL == 0 {
+		opts.CertificateTTL = DefaultCertificateTTL
+	}
+	return &Service{dr, confReader, certWriter, subscriber, tokenConsumer, opts}
 }
 
-// Enrol tries to enrol a device. If enable-auto-enrolment is true then the device is automatically
-// enrolled. If false, the device is created but not enroled yet.
-func (s *Service) Enrol(ctx context.Context, deviceID string) (status entity.EnrolStatus, err error) {
+// Enrol tries to enrol a device. If the device presents a valid enrolment token, it is enroled right away and placed
+// in the namespace and set of the token. Otherwise, if enable-auto-enrolment is true then the device is automatically
+// enrolled. If false, the device is created in pending state and it waits for an admin to approve or refuse it.
+// A pending device which comes back with a valid token is enroled.
+func (s *Service) Enrol(ctx context.Context, deviceID string, token string) (status entity.EnrolStatus, err error) {
 	d, err := s.deviceReaderWriter.GetDevice(ctx, deviceID)
 	if err != nil {
 		if !errService.IsResourceNotFound(err) {
 			return entity.NotEnroledStatus, err
 		}
+		if token == "" && s.opts.RequireEnrolmentToken {
+			return entity.NotEnroledStatus, errService.NewInvalidEnrolmentTokenError("enrolment token is required")
+		}
 		// device not found. create the device
 		device := entity.Device{
 			ID:          deviceID,
@@ -42,19 +70,68 @@ func (s *Service) Enrol(ctx context.Context, deviceID string) (status entity.Enr
 			EnrolStatus: entity.EnroledStatus,
 			EnroledAt:   time.Now().UTC(),
 		}
-		device.EnrolStatus = entity.EnroledStatus
+		if token != "" {
+			t, err := s.tokenConsumer.ConsumeToken(ctx, token)
+			if err != nil {
+				return entity.NotEnroledStatus, err
+			}
+			device.NamespaceID = t.NamespaceID
+			device.SetID = t.SetID
+		} else if !s.opts.AutoEnrolment {
+			device.EnrolStatus = entity.PendingEnrolStatus
+		}
 		err = s.deviceReaderWriter.CreateDevice(ctx, device)
 		if err != nil {
+			s.releaseToken(ctx, deviceID, token)
 			return entity.NotEnroledStatus, err
 		}
-		zap.S().Infow("device enroled", "device_id", deviceID, "enrol_status", d.EnrolStatus)
+		zap.S().Infow(enrolMessage(device.EnrolStatus), "device_id", deviceID, "enrol_status", device.EnrolStatus, "namespace_id", device.NamespaceID)
 		return device.EnrolStatus, nil
 	}
 
-	zap.S().Infow("device enroled", "device_id", deviceID, "enrol_status", d.EnrolStatus)
+	if d.EnrolStatus == entity.PendingEnrolStatus && token != "" {
+		t, err := s.tokenConsumer.ConsumeToken(ctx, token)
+		if err != nil {
+			return d.EnrolStatus, err
+		}
+		d.NamespaceID = t.NamespaceID
+		d.SetID = t.SetID
+		d.EnrolStatus = entity.EnroledStatus
+		d.EnroledAt = time.Now().UTC()
+		if err := s.deviceReaderWriter.UpdateDevice(ctx, d); err != nil {
+			s.releaseToken(ctx, deviceID, token)
+			return entity.PendingEnrolStatus, err
+		}
+	}
+
+	zap.S().Infow(enrolMessage(d.EnrolStatus), "device_id", deviceID, "enrol_status", d.EnrolStatus)
 	return d.EnrolStatus, nil
 }
 
+// enrolMessage returns the log message of an enrolment request which ended with the status.
+func enrolMessage(status entity.EnrolStatus) string {
+	switch status {
+	case entity.EnroledStatus:
+		return "device enroled"
+	case entity.PendingEnrolStatus:
+		return "device enrolment pending"
+	case entity.RefusedEnrolStatus:
+		return "device enrolment refused"
+	default:
+		return "device not enroled"
+	}
+}
+
+// releaseToken gives back the use of the token consumed by an enrolment which failed.
+func (s *Service) releaseToken(ctx context.Context, deviceID string, token string) {
+	if token == "" {
+		return
+	}
+	if err := s.tokenConsumer.ReleaseToken(ctx, token); err != nil {
+		zap.S().Errorw("unable to release enrolment token", "error", err, "device_id", deviceID)
+	}
+}
+
 func (s *Service) IsEnroled(ctx context.Context, deviceID string) (bool, error) {
 	device, err := s.deviceReaderWriter.GetDevice(ctx, deviceID)
 	if err != nil {
@@ -77,7 +154,7 @@ func (s *Service) Register(ctx context.Context, deviceID string, csr string) (en
 	}
 
 	cn := fmt.Sprintf("%s.%s", deviceID, BaseDomain)
-	certificate, err := s.certWriter.SignCSR(ctx, bytes.NewBufferString(csr).Bytes(), cn, DefaultCertificateTTL)
+	certificate, err := s.certWriter.SignCSR(ctx, bytes.NewBufferString(csr).Bytes(), cn, s.opts.CertificateTTL)
 	if err != nil {
 		return entity.CertificateGroup{}, fmt.Errorf("unable to sign the csr: %w", err)
 	}
@@ -95,6 +172,54 @@ func (s *Service) Register(ctx context.Context, deviceID string, csr string) (en
 	return certificate, nil
 }
 
+// RenewCertificate signs a new certificate for a registered device. The device is authenticated with its current certificate
+// which must be the last one issued to the device and expire within the renewal window.
+func (s *Service) RenewCertificate(ctx context.Context, deviceID string, csr string, current *x509.Certificate) (entity.CertificateGroup, error) {
+	device, err := s.deviceReaderWriter.GetDevice(ctx, deviceID)
+	if err != nil {
+		return entity.CertificateGroup{}, err
+	}
+
+	if !device.Registred {
+		return entity.CertificateGroup{}, errService.NewDeviceNotRegisteredError(deviceID)
+	}
+
+	cn := fmt.Sprintf("%s.%s", deviceID, BaseDomain)
+	if current.Subject.CommonName != cn {
+		return entity.CertificateGroup{}, errService.NewCertificateMismatchError(deviceID, fmt.Sprintf("common name %q is not %q", current.Subject.CommonName, cn))
+	}
+
+	// serial numbers are formatted like the auth service does
+	if current.SerialNumber == nil || !strings.EqualFold(fmt.Sprintf("%x", current.SerialNumber), device.CertificateSerialNumber) {
+		return entity.CertificateGroup{}, errService.NewCertificateMismatchError(deviceID, "serial number is not the one of the device certificate")
+	}
+
+	if s.opts.RenewalWindow > 0 && time.Until(current.NotAfter) > s.opts.RenewalWindow {
+		return entity.CertificateGroup{}, errService.NewCertificateNotRenewableError(deviceID, current.NotAfter)
+	}
+
+	certificate, err := s.certWriter.SignCSR(ctx, bytes.NewBufferString(csr).Bytes(), cn, s.opts.CertificateTTL)
+	if err != nil {
+		return entity.CertificateGroup{}, fmt.Errorf("unable to sign the csr: %w", err)
+	}
+
+	oldSerialNumber := device.CertificateSerialNumber
+	if err := s.deviceReaderWriter.UpdateCertificateSerialNumber(ctx, deviceID, oldSerialNumber, certificate.GetSerialNumber()); err != nil {
+		return entity.CertificateGroup{}, fmt.Errorf("unable to update certificate of device %q: %w", deviceID, err)
+	}
+
+	zap.S().Infow("device certificate renewed", "device_id", deviceID, "old_certificate_sn", oldSerialNumber, "certificate_sn", certificate.GetSerialNumber())
+
+	if s.opts.RevokeRenewedCertificate && oldSerialNumber != "" {
+		if err := s.certWriter.RevokeCertificate(ctx, oldSerialNumber); err != nil {
+			// the device already uses the new certificate. The old one will expire by itself.
+			zap.S().Errorw("unable to revoke old certificate", "error", err, "device_id", deviceID, "certificate_sn", oldSerialNumber)
+		}
+	}
+
+	return certificate, nil
+}
+
 func (s *Service) IsRegistered(ctx context.Context, deviceID string) (bool, error) {
 	device, err := s.deviceReaderWriter.GetDevice(ctx, deviceID)
 	if err != nil {
@@ -103,11 +228,79 @@ func (s *Service) IsRegistered(ctx context.Context, deviceID string) (bool, erro
 	return device.Registred, nil
 }
 
-func (s *Service) GetConfiguration(ctx context.Context, deviceID string) (entity.DeviceConfiguration, error) {
-	return s.confReader.GetDeviceConfiguration(ctx, deviceID)
+// GetConfiguration returns the configuration of the device. If currentHash is the hash of the configuration,
+// the device already has it and only the hash is returned marked as not modified.
+func (s *Service) GetConfiguration(ctx context.Context, deviceID string, currentHash string) (entity.DeviceConfiguration, error) {
+	conf, err := s.confReader.GetDeviceConfiguration(ctx, deviceID)
+	if err != nil {
+		return entity.DeviceConfiguration{}, err
+	}
+	return notModified(conf, currentHash), nil
 }
 
-// Heartbeat writes metrics from heartbeat.
+// WatchConfiguration returns a channel on which the configuration of the device is sent first when the watch starts and
+// then each time it changes. The first configuration is marked as not modified if currentHash is its hash.
+// The channel is closed when the context is done.
+func (s *Service) WatchConfiguration(ctx context.Context, deviceID string, currentHash string) (<-chan entity.DeviceConfiguration, error) {
+	// subscribe before reading the configuration so a change made in between is not lost.
+	notifications, unsubscribe := s.subscriber.Subscribe(deviceID)
+
+	current, err := s.confReader.GetDeviceConfiguration(ctx, deviceID)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	out := make(chan entity.DeviceConfiguration, 1)
+	out <- notModified(current, currentHash)
+
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-notifications:
+				conf, err := s.confReader.GetDeviceConfiguration(ctx, deviceID)
+				if err != nil {
+					zap.S().Errorw("unable to get device configuration", "error", err, "device_id", deviceID)
+					continue
+				}
+				if conf.Hash == current.Hash {
+					continue
+				}
+				current = conf
+				select {
+				case out <- conf:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Heartbeat saves the last seen time of the device and marks it as online.
 func (s *Service) Heartbeat(ctx context.Context, heartbeat entity.Heartbeat) error {
+	if heartbeat.Timestamp.IsZero() {
+		heartbeat.Timestamp = time.Now().UTC()
+	}
+
+	if err := s.deviceReaderWriter.UpdateHeartbeat(ctx, heartbeat); err != nil {
+		return err
+	}
+
+	zap.S().Debugw("heartbeat received", "device_id", heartbeat.DeviceID, "uptime", heartbeat.Uptime, "configuration_hash", heartbeat.ConfigurationHash, "workloads", len(heartbeat.Workloads))
 	return nil
 }
+
+func notModified(conf entity.DeviceConfiguration, currentHash string) entity.DeviceConfiguration {
+	if currentHash == "" || conf.Hash != currentHash {
+		return conf
+	}
+	return entity.DeviceConfiguration{Hash: conf.Hash, NotModified: true}
+}
@@ -0,0 +1,73 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package edge
+
+import (
+	"sync"
+)
+
+// Ensure, that SubscriberMock does implement Subscriber.
+// If this is not the case, regenerate this file with moq.
+var _ Subscriber = &SubscriberMock{}
+
+// SubscriberMock is a mock implementation of Subscriber.
+//
+// 	func TestSomethingThatUsesSubscriber(t *testing.T) {
+//
+// 		// make and configure a mocked Subscriber
+// 		mockedSubscriber := &SubscriberMock{
+// 			SubscribeFunc: func(deviceID string) (<-chan struct{}, func()) {
+// 				panic("mock out the Subscribe method")
+// 			},
+// 		}
+//
+// 		// use mockedSubscriber in code that requires Subscriber
+// 		// and then make assertions.
+//
+// 	}
+type SubscriberMock struct {
+	// SubscribeFunc mocks the Subscribe method.
+	SubscribeFunc func(deviceID string) (<-chan struct{}, func())
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Subscribe holds details about calls to the Subscribe method.
+		Subscribe []struct {
+			// DeviceID is the deviceID argument value.
+			DeviceID string
+		}
+	}
+	lockSubscribe sync.RWMutex
+}
+
+// Subscribe calls SubscribeFunc.
+func (mock *SubscriberMock) Subscribe(deviceID string) (<-chan struct{}, func()) {
+	if mock.SubscribeFunc == nil {
+		panic("SubscriberMock.SubscribeFunc: method is nil but Subscriber.Subscribe was just called")
+	}
+	callInfo := struct {
+		DeviceID string
+	}{
+		DeviceID: deviceID,
+	}
+	mock.lockSubscribe.Lock()
+	mock.calls.Subscribe = append(mock.calls.Subscribe, callInfo)
+	mock.lockSubscribe.Unlock()
+	return mock.SubscribeFunc(deviceID)
+}
+
+// SubscribeCalls gets all the calls that were made to Subscribe.
+// Check the length with:
+//     len(mockedSubscriber.SubscribeCalls())
+func (mock *SubscriberMock) SubscribeCalls() []struct {
+	DeviceID string
+} {
+	var calls []struct {
+		DeviceID string
+	}
+	mock.lockSubscribe.RLock()
+	calls = mock.calls.Subscribe
+	mock.lockSubscribe.RUnlock()
+	return calls
+}
@@ -0,0 +1,130 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package edge
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that TokenConsumerMock does implement TokenConsumer.
+// If this is not the case, regenerate this file with moq.
+var _ TokenConsumer = &TokenConsumerMock{}
+
+// TokenConsumerMock is a mock implementation of TokenConsumer.
+//
+// 	func TestSomethingThatUsesTokenConsumer(t *testing.T) {
+//
+// 		// make and configure a mocked TokenConsumer
+// 		mockedTokenConsumer := &TokenConsumerMock{
+// 			ConsumeTokenFunc: func(ctx context.Context, token string) (entity.EnrolmentToken, error) {
+// 				panic("mock out the ConsumeToken method")
+// 			},
+// 			ReleaseTokenFunc: func(ctx context.Context, token string) error {
+// 				panic("mock out the ReleaseToken method")
+// 			},
+// 		}
+//
+// 		// use mockedTokenConsumer in code that requires TokenConsumer
+// 		// and then make assertions.
+//
+// 	}
+type TokenConsumerMock struct {
+	// ConsumeTokenFunc mocks the ConsumeToken method.
+	ConsumeTokenFunc func(ctx context.Context, token string) (entity.EnrolmentToken, error)
+
+	// ReleaseTokenFunc mocks the ReleaseToken method.
+	ReleaseTokenFunc func(ctx context.Context, token string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ConsumeToken holds details about calls to the ConsumeToken method.
+		ConsumeToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+		// ReleaseToken holds details about calls to the ReleaseToken method.
+		ReleaseToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockConsumeToken sync.RWMutex
+	lockReleaseToken sync.RWMutex
+}
+
+// ConsumeToken calls ConsumeTokenFunc.
+func (mock *TokenConsumerMock) ConsumeToken(ctx context.Context, token string) (entity.EnrolmentToken, error) {
+	if mock.ConsumeTokenFunc == nil {
+		panic("TokenConsumerMock.ConsumeTokenFunc: method is nil but TokenConsumer.ConsumeToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockConsumeToken.Lock()
+	mock.calls.ConsumeToken = append(mock.calls.ConsumeToken, callInfo)
+	mock.lockConsumeToken.Unlock()
+	return mock.ConsumeTokenFunc(ctx, token)
+}
+
+// ConsumeTokenCalls gets all the calls that were made to ConsumeToken.
+// Check the length with:
+//     len(mockedTokenConsumer.ConsumeTokenCalls())
+func (mock *TokenConsumerMock) ConsumeTokenCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockConsumeToken.RLock()
+	calls = mock.calls.ConsumeToken
+	mock.lockConsumeToken.RUnlock()
+	return calls
+}
+
+// ReleaseToken calls ReleaseTokenFunc.
+func (mock *TokenConsumerMock) ReleaseToken(ctx context.Context, token string) error {
+	if mock.ReleaseTokenFunc == nil {
+		panic("TokenConsumerMock.ReleaseTokenFunc: method is nil but TokenConsumer.ReleaseToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockReleaseToken.Lock()
+	mock.calls.ReleaseToken = append(mock.calls.ReleaseToken, callInfo)
+	mock.lockReleaseToken.Unlock()
+	return mock.ReleaseTokenFunc(ctx, token)
+}
+
+// ReleaseTokenCalls gets all the calls that were made to ReleaseToken.
+// Check the length with:
+//     len(mockedTokenConsumer.ReleaseTokenCalls())
+func (mock *TokenConsumerMock) ReleaseTokenCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockReleaseToken.RLock()
+	calls = mock.calls.ReleaseToken
+	mock.lockReleaseToken.RUnlock()
+	return calls
+}
@@ -2,6 +2,7 @@ package errors
 
 import (
 	"fmt"
+	"time"
 )
 
 type DeviceNotRegisteredError struct {
@@ -28,6 +29,58 @@ func NewDeviceNotEnroledError(deviceID string) DeviceNotEnroledError {
 	return DeviceNotEnroledError{deviceID}
 }
 
+type InvalidEnrolStatusError struct {
+	DeviceID string
+	Status   string
+}
+
+func (d InvalidEnrolStatusError) Error() string {
+	return fmt.Sprintf("invalid enrol status %q for device %q", d.Status, d.DeviceID)
+}
+
+func NewInvalidEnrolStatusError(deviceID, status string) InvalidEnrolStatusError {
+	return InvalidEnrolStatusError{deviceID, status}
+}
+
+type CertificateNotRenewableError struct {
+	DeviceID  string
+	ExpiresAt time.Time
+}
+
+func (c CertificateNotRenewableError) Error() string {
+	return fmt.Sprintf("certificate of device %q expires at %s and it cannot be renewed yet", c.DeviceID, c.ExpiresAt.Format(time.RFC3339))
+}
+
+func NewCertificateNotRenewableError(deviceID string, expiresAt time.Time) CertificateNotRenewableError {
+	return CertificateNotRenewableError{deviceID, expiresAt}
+}
+
+// CertificateMismatchError is returned when the certificate presented by a device has not been issued to it.
+type CertificateMismatchError struct {
+	DeviceID string
+	Reason   string
+}
+
+func (c CertificateMismatchError) Error() string {
+	return fmt.Sprintf("certificate presented by device %q does not belong to it: %s", c.DeviceID, c.Reason)
+}
+
+func NewCertificateMismatchError(deviceID, reason string) CertificateMismatchError {
+	return CertificateMismatchError{deviceID, reason}
+}
+
+type InvalidEnrolmentTokenError struct {
+	Reason string
+}
+
+func (i InvalidEnrolmentTokenError) Error() string {
+	return fmt.Sprintf("invalid enrolment token: %s", i.Reason)
+}
+
+func NewInvalidEnrolmentTokenError(reason string) InvalidEnrolmentTokenError {
+	return InvalidEnrolmentTokenError{reason}
+}
+
 func IsResourceNotFound(err error) bool {
 	if err == nil {
 		return false
@@ -112,3 +165,123 @@ func (d DeleteResourceError) Error() string {
 func NewDeleteResourceError(resourceType, resourceID, reason string) DeleteResourceError {
 	return DeleteResourceError{resourceType, resourceID, reason}
 }
+
+type TemplateRenderError struct {
+	DeviceID   string
+	WorkloadID string
+	Reason     string
+}
+
+func (t TemplateRenderError) Error() string {
+	return fmt.Sprintf("unable to render workload %q for device %q: %s", t.WorkloadID, t.DeviceID, t.Reason)
+}
+
+func NewTemplateRenderError(deviceID, workloadID, reason string) TemplateRenderError {
+	return TemplateRenderError{deviceID, workloadID, reason}
+}
+
+func IsTemplateRenderError(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(TemplateRenderError)
+	return ok
+}
+
+type InvalidWebhookSignatureError struct {
+	RepositoryID string
+	Reason       string
+}
+
+func (i InvalidWebhookSignatureError) Error() string {
+	return fmt.Sprintf("invalid webhook for repository %q: %s", i.RepositoryID, i.Reason)
+}
+
+func NewInvalidWebhookSignatureError(repositoryID, reason string) InvalidWebhookSignatureError {
+	return InvalidWebhookSignatureError{repositoryID, reason}
+}
+
+func IsInvalidWebhookSignature(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(InvalidWebhookSignatureError)
+	return ok
+}
+
+type InvalidRepositoryRefError struct {
+	RepositoryID string
+	Reason       string
+}
+
+func (i InvalidRepositoryRefError) Error() string {
+	return fmt.Sprintf("invalid git reference for repository %q: %s", i.RepositoryID, i.Reason)
+}
+
+func NewInvalidRepositoryRefError(repositoryID, reason string) InvalidRepositoryRefError {
+	return InvalidRepositoryRefError{repositoryID, reason}
+}
+
+func IsInvalidRepositoryRef(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(InvalidRepositoryRefError)
+	return ok
+}
+
+type InvalidRepositoryScopeError struct {
+	RepositoryID string
+	Reason       string
+}
+
+func (i InvalidRepositoryScopeError) Error() string {
+	return fmt.Sprintf("invalid scope for repository %q: %s", i.RepositoryID, i.Reason)
+}
+
+func NewInvalidRepositoryScopeError(repositoryID, reason string) InvalidRepositoryScopeError {
+	return InvalidRepositoryScopeError{repositoryID, reason}
+}
+
+func IsInvalidRepositoryScope(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(InvalidRepositoryScopeError)
+	return ok
+}
+
+type InvalidRepositoryIDError struct {
+	RepositoryID string
+	Reason       string
+}
+
+func (i InvalidRepositoryIDError) Error() string {
+	return fmt.Sprintf("invalid repository id %q: %s", i.RepositoryID, i.Reason)
+}
+
+func NewInvalidRepositoryIDError(repositoryID, reason string) InvalidRepositoryIDError {
+	return InvalidRepositoryIDError{repositoryID, reason}
+}
+
+type CommitSignatureError struct {
+	RepositoryID string
+	Commit       string
+	Reason       string
+}
+
+func (c CommitSignatureError) Error() string {
+	return fmt.Sprintf("commit %q of repository %q is refused: %s", c.Commit, c.RepositoryID, c.Reason)
+}
+
+func NewCommitSignatureError(repositoryID, commit, reason string) CommitSignatureError {
+	return CommitSignatureError{repositoryID, commit, reason}
+}
+
+func IsCommitSignatureError(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(CommitSignatureError)
+	return ok
+}
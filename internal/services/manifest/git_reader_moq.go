@@ -19,7 +19,7 @@ var _ GitReader = &GitReaderMock{}
 //
 // 		// make and configure a mocked GitReader
 // 		mockedGitReader := &GitReaderMock{
-// 			GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+// 			GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 // 				panic("mock out the GetManifests method")
 // 			},
 // 		}
@@ -30,7 +30,7 @@ var _ GitReader = &GitReaderMock{}
 // 	}
 type GitReaderMock struct {
 	// GetManifestsFunc mocks the GetManifests method.
-	GetManifestsFunc func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error)
+	GetManifestsFunc func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -48,7 +48,7 @@ type GitReaderMock struct {
 }
 
 // GetManifests calls GetManifestsFunc.
-func (mock *GitReaderMock) GetManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+func (mock *GitReaderMock) GetManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 	if mock.GetManifestsFunc == nil {
 		panic("GitReaderMock.GetManifestsFunc: method is nil but GitReader.GetManifests was just called")
 	}
@@ -18,12 +18,14 @@ type DeviceReader interface {
 type ManifestReader interface {
 	GetManifest(ctx context.Context, id string) (entity.Manifest, error)
 	GetManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error)
+	GetManifestsByLabels(ctx context.Context, labels map[string]string) ([]entity.Manifest, error)
 }
 
 type ManifestWriter interface {
 	InsertManifest(ctx context.Context, manifest entity.Manifest) error
 	UpdateManifest(ctx context.Context, manifest entity.Manifest) error
 	DeleteManifest(ctx context.Context, id string) error
+	RenameManifest(ctx context.Context, oldID, newID string) error
 
 	CreateRelation(ctx context.Context, relation entity.Relation) error
 	DeleteRelation(ctx context.Context, relation entity.Relation) error
@@ -37,5 +39,10 @@ type ManifestReaderWriter interface {
 
 //go:generate moq -out git_reader_moq.go . GitReader
 type GitReader interface {
-	GetManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error)
+	GetManifests(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error)
+}
+
+//go:generate moq -out notifier_moq.go . Notifier
+type Notifier interface {
+	NotifyAll()
 }
@@ -34,9 +34,15 @@ var _ ManifestReaderWriter = &ManifestReaderWriterMock{}
 // 			GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
 // 				panic("mock out the GetManifests method")
 // 			},
+// 			GetManifestsByLabelsFunc: func(ctx context.Context, labels map[string]string) ([]entity.Manifest, error) {
+// 				panic("mock out the GetManifestsByLabels method")
+// 			},
 // 			InsertManifestFunc: func(ctx context.Context, manifest entity.Manifest) error {
 // 				panic("mock out the InsertManifest method")
 // 			},
+// 			RenameManifestFunc: func(ctx context.Context, oldID string, newID string) error {
+// 				panic("mock out the RenameManifest method")
+// 			},
 // 			UpdateManifestFunc: func(ctx context.Context, manifest entity.Manifest) error {
 // 				panic("mock out the UpdateManifest method")
 // 			},
@@ -62,9 +68,15 @@ type ManifestReaderWriterMock struct {
 	// GetManifestsFunc mocks the GetManifests method.
 	GetManifestsFunc func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error)
 
+	// GetManifestsByLabelsFunc mocks the GetManifestsByLabels method.
+	GetManifestsByLabelsFunc func(ctx context.Context, labels map[string]string) ([]entity.Manifest, error)
+
 	// InsertManifestFunc mocks the InsertManifest method.
 	InsertManifestFunc func(ctx context.Context, manifest entity.Manifest) error
 
+	// RenameManifestFunc mocks the RenameManifest method.
+	RenameManifestFunc func(ctx context.Context, oldID string, newID string) error
+
 	// UpdateManifestFunc mocks the UpdateManifest method.
 	UpdateManifestFunc func(ctx context.Context, manifest entity.Manifest) error
 
@@ -107,6 +119,13 @@ type ManifestReaderWriterMock struct {
 			// FilterFn is the filterFn argument value.
 			FilterFn func(m entity.Manifest) bool
 		}
+		// GetManifestsByLabels holds details about calls to the GetManifestsByLabels method.
+		GetManifestsByLabels []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Labels is the labels argument value.
+			Labels map[string]string
+		}
 		// InsertManifest holds details about calls to the InsertManifest method.
 		InsertManifest []struct {
 			// Ctx is the ctx argument value.
@@ -114,6 +133,15 @@ type ManifestReaderWriterMock struct {
 			// Manifest is the manifest argument value.
 			Manifest entity.Manifest
 		}
+		// RenameManifest holds details about calls to the RenameManifest method.
+		RenameManifest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OldID is the oldID argument value.
+			OldID string
+			// NewID is the newID argument value.
+			NewID string
+		}
 		// UpdateManifest holds details about calls to the UpdateManifest method.
 		UpdateManifest []struct {
 			// Ctx is the ctx argument value.
@@ -122,13 +150,15 @@ type ManifestReaderWriterMock struct {
 			Manifest entity.Manifest
 		}
 	}
-	lockCreateRelation sync.RWMutex
-	lockDeleteManifest sync.RWMutex
-	lockDeleteRelation sync.RWMutex
-	lockGetManifest    sync.RWMutex
-	lockGetManifests   sync.RWMutex
-	lockInsertManifest sync.RWMutex
-	lockUpdateManifest sync.RWMutex
+	lockCreateRelation       sync.RWMutex
+	lockDeleteManifest       sync.RWMutex
+	lockDeleteRelation       sync.RWMutex
+	lockGetManifest          sync.RWMutex
+	lockGetManifests         sync.RWMutex
+	lockGetManifestsByLabels sync.RWMutex
+	lockInsertManifest       sync.RWMutex
+	lockRenameManifest       sync.RWMutex
+	lockUpdateManifest       sync.RWMutex
 }
 
 // CreateRelation calls CreateRelationFunc.
@@ -310,6 +340,41 @@ func (mock *ManifestReaderWriterMock) GetManifestsCalls() []struct {
 	return calls
 }
 
+// GetManifestsByLabels calls GetManifestsByLabelsFunc.
+func (mock *ManifestReaderWriterMock) GetManifestsByLabels(ctx context.Context, labels map[string]string) ([]entity.Manifest, error) {
+	if mock.GetManifestsByLabelsFunc == nil {
+		panic("ManifestReaderWriterMock.GetManifestsByLabelsFunc: method is nil but ManifestReaderWriter.GetManifestsByLabels was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Labels map[string]string
+	}{
+		Ctx:    ctx,
+		Labels: labels,
+	}
+	mock.lockGetManifestsByLabels.Lock()
+	mock.calls.GetManifestsByLabels = append(mock.calls.GetManifestsByLabels, callInfo)
+	mock.lockGetManifestsByLabels.Unlock()
+	return mock.GetManifestsByLabelsFunc(ctx, labels)
+}
+
+// GetManifestsByLabelsCalls gets all the calls that were made to GetManifestsByLabels.
+// Check the length with:
+//     len(mockedManifestReaderWriter.GetManifestsByLabelsCalls())
+func (mock *ManifestReaderWriterMock) GetManifestsByLabelsCalls() []struct {
+	Ctx    context.Context
+	Labels map[string]string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Labels map[string]string
+	}
+	mock.lockGetManifestsByLabels.RLock()
+	calls = mock.calls.GetManifestsByLabels
+	mock.lockGetManifestsByLabels.RUnlock()
+	return calls
+}
+
 // InsertManifest calls InsertManifestFunc.
 func (mock *ManifestReaderWriterMock) InsertManifest(ctx context.Context, manifest entity.Manifest) error {
 	if mock.InsertManifestFunc == nil {
@@ -345,6 +410,45 @@ func (mock *ManifestReaderWriterMock) InsertManifestCalls() []struct {
 	return calls
 }
 
+// RenameManifest calls RenameManifestFunc.
+func (mock *ManifestReaderWriterMock) RenameManifest(ctx context.Context, oldID string, newID string) error {
+	if mock.RenameManifestFunc == nil {
+		panic("ManifestReaderWriterMock.RenameManifestFunc: method is nil but ManifestReaderWriter.RenameManifest was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		OldID string
+		NewID string
+	}{
+		Ctx:   ctx,
+		OldID: oldID,
+		NewID: newID,
+	}
+	mock.lockRenameManifest.Lock()
+	mock.calls.RenameManifest = append(mock.calls.RenameManifest, callInfo)
+	mock.lockRenameManifest.Unlock()
+	return mock.RenameManifestFunc(ctx, oldID, newID)
+}
+
+// RenameManifestCalls gets all the calls that were made to RenameManifest.
+// Check the length with:
+//     len(mockedManifestReaderWriter.RenameManifestCalls())
+func (mock *ManifestReaderWriterMock) RenameManifestCalls() []struct {
+	Ctx   context.Context
+	OldID string
+	NewID string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		OldID string
+		NewID string
+	}
+	mock.lockRenameManifest.RLock()
+	calls = mock.calls.RenameManifest
+	mock.lockRenameManifest.RUnlock()
+	return calls
+}
+
 // UpdateManifest calls UpdateManifestFunc.
 func (mock *ManifestReaderWriterMock) UpdateManifest(ctx context.Context, manifest entity.Manifest) error {
 	if mock.UpdateManifestFunc == nil {
@@ -23,6 +23,9 @@ var _ = Describe("manifests", func() {
 		manifestReaderWriter *manifest.ManifestReaderWriterMock
 		service              *manifest.Service
 		db                   *db
+		notifier             = &manifest.NotifierMock{
+			NotifyAllFunc: func() {},
+		}
 	)
 
 	Describe("CRUD relations and manifests", Ordered, func() {
@@ -83,7 +86,7 @@ var _ = Describe("manifests", func() {
 		Context("namespace", func() {
 			It("successfully creates a relation for a namespace", func() {
 				gitReader = &manifest.GitReaderMock{
-					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 						workload := entity.ManifestV1{
 							ObjectMeta: entity.ObjectMeta{
 								Id:   "test",
@@ -98,11 +101,11 @@ var _ = Describe("manifests", func() {
 						}
 						manifest := []entity.Manifest{}
 						manifest = append(manifest, workload)
-						return manifest, nil
+						return manifest, nil, nil
 					},
 				}
-				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-				err := service.UpdateManifests(context.TODO(), entity.Repository{})
+				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+				_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 				Expect(err).To(BeNil())
 
 				// expect one manifest and one relation
@@ -117,7 +120,7 @@ var _ = Describe("manifests", func() {
 
 			It("unable to create relation when namespace is missing", func() {
 				gitReader = &manifest.GitReaderMock{
-					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 						workload := entity.ManifestV1{
 							ObjectMeta: entity.ObjectMeta{
 								Id:   "test",
@@ -132,15 +135,15 @@ var _ = Describe("manifests", func() {
 						}
 						manifest := []entity.Manifest{}
 						manifest = append(manifest, workload)
-						return manifest, nil
+						return manifest, nil, nil
 					},
 				}
 				deviceReaderWriter.GetNamespaceFunc = func(ctx context.Context, id string) (entity.Namespace, error) {
 					return entity.Namespace{}, errors.NewResourceNotFoundError("namespace", id)
 				}
 
-				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-				err := service.UpdateManifests(context.TODO(), entity.Repository{})
+				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+				_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 				Expect(err).To(BeNil())
 
 				// expect one manifest and one relation
@@ -151,7 +154,7 @@ var _ = Describe("manifests", func() {
 
 			It("successfully delete a relation for a namespace", func() {
 				gitReader = &manifest.GitReaderMock{
-					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 						workload := entity.ManifestV1{
 							ObjectMeta: entity.ObjectMeta{
 								Id:   "test",
@@ -166,11 +169,11 @@ var _ = Describe("manifests", func() {
 						}
 						manifest := []entity.Manifest{}
 						manifest = append(manifest, workload)
-						return manifest, nil
+						return manifest, nil, nil
 					},
 				}
-				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-				err := service.UpdateManifests(context.TODO(), entity.Repository{})
+				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+				_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 				Expect(err).To(BeNil())
 
 				// expect one manifest and one relation
@@ -182,7 +185,7 @@ var _ = Describe("manifests", func() {
 				Expect(r.ResourceID).To(Equal("namespace"))
 				Expect(r.ManifestID).To(Equal("test"))
 
-				gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -191,14 +194,14 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				}
 				m, _ := db.GetManifest("test")
 				w := m.(entity.ManifestV1)
 				w.Namespaces = append(w.Namespaces, "namespace")
 				db.InsertManifest(w)
 
-				err = service.UpdateManifests(context.TODO(), entity.Repository{})
+				_, err = service.UpdateManifests(context.TODO(), entity.Repository{})
 				Expect(err).To(BeNil())
 
 				// expect one manifest and one relation
@@ -209,7 +212,7 @@ var _ = Describe("manifests", func() {
 
 			It("successfully updates a relation for a namespace", func() {
 				gitReader = &manifest.GitReaderMock{
-					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 						workload := entity.ManifestV1{
 							ObjectMeta: entity.ObjectMeta{
 								Id:   "test",
@@ -224,11 +227,11 @@ var _ = Describe("manifests", func() {
 						}
 						manifest := []entity.Manifest{}
 						manifest = append(manifest, workload)
-						return manifest, nil
+						return manifest, nil, nil
 					},
 				}
-				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-				err := service.UpdateManifests(context.TODO(), entity.Repository{})
+				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+				_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 				Expect(err).To(BeNil())
 
 				// expect one manifest and one relation
@@ -240,7 +243,7 @@ var _ = Describe("manifests", func() {
 				Expect(r.ResourceID).To(Equal("namespace"))
 				Expect(r.ManifestID).To(Equal("test"))
 
-				gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -255,14 +258,14 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				}
 				m, _ := db.GetManifest("test")
 				w := m.(entity.ManifestV1)
 				w.Namespaces = append(w.Namespaces, "namespace")
 				db.InsertManifest(w)
 
-				err = service.UpdateManifests(context.TODO(), entity.Repository{})
+				_, err = service.UpdateManifests(context.TODO(), entity.Repository{})
 				Expect(err).To(BeNil())
 
 				// expect one manifest and one relation
@@ -277,7 +280,7 @@ var _ = Describe("manifests", func() {
 
 			It("successfully delete relation and manifest when manifest removed from git", func() {
 				gitReader = &manifest.GitReaderMock{
-					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+					GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 						workload := entity.ManifestV1{
 							ObjectMeta: entity.ObjectMeta{
 								Id:   "test",
@@ -292,11 +295,11 @@ var _ = Describe("manifests", func() {
 						}
 						manifest := []entity.Manifest{}
 						manifest = append(manifest, workload)
-						return manifest, nil
+						return manifest, nil, nil
 					},
 				}
-				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-				err := service.UpdateManifests(context.TODO(), entity.Repository{})
+				service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+				_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 				Expect(err).To(BeNil())
 
 				// expect one manifest and one relation
@@ -308,16 +311,16 @@ var _ = Describe("manifests", func() {
 				Expect(r.ResourceID).To(Equal("namespace"))
 				Expect(r.ManifestID).To(Equal("test"))
 
-				gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					manifest := []entity.Manifest{}
-					return manifest, nil
+					return manifest, nil, nil
 				}
 				m, _ := db.GetManifest("test")
 				w := m.(entity.ManifestV1)
 				w.Namespaces = append(w.Namespaces, "namespace")
 				db.InsertManifest(w)
 
-				err = service.UpdateManifests(context.TODO(), entity.Repository{})
+				_, err = service.UpdateManifests(context.TODO(), entity.Repository{})
 				Expect(err).To(BeNil())
 
 				// expect one manifest and one relation
@@ -331,7 +334,7 @@ var _ = Describe("manifests", func() {
 	Context("sets", func() {
 		It("successfully creates a relation for a set", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -346,11 +349,11 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -365,7 +368,7 @@ var _ = Describe("manifests", func() {
 
 		It("unable to create a relation when set is missing", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -380,7 +383,7 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
 			d := &manifest.DeviceReaderMock{
@@ -389,8 +392,8 @@ var _ = Describe("manifests", func() {
 				},
 			}
 
-			service = manifest.New(d, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(d, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			mCount, rCount := db.Count()
@@ -400,7 +403,7 @@ var _ = Describe("manifests", func() {
 
 		It("successfully creates a relation for a set and a namespace", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -419,11 +422,11 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -434,7 +437,7 @@ var _ = Describe("manifests", func() {
 
 		It("successfully delete a relation for a set", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -449,11 +452,11 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -465,7 +468,7 @@ var _ = Describe("manifests", func() {
 			Expect(r.ResourceID).To(Equal("set"))
 			Expect(r.ManifestID).To(Equal("test"))
 
-			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 				workload := entity.ManifestV1{
 					ObjectMeta: entity.ObjectMeta{
 						Id:   "test",
@@ -474,14 +477,14 @@ var _ = Describe("manifests", func() {
 				}
 				manifest := []entity.Manifest{}
 				manifest = append(manifest, workload)
-				return manifest, nil
+				return manifest, nil, nil
 			}
 			m, _ := db.GetManifest("test")
 			w := m.(entity.ManifestV1)
 			w.Sets = append(w.Sets, "set")
 			db.InsertManifest(w)
 
-			err = service.UpdateManifests(context.TODO(), entity.Repository{})
+			_, err = service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			mCount, rCount = db.Count()
@@ -491,7 +494,7 @@ var _ = Describe("manifests", func() {
 
 		It("successfully updates a relation for a set", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -506,11 +509,11 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -522,7 +525,7 @@ var _ = Describe("manifests", func() {
 			Expect(r.ResourceID).To(Equal("set"))
 			Expect(r.ManifestID).To(Equal("test"))
 
-			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 				workload := entity.ManifestV1{
 					ObjectMeta: entity.ObjectMeta{
 						Id:   "test",
@@ -537,14 +540,14 @@ var _ = Describe("manifests", func() {
 				}
 				manifest := []entity.Manifest{}
 				manifest = append(manifest, workload)
-				return manifest, nil
+				return manifest, nil, nil
 			}
 			m, _ := db.GetManifest("test")
 			w := m.(entity.ManifestV1)
 			w.Sets = append(w.Sets, "set")
 			db.InsertManifest(w)
 
-			err = service.UpdateManifests(context.TODO(), entity.Repository{})
+			_, err = service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -559,7 +562,7 @@ var _ = Describe("manifests", func() {
 	Context("devices", func() {
 		It("successfully creates a relation for a device", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -574,11 +577,11 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -589,7 +592,7 @@ var _ = Describe("manifests", func() {
 
 		It("unable to create relation when device is missing", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -604,7 +607,7 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
 			d := &manifest.DeviceReaderMock{
@@ -612,8 +615,8 @@ var _ = Describe("manifests", func() {
 					return entity.Device{}, errors.NewResourceNotFoundError("device", id)
 				},
 			}
-			service = manifest.New(d, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(d, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			mCount, rCount := db.Count()
@@ -623,7 +626,7 @@ var _ = Describe("manifests", func() {
 
 		It("successfully creates a relation for 1 set,1 namespace and 1 device", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -646,11 +649,11 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			mCount, rCount := db.Count()
@@ -660,7 +663,7 @@ var _ = Describe("manifests", func() {
 
 		It("successfully deletes a relation for the set only", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -683,18 +686,18 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			mCount, rCount := db.Count()
 			Expect(mCount).To(Equal(1), "expect 1 manifest")
 			Expect(rCount).To(Equal(3), "expect 3 relations")
 
-			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 				workload := entity.ManifestV1{
 					ObjectMeta: entity.ObjectMeta{
 						Id:   "test",
@@ -713,7 +716,7 @@ var _ = Describe("manifests", func() {
 				}
 				manifest := []entity.Manifest{}
 				manifest = append(manifest, workload)
-				return manifest, nil
+				return manifest, nil, nil
 			}
 			m, _ := db.GetManifest("test")
 			w := m.(entity.ManifestV1)
@@ -721,7 +724,7 @@ var _ = Describe("manifests", func() {
 			w.Namespaces = append(w.Namespaces, "namespace")
 			db.InsertManifest(w)
 
-			err = service.UpdateManifests(context.TODO(), entity.Repository{})
+			_, err = service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			mCount, rCount = db.Count()
@@ -731,7 +734,7 @@ var _ = Describe("manifests", func() {
 
 		It("successfully delete a relation for a device", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -746,11 +749,11 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -762,7 +765,7 @@ var _ = Describe("manifests", func() {
 			Expect(r.ResourceID).To(Equal("device"))
 			Expect(r.ManifestID).To(Equal("test"))
 
-			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 				workload := entity.ManifestV1{
 					ObjectMeta: entity.ObjectMeta{
 						Id:   "test",
@@ -771,14 +774,14 @@ var _ = Describe("manifests", func() {
 				}
 				manifest := []entity.Manifest{}
 				manifest = append(manifest, workload)
-				return manifest, nil
+				return manifest, nil, nil
 			}
 			m, _ := db.GetManifest("test")
 			w := m.(entity.ManifestV1)
 			w.Devices = append(w.Devices, "device")
 			db.InsertManifest(w)
 
-			err = service.UpdateManifests(context.TODO(), entity.Repository{})
+			_, err = service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			mCount, rCount = db.Count()
@@ -788,7 +791,7 @@ var _ = Describe("manifests", func() {
 
 		It("successfully updates a relation for a device", func() {
 			gitReader = &manifest.GitReaderMock{
-				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 					workload := entity.ManifestV1{
 						ObjectMeta: entity.ObjectMeta{
 							Id:   "test",
@@ -803,11 +806,11 @@ var _ = Describe("manifests", func() {
 					}
 					manifest := []entity.Manifest{}
 					manifest = append(manifest, workload)
-					return manifest, nil
+					return manifest, nil, nil
 				},
 			}
-			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader)
-			err := service.UpdateManifests(context.TODO(), entity.Repository{})
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -815,7 +818,7 @@ var _ = Describe("manifests", func() {
 			Expect(mCount).To(Equal(1), "expect 1 manifest")
 			Expect(rCount).To(Equal(1), "expect 1 relation")
 
-			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+			gitReader.GetManifestsFunc = func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
 				workload := entity.ManifestV1{
 					ObjectMeta: entity.ObjectMeta{
 						Id:   "test",
@@ -830,14 +833,14 @@ var _ = Describe("manifests", func() {
 				}
 				manifest := []entity.Manifest{}
 				manifest = append(manifest, workload)
-				return manifest, nil
+				return manifest, nil, nil
 			}
 			m, _ := db.GetManifest("test")
 			w := m.(entity.ManifestV1)
 			w.Devices = append(w.Devices, "device")
 			db.InsertManifest(w)
 
-			err = service.UpdateManifests(context.TODO(), entity.Repository{})
+			_, err = service.UpdateManifests(context.TODO(), entity.Repository{})
 			Expect(err).To(BeNil())
 
 			// expect one manifest and one relation
@@ -850,9 +853,245 @@ var _ = Describe("manifests", func() {
 		})
 	})
 
+	Describe("manifest ids", func() {
+		BeforeEach(func() {
+			db = NewDB()
+			deviceReaderWriter = &manifest.DeviceReaderMock{
+				GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+					return entity.Namespace{Name: id}, nil
+				},
+			}
+			manifestReaderWriter = &manifest.ManifestReaderWriterMock{
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+					return db.GetManifests(), nil
+				},
+				GetManifestFunc: func(ctx context.Context, id string) (entity.Manifest, error) {
+					m, ok := db.GetManifest(id)
+					if !ok {
+						return m, fmt.Errorf("not found")
+					}
+					return m, nil
+				},
+				InsertManifestFunc: func(ctx context.Context, manifest entity.Manifest) error {
+					db.InsertManifest(manifest)
+					return nil
+				},
+				UpdateManifestFunc: func(ctx context.Context, manifest entity.Manifest) error {
+					db.InsertManifest(manifest)
+					return nil
+				},
+				DeleteManifestFunc: func(ctx context.Context, id string) error {
+					db.DeleteManifest(id)
+					return nil
+				},
+				RenameManifestFunc: func(ctx context.Context, oldID, newID string) error {
+					db.RenameManifest(oldID, newID)
+					return nil
+				},
+				CreateRelationFunc: func(ctx context.Context, relation entity.Relation) error {
+					db.InsertRelation(relation)
+					return nil
+				},
+				DeleteRelationFunc: func(ctx context.Context, relation entity.Relation) error {
+					db.DeleteRelation(relation)
+					return nil
+				},
+			}
+			gitReader = &manifest.GitReaderMock{
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
+					workload := entity.ManifestV1{
+						ObjectMeta: entity.ObjectMeta{
+							Id:   entity.NewManifestID("repo", "web"),
+							Name: "web",
+							Hash: "hash",
+						},
+						Path: "workloads/web.yaml",
+						Selectors: entity.Selectors{
+							{
+								Type:  entity.NamespaceSelector,
+								Value: "namespace",
+							},
+						},
+					}
+					return []entity.Manifest{workload}, nil, nil
+				},
+			}
+			service = manifest.New(deviceReaderWriter, manifestReaderWriter, gitReader, notifier)
+		})
+
+		It("renames the manifests stored with the id computed from their path", func() {
+			db.InsertManifest(entity.ManifestV1{
+				ObjectMeta: entity.ObjectMeta{Id: "path-hash", Name: "web", Hash: "hash"},
+				Path:       "/var/repos/repo/workloads/web.yaml",
+				Namespaces: []string{"namespace"},
+			})
+			db.InsertRelation(entity.NewNamespaceRelation("namespace", "path-hash"))
+
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{Id: "repo", LocalPath: "/var/repos/repo"})
+			Expect(err).To(BeNil())
+
+			id := entity.NewManifestID("repo", "web")
+			Expect(len(manifestReaderWriter.RenameManifestCalls())).To(Equal(1))
+			Expect(manifestReaderWriter.DeleteManifestCalls()).To(BeEmpty())
+
+			mCount, rCount := db.Count()
+			Expect(mCount).To(Equal(1), "expect 1 manifest")
+			Expect(rCount).To(Equal(1), "expect 1 relation")
+			_, ok := db.GetManifest(id)
+			Expect(ok).To(BeTrue())
+			r, ok := db.GetRelation(id + "namespace")
+			Expect(ok).To(BeTrue())
+			Expect(r.ManifestID).To(Equal(id))
+		})
+
+		It("renames the manifests stored before the local storage was moved", func() {
+			db.InsertManifest(entity.ManifestV1{
+				ObjectMeta: entity.ObjectMeta{Id: "path-hash", Name: "web", Hash: "hash"},
+				Path:       "/old/storage/repo/workloads/web.yaml",
+				Namespaces: []string{"namespace"},
+			})
+			db.InsertRelation(entity.NewNamespaceRelation("namespace", "path-hash"))
+
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{Id: "repo", LocalPath: "/var/repos/repo"})
+			Expect(err).To(BeNil())
+
+			id := entity.NewManifestID("repo", "web")
+			Expect(len(manifestReaderWriter.RenameManifestCalls())).To(Equal(1))
+			Expect(manifestReaderWriter.RenameManifestCalls()[0].NewID).To(Equal(id))
+			Expect(manifestReaderWriter.DeleteManifestCalls()).To(BeEmpty())
+
+			m, ok := db.GetManifest(id)
+			Expect(ok).To(BeTrue())
+			Expect(m.(entity.ManifestV1).Path).To(Equal("workloads/web.yaml"))
+		})
+
+		It("keeps the id when the manifest file is moved", func() {
+			id := entity.NewManifestID("repo", "web")
+			db.InsertManifest(entity.ManifestV1{
+				ObjectMeta: entity.ObjectMeta{Id: id, Name: "web", Hash: "hash"},
+				Path:       "web.yaml",
+				Namespaces: []string{"namespace"},
+			})
+			db.InsertRelation(entity.NewNamespaceRelation("namespace", id))
+
+			_, err := service.UpdateManifests(context.TODO(), entity.Repository{Id: "repo", LocalPath: "/var/repos/repo"})
+			Expect(err).To(BeNil())
+
+			Expect(manifestReaderWriter.RenameManifestCalls()).To(BeEmpty())
+			Expect(manifestReaderWriter.DeleteManifestCalls()).To(BeEmpty())
+			Expect(manifestReaderWriter.InsertManifestCalls()).To(BeEmpty())
+
+			m, ok := db.GetManifest(id)
+			Expect(ok).To(BeTrue())
+			Expect(m.(entity.ManifestV1).Path).To(Equal("workloads/web.yaml"))
+			_, rCount := db.Count()
+			Expect(rCount).To(Equal(1), "expect 1 relation")
+		})
+	})
+
+	Describe("repository deletion", func() {
+		BeforeEach(func() {
+			db = NewDB()
+			manifestReaderWriter = &manifest.ManifestReaderWriterMock{
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+					return db.GetManifests(), nil
+				},
+				DeleteManifestFunc: func(ctx context.Context, id string) error {
+					db.DeleteManifest(id)
+					return nil
+				},
+			}
+			service = manifest.New(&manifest.DeviceReaderMock{}, manifestReaderWriter, &manifest.GitReaderMock{}, notifier)
+
+			db.InsertManifest(entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "idle"), Name: "idle"}})
+			db.InsertManifest(entity.ManifestV1{
+				ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "web"), Name: "web"},
+				Devices:    []string{"device"},
+			})
+			db.InsertRelation(entity.NewDeviceRelation("device", entity.NewManifestID("repo", "web")))
+		})
+
+		It("refuses to delete the manifests still deployed to devices", func() {
+			err := service.DeleteManifests(context.TODO(), entity.Repository{Id: "repo"}, false)
+			Expect(err).ToNot(BeNil())
+			_, ok := err.(errors.DeleteResourceError)
+			Expect(ok).To(BeTrue())
+			Expect(manifestReaderWriter.DeleteManifestCalls()).To(BeEmpty())
+		})
+
+		It("deletes the manifests still deployed to devices when forced", func() {
+			err := service.DeleteManifests(context.TODO(), entity.Repository{Id: "repo"}, true)
+			Expect(err).To(BeNil())
+			mCount, rCount := db.Count()
+			Expect(mCount).To(Equal(0), "expect no manifest")
+			Expect(rCount).To(Equal(0), "expect no relation")
+		})
+
+		It("deletes the manifests which are not deployed", func() {
+			db.DeleteManifest(entity.NewManifestID("repo", "web"))
+
+			err := service.DeleteManifests(context.TODO(), entity.Repository{Id: "repo"}, false)
+			Expect(err).To(BeNil())
+			mCount, _ := db.Count()
+			Expect(mCount).To(Equal(0), "expect no manifest")
+		})
+	})
+
 	AfterEach(func() {
 		db.Clear()
 	})
+
+	Describe("manifest changes", func() {
+		It("returns the manifests created, updated and deleted with the file errors", func() {
+			db = NewDB()
+			db.InsertManifest(entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "kept"), Name: "kept", Hash: "1"}, Path: "kept.manifest.yaml"})
+			db.InsertManifest(entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "changed"), Name: "changed", Hash: "1"}, Path: "changed.manifest.yaml"})
+			db.InsertManifest(entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "removed"), Name: "removed"}, Path: "removed.manifest.yaml"})
+
+			manifestReaderWriter = &manifest.ManifestReaderWriterMock{
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, error) {
+					return db.GetManifests(), nil
+				},
+				GetManifestFunc: func(ctx context.Context, id string) (entity.Manifest, error) {
+					m, ok := db.GetManifest(id)
+					if !ok {
+						return m, fmt.Errorf("not found")
+					}
+					return m, nil
+				},
+				InsertManifestFunc: func(ctx context.Context, manifest entity.Manifest) error {
+					db.InsertManifest(manifest)
+					return nil
+				},
+				UpdateManifestFunc: func(ctx context.Context, manifest entity.Manifest) error {
+					db.InsertManifest(manifest)
+					return nil
+				},
+				DeleteManifestFunc: func(ctx context.Context, id string) error {
+					db.DeleteManifest(id)
+					return nil
+				},
+			}
+			gitReader = &manifest.GitReaderMock{
+				GetManifestsFunc: func(ctx context.Context, repo entity.Repository, filterFn func(m entity.Manifest) bool) ([]entity.Manifest, []entity.ManifestFileError, error) {
+					return []entity.Manifest{
+						entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "kept"), Name: "kept", Hash: "1"}, Path: "kept.manifest.yaml"},
+						entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "changed"), Name: "changed", Hash: "2"}, Path: "changed.manifest.yaml"},
+						entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "added"), Name: "added"}, Path: "added.manifest.yaml"},
+						entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: entity.NewManifestID("repo", "other"), Name: "other"}, Path: "other.manifest.yaml"},
+					}, []entity.ManifestFileError{{Path: "broken.manifest.yaml", Reason: "invalid yaml"}}, nil
+				},
+			}
+			service = manifest.New(&manifest.DeviceReaderMock{}, manifestReaderWriter, gitReader, notifier)
+
+			changes, err := service.UpdateManifests(context.TODO(), entity.Repository{Id: "repo"})
+			Expect(err).To(BeNil())
+			Expect(changes.Created).To(Equal(2))
+			Expect(changes.Updated).To(Equal(1))
+			Expect(changes.Deleted).To(Equal(1))
+			Expect(changes.FileErrors).To(Equal([]entity.ManifestFileError{{Path: "broken.manifest.yaml", Reason: "invalid yaml"}}))
+		})
+	})
 })
 
 type db struct {
@@ -928,3 +1167,22 @@ func (d *db) DeleteManifest(id string) {
 func (d *db) DeleteRelation(r entity.Relation) {
 	delete(d.Relations, fmt.Sprintf("%s%s", r.ManifestID, r.ResourceID))
 }
+
+func (d *db) RenameManifest(oldID, newID string) {
+	m, ok := d.Manifests[oldID]
+	if !ok {
+		return
+	}
+	w := m.(entity.ManifestV1)
+	w.Id = newID
+	delete(d.Manifests, oldID)
+	d.Manifests[newID] = w
+
+	for key, r := range d.Relations {
+		if r.ManifestID == oldID {
+			delete(d.Relations, key)
+			r.ManifestID = newID
+			d.InsertRelation(r)
+		}
+	}
+}
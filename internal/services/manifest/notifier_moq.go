@@ -0,0 +1,66 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package manifest
+
+import (
+	"sync"
+)
+
+// Ensure, that NotifierMock does implement Notifier.
+// If this is not the case, regenerate this file with moq.
+var _ Notifier = &NotifierMock{}
+
+// NotifierMock is a mock implementation of Notifier.
+//
+// 	func TestSomethingThatUsesNotifier(t *testing.T) {
+//
+// 		// make and configure a mocked Notifier
+// 		mockedNotifier := &NotifierMock{
+// 			NotifyAllFunc: func()  {
+// 				panic("mock out the NotifyAll method")
+// 			},
+// 		}
+//
+// 		// use mockedNotifier in code that requires Notifier
+// 		// and then make assertions.
+//
+// 	}
+type NotifierMock struct {
+	// NotifyAllFunc mocks the NotifyAll method.
+	NotifyAllFunc func()
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// NotifyAll holds details about calls to the NotifyAll method.
+		NotifyAll []struct {
+		}
+	}
+	lockNotifyAll sync.RWMutex
+}
+
+// NotifyAll calls NotifyAllFunc.
+func (mock *NotifierMock) NotifyAll() {
+	if mock.NotifyAllFunc == nil {
+		panic("NotifierMock.NotifyAllFunc: method is nil but Notifier.NotifyAll was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockNotifyAll.Lock()
+	mock.calls.NotifyAll = append(mock.calls.NotifyAll, callInfo)
+	mock.lockNotifyAll.Unlock()
+	mock.NotifyAllFunc()
+}
+
+// NotifyAllCalls gets all the calls that were made to NotifyAll.
+// Check the length with:
+//     len(mockedNotifier.NotifyAllCalls())
+func (mock *NotifierMock) NotifyAllCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockNotifyAll.RLock()
+	calls = mock.calls.NotifyAll
+	mock.lockNotifyAll.RUnlock()
+	return calls
+}
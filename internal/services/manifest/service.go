@@ -13,13 +13,15 @@ type Service struct {
 	manifestReaderWriter ManifestReaderWriter
 	deviceReader         DeviceReader
 	gitReader            GitReader
+	notifier             Notifier
 }
 
-func New(deviceReader DeviceReader, rw ManifestReaderWriter, git GitReader) *Service {
+func New(deviceReader DeviceReader, rw ManifestReaderWriter, git GitReader, notifier Notifier) *Service {
 	return &Service{
 		deviceReader:         deviceReader,
 		gitReader:            git,
 		manifestReaderWriter: rw,
+		notifier:             notifier,
 	}
 }
 
@@ -31,48 +33,156 @@ func (w *Service) GetManifest(ctx context.Context, id string) (entity.Manifest,
 	return nil, nil
 }
 
-func (w *Service) UpdateManifests(ctx context.Context, repo entity.Repository) error {
+// SelectManifests returns the manifests whose label selector matches the labels of the device.
+// Label selectors are not stored as relations so a change of the device's labels is taken into account right away.
+func (w *Service) SelectManifests(ctx context.Context, device entity.Device) ([]entity.Manifest, error) {
+	return w.manifestReaderWriter.GetManifestsByLabels(ctx, device.Labels)
+}
+
+// UpdateManifests synchronizes the manifests of the repository with the manifest files found in its clone.
+// It returns the number of manifests created, updated and deleted with the files which have been refused.
+func (w *Service) UpdateManifests(ctx context.Context, repo entity.Repository) (entity.ManifestChanges, error) {
 	pgManifests, err := w.manifestReaderWriter.GetManifests(ctx, repo, func(m entity.Manifest) bool { return true })
 	if err != nil {
-		return fmt.Errorf("unable to read manifests of repo %q: %w", repo.Id, err)
+		return entity.ManifestChanges{}, fmt.Errorf("unable to read manifests of repo %q: %w", repo.Id, err)
+	}
+
+	gitManifests, fileErrors, err := w.gitReader.GetManifests(ctx, repo, func(m entity.Manifest) bool { return true })
+	if err != nil {
+		return entity.ManifestChanges{}, fmt.Errorf("unable to read manifest from repo %q: %w", repo.Id, err)
 	}
 
-	gitManifests, err := w.gitReader.GetManifests(ctx, repo, func(m entity.Manifest) bool { return true })
+	renamed, err := w.migrateManifestIDs(ctx, repo, pgManifests, gitManifests)
 	if err != nil {
-		return fmt.Errorf("unable to read manifest from repo %q: %w", repo.Id, err)
+		return entity.ManifestChanges{}, err
+	}
+
+	if renamed {
+		pgManifests, err = w.manifestReaderWriter.GetManifests(ctx, repo, func(m entity.Manifest) bool { return true })
+		if err != nil {
+			return entity.ManifestChanges{}, fmt.Errorf("unable to read manifests of repo %q: %w", repo.Id, err)
+		}
 	}
 
 	created := substract(gitManifests, pgManifests, func(m entity.Manifest) string { return m.GetID() })
 	deleted := substract(pgManifests, gitManifests, func(m entity.Manifest) string { return m.GetID() })
-	updated := intersect(gitManifests, pgManifests, func(m entity.Manifest) string { return m.GetID() }, func(m1, m2 entity.Manifest) bool { return m1.GetHash() == m1.GetHash() })
+	updated := intersect(gitManifests, pgManifests, func(m entity.Manifest) string { return m.GetID() }, changed)
 
 	for _, c := range created {
 		if err := w.manifestReaderWriter.InsertManifest(ctx, c); err != nil && !errService.IsResourceAlreadyExists(err) {
-			return fmt.Errorf("unable to insert manifest %q: %w", c.GetID(), err)
+			return entity.ManifestChanges{}, fmt.Errorf("unable to insert manifest %q: %w", c.GetID(), err)
 		}
 		if err := w.updateWorkloadRelations(ctx, c); err != nil {
-			return err
+			return entity.ManifestChanges{}, err
 		}
 	}
 
 	for _, d := range deleted {
 		if err := w.manifestReaderWriter.DeleteManifest(ctx, d.GetID()); err != nil {
-			return fmt.Errorf("unable to delete manifest %q: %w", d.GetID(), err)
+			return entity.ManifestChanges{}, fmt.Errorf("unable to delete manifest %q: %w", d.GetID(), err)
 		}
 	}
 
 	for _, u := range updated {
 		if err := w.updateWorkloadRelations(ctx, u); err != nil {
-			return err
+			return entity.ManifestChanges{}, err
 		}
 		if err := w.manifestReaderWriter.UpdateManifest(ctx, u); err != nil {
-			return fmt.Errorf("unable to update manifest %q: %w", u.GetID(), err)
+			return entity.ManifestChanges{}, fmt.Errorf("unable to update manifest %q: %w", u.GetID(), err)
 		}
 	}
 
+	// manifests can target any namespace, set or device so let every watcher check its configuration
+	if len(created)+len(deleted)+len(updated) > 0 {
+		w.notifier.NotifyAll()
+	}
+
+	return entity.ManifestChanges{
+		Created:    len(created),
+		Updated:    len(updated),
+		Deleted:    len(deleted),
+		FileErrors: fileErrors,
+	}, nil
+}
+
+// DeleteManifests removes the manifests of the repository with their relations and notifies the watchers so the
+// workloads are removed from the devices. Unless force is set, nothing is removed if one of the manifests still
+// targets devices.
+func (w *Service) DeleteManifests(ctx context.Context, repo entity.Repository, force bool) error {
+	manifests, err := w.manifestReaderWriter.GetManifests(ctx, repo, func(m entity.Manifest) bool { return true })
+	if err != nil {
+		return fmt.Errorf("unable to read manifests of repo %q: %w", repo.Id, err)
+	}
+
+	if !force {
+		for _, m := range manifests {
+			if isDeployed(m) {
+				return errService.NewDeleteResourceError("repository", repo.Id, fmt.Sprintf("manifest %q is still deployed to devices", m.GetID()))
+			}
+		}
+	}
+
+	for _, m := range manifests {
+		if err := w.manifestReaderWriter.DeleteManifest(ctx, m.GetID()); err != nil {
+			return fmt.Errorf("unable to delete manifest %q: %w", m.GetID(), err)
+		}
+		zap.S().Infow("manifest deleted", "repo_id", repo.Id, "manifest_id", m.GetID())
+	}
+
+	if len(manifests) > 0 {
+		w.notifier.NotifyAll()
+	}
+
 	return nil
 }
 
+// isDeployed returns true if the manifest targets namespaces, sets, devices or devices selected by their labels.
+func isDeployed(m entity.Manifest) bool {
+	return len(m.GetNamespaces())+len(m.GetSets())+len(m.GetDevices()) > 0 || !m.GetLabelSelector().IsEmpty()
+}
+
+// migrateManifestIDs renames the stored manifests whose id was computed from the path of the manifest file to the id
+// computed from the repository and the name of the manifest. The relations of the manifests are kept.
+// It returns true if at least one manifest has been renamed.
+func (w *Service) migrateManifestIDs(ctx context.Context, repo entity.Repository, pgManifests, gitManifests []entity.Manifest) (bool, error) {
+	stored := make(map[string]struct{}, len(pgManifests))
+	for _, m := range pgManifests {
+		stored[m.GetID()] = struct{}{}
+	}
+
+	byPath := make(map[string]entity.Manifest, len(gitManifests))
+	for _, m := range gitManifests {
+		byPath[manifestPath(m)] = m
+	}
+
+	renamed := false
+	for _, m := range pgManifests {
+		if contains(gitManifests, m.GetID()) {
+			continue
+		}
+
+		path, found := storedManifestPath(repo.Id, manifestPath(m), byPath)
+		if !found {
+			continue
+		}
+		gitManifest := byPath[path]
+
+		if _, exists := stored[gitManifest.GetID()]; exists {
+			continue
+		}
+
+		if err := w.manifestReaderWriter.RenameManifest(ctx, m.GetID(), gitManifest.GetID()); err != nil {
+			return false, fmt.Errorf("unable to rename manifest %q to %q: %w", m.GetID(), gitManifest.GetID(), err)
+		}
+		zap.S().Infow("manifest renamed", "repo_id", repo.Id, "old_manifest_id", m.GetID(), "manifest_id", gitManifest.GetID(), "path", path)
+
+		stored[gitManifest.GetID()] = struct{}{}
+		renamed = true
+	}
+
+	return renamed, nil
+}
+
 func (w *Service) updateWorkloadRelations(ctx context.Context, gitManifest entity.Manifest) error {
 	// get the old pgManifest
 	pgManifest, err := w.manifestReaderWriter.GetManifest(ctx, gitManifest.GetID())
@@ -1,6 +1,11 @@
 package manifest
 
-import "github.com/tupyy/tinyedge-controller/internal/entity"
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
 
 // substract return all elements of a which are not found in b
 func substract[T any, S func(elem T) string](a []T, b []T, idFn S) []T {
@@ -77,3 +82,44 @@ func contains(arr []entity.Manifest, id string) bool {
 	}
 	return false
 }
+
+// manifestPath returns the path of the manifest file.
+func manifestPath(m entity.Manifest) string {
+	switch v := m.(type) {
+	case entity.ManifestV1:
+		return v.Path
+	case entity.Configuration:
+		return v.Path
+	default:
+		return ""
+	}
+}
+
+// changed returns true if the manifest read from the repository differs from the stored one.
+// The path is not part of the hash so a moved manifest file changes only the path.
+func changed(m1, m2 entity.Manifest) bool {
+	return m1.GetHash() != m2.GetHash() || manifestPath(m1) != manifestPath(m2)
+}
+
+// storedManifestPath returns the path relative to the root of the repository of a stored manifest if a manifest file is
+// found at this path. Old manifests are stored with the absolute path of their file in the clone, that is
+// <local storage>/<repo id>/<path>. The local storage may have been moved since so only the part after the repo id is kept.
+func storedManifestPath(repoID, path string, byPath map[string]entity.Manifest) (string, bool) {
+	if !filepath.IsAbs(path) {
+		_, found := byPath[path]
+		return path, found
+	}
+
+	// the repo id can also be the name of a directory of the local storage or of the repository so every match is tried.
+	segment := string(filepath.Separator) + repoID + string(filepath.Separator)
+	for rest := path; ; {
+		i := strings.Index(rest, segment)
+		if i < 0 {
+			return "", false
+		}
+		rest = rest[i+len(segment)-1:]
+		if _, found := byPath[rest[1:]]; found {
+			return rest[1:], true
+		}
+	}
+}
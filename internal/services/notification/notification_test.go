@@ -0,0 +1,54 @@
+package notification_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/services/notification"
+)
+
+var _ = Describe("Notification", func() {
+	It("notifies only the subscribers of the device", func() {
+		service := notification.New()
+		first, cancelFirst := service.Subscribe("first")
+		defer cancelFirst()
+		second, cancelSecond := service.Subscribe("second")
+		defer cancelSecond()
+
+		service.NotifyDevices("first")
+		Expect(first).To(Receive())
+		Expect(second).ToNot(Receive())
+	})
+
+	It("notifies all the subscribers", func() {
+		service := notification.New()
+		first, cancelFirst := service.Subscribe("first")
+		defer cancelFirst()
+		second, cancelSecond := service.Subscribe("second")
+		defer cancelSecond()
+
+		service.NotifyAll()
+		Expect(first).To(Receive())
+		Expect(second).To(Receive())
+	})
+
+	It("coalesces the notifications", func() {
+		service := notification.New()
+		ch, cancel := service.Subscribe("first")
+		defer cancel()
+
+		service.NotifyDevices("first")
+		service.NotifyDevices("first")
+		Expect(ch).To(Receive())
+		Expect(ch).ToNot(Receive())
+	})
+
+	It("does not notify after unsubscribe", func() {
+		service := notification.New()
+		ch, cancel := service.Subscribe("first")
+		cancel()
+
+		service.NotifyAll()
+		Expect(ch).ToNot(Receive())
+	})
+})
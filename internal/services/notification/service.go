@@ -0,0 +1,77 @@
+package notification
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Service is an in-memory bus which notifies the subscribers when the configuration of a device might have changed.
+// Notifications are coalesced: a subscriber which has not consumed the previous notification will receive only one.
+type Service struct {
+	lock        sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+func New() *Service {
+	return &Service{
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe returns a channel which receives a notification each time the configuration of the device might have changed.
+// The returned function must be called to unsubscribe.
+func (s *Service) Subscribe(deviceID string) (<-chan struct{}, func()) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ch := make(chan struct{}, 1)
+	if _, ok := s.subscribers[deviceID]; !ok {
+		s.subscribers[deviceID] = make(map[chan struct{}]struct{})
+	}
+	s.subscribers[deviceID][ch] = struct{}{}
+
+	zap.S().Debugw("subscriber added", "device_id", deviceID)
+
+	return ch, func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		delete(s.subscribers[deviceID], ch)
+		if len(s.subscribers[deviceID]) == 0 {
+			delete(s.subscribers, deviceID)
+		}
+		zap.S().Debugw("subscriber removed", "device_id", deviceID)
+	}
+}
+
+// NotifyDevices notifies the subscribers of the devices.
+func (s *Service) NotifyDevices(deviceIDs ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, id := range deviceIDs {
+		for ch := range s.subscribers[id] {
+			notify(ch)
+		}
+	}
+}
+
+// NotifyAll notifies all the subscribers.
+func (s *Service) NotifyAll() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, channels := range s.subscribers {
+		for ch := range channels {
+			notify(ch)
+		}
+	}
+}
+
+func notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,393 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repository
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that GitReaderWriterMock does implement GitReaderWriter.
+// If this is not the case, regenerate this file with moq.
+var _ GitReaderWriter = &GitReaderWriterMock{}
+
+// GitReaderWriterMock is a mock implementation of GitReaderWriter.
+//
+// 	func TestSomethingThatUsesGitReaderWriter(t *testing.T) {
+//
+// 		// make and configure a mocked GitReaderWriter
+// 		mockedGitReaderWriter := &GitReaderWriterMock{
+// 			CheckoutFunc: func(ctx context.Context, r entity.Repository, sha string) error {
+// 				panic("mock out the Checkout method")
+// 			},
+// 			CloneFunc: func(ctx context.Context, remoteRepo entity.Repository) (entity.Repository, error) {
+// 				panic("mock out the Clone method")
+// 			},
+// 			DeleteFunc: func(ctx context.Context, r entity.Repository) error {
+// 				panic("mock out the Delete method")
+// 			},
+// 			GetHeadShaFunc: func(ctx context.Context, r entity.Repository) (string, error) {
+// 				panic("mock out the GetHeadSha method")
+// 			},
+// 			OpenFunc: func(ctx context.Context, r entity.Repository) (entity.Repository, error) {
+// 				panic("mock out the Open method")
+// 			},
+// 			PullFunc: func(ctx context.Context, r entity.Repository) error {
+// 				panic("mock out the Pull method")
+// 			},
+// 			VerifyCommitSignatureFunc: func(ctx context.Context, r entity.Repository, sha string, keys entity.SigningKeys) error {
+// 				panic("mock out the VerifyCommitSignature method")
+// 			},
+// 		}
+//
+// 		// use mockedGitReaderWriter in code that requires GitReaderWriter
+// 		// and then make assertions.
+//
+// 	}
+type GitReaderWriterMock struct {
+	// CheckoutFunc mocks the Checkout method.
+	CheckoutFunc func(ctx context.Context, r entity.Repository, sha string) error
+
+	// CloneFunc mocks the Clone method.
+	CloneFunc func(ctx context.Context, remoteRepo entity.Repository) (entity.Repository, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, r entity.Repository) error
+
+	// GetHeadShaFunc mocks the GetHeadSha method.
+	GetHeadShaFunc func(ctx context.Context, r entity.Repository) (string, error)
+
+	// OpenFunc mocks the Open method.
+	OpenFunc func(ctx context.Context, r entity.Repository) (entity.Repository, error)
+
+	// PullFunc mocks the Pull method.
+	PullFunc func(ctx context.Context, r entity.Repository) error
+
+	// VerifyCommitSignatureFunc mocks the VerifyCommitSignature method.
+	VerifyCommitSignatureFunc func(ctx context.Context, r entity.Repository, sha string, keys entity.SigningKeys) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Checkout holds details about calls to the Checkout method.
+		Checkout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+			// Sha is the sha argument value.
+			Sha string
+		}
+		// Clone holds details about calls to the Clone method.
+		Clone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RemoteRepo is the remoteRepo argument value.
+			RemoteRepo entity.Repository
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+		}
+		// GetHeadSha holds details about calls to the GetHeadSha method.
+		GetHeadSha []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+		}
+		// Open holds details about calls to the Open method.
+		Open []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+		}
+		// Pull holds details about calls to the Pull method.
+		Pull []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+		}
+		// VerifyCommitSignature holds details about calls to the VerifyCommitSignature method.
+		VerifyCommitSignature []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+			// Sha is the sha argument value.
+			Sha string
+			// Keys is the keys argument value.
+			Keys entity.SigningKeys
+		}
+	}
+	lockCheckout              sync.RWMutex
+	lockClone                 sync.RWMutex
+	lockDelete                sync.RWMutex
+	lockGetHeadSha            sync.RWMutex
+	lockOpen                  sync.RWMutex
+	lockPull                  sync.RWMutex
+	lockVerifyCommitSignature sync.RWMutex
+}
+
+// Checkout calls CheckoutFunc.
+func (mock *GitReaderWriterMock) Checkout(ctx context.Context, r entity.Repository, sha string) error {
+	if mock.CheckoutFunc == nil {
+		panic("GitReaderWriterMock.CheckoutFunc: method is nil but GitReaderWriter.Checkout was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+		Sha string
+	}{
+		Ctx: ctx,
+		R:   r,
+		Sha: sha,
+	}
+	mock.lockCheckout.Lock()
+	mock.calls.Checkout = append(mock.calls.Checkout, callInfo)
+	mock.lockCheckout.Unlock()
+	return mock.CheckoutFunc(ctx, r, sha)
+}
+
+// CheckoutCalls gets all the calls that were made to Checkout.
+// Check the length with:
+//     len(mockedGitReaderWriter.CheckoutCalls())
+func (mock *GitReaderWriterMock) CheckoutCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+	Sha string
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+		Sha string
+	}
+	mock.lockCheckout.RLock()
+	calls = mock.calls.Checkout
+	mock.lockCheckout.RUnlock()
+	return calls
+}
+
+// Clone calls CloneFunc.
+func (mock *GitReaderWriterMock) Clone(ctx context.Context, remoteRepo entity.Repository) (entity.Repository, error) {
+	if mock.CloneFunc == nil {
+		panic("GitReaderWriterMock.CloneFunc: method is nil but GitReaderWriter.Clone was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		RemoteRepo entity.Repository
+	}{
+		Ctx:        ctx,
+		RemoteRepo: remoteRepo,
+	}
+	mock.lockClone.Lock()
+	mock.calls.Clone = append(mock.calls.Clone, callInfo)
+	mock.lockClone.Unlock()
+	return mock.CloneFunc(ctx, remoteRepo)
+}
+
+// CloneCalls gets all the calls that were made to Clone.
+// Check the length with:
+//     len(mockedGitReaderWriter.CloneCalls())
+func (mock *GitReaderWriterMock) CloneCalls() []struct {
+	Ctx        context.Context
+	RemoteRepo entity.Repository
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RemoteRepo entity.Repository
+	}
+	mock.lockClone.RLock()
+	calls = mock.calls.Clone
+	mock.lockClone.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *GitReaderWriterMock) Delete(ctx context.Context, r entity.Repository) error {
+	if mock.DeleteFunc == nil {
+		panic("GitReaderWriterMock.DeleteFunc: method is nil but GitReaderWriter.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, r)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//     len(mockedGitReaderWriter.DeleteCalls())
+func (mock *GitReaderWriterMock) DeleteCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// GetHeadSha calls GetHeadShaFunc.
+func (mock *GitReaderWriterMock) GetHeadSha(ctx context.Context, r entity.Repository) (string, error) {
+	if mock.GetHeadShaFunc == nil {
+		panic("GitReaderWriterMock.GetHeadShaFunc: method is nil but GitReaderWriter.GetHeadSha was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockGetHeadSha.Lock()
+	mock.calls.GetHeadSha = append(mock.calls.GetHeadSha, callInfo)
+	mock.lockGetHeadSha.Unlock()
+	return mock.GetHeadShaFunc(ctx, r)
+}
+
+// GetHeadShaCalls gets all the calls that were made to GetHeadSha.
+// Check the length with:
+//     len(mockedGitReaderWriter.GetHeadShaCalls())
+func (mock *GitReaderWriterMock) GetHeadShaCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+	}
+	mock.lockGetHeadSha.RLock()
+	calls = mock.calls.GetHeadSha
+	mock.lockGetHeadSha.RUnlock()
+	return calls
+}
+
+// Open calls OpenFunc.
+func (mock *GitReaderWriterMock) Open(ctx context.Context, r entity.Repository) (entity.Repository, error) {
+	if mock.OpenFunc == nil {
+		panic("GitReaderWriterMock.OpenFunc: method is nil but GitReaderWriter.Open was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockOpen.Lock()
+	mock.calls.Open = append(mock.calls.Open, callInfo)
+	mock.lockOpen.Unlock()
+	return mock.OpenFunc(ctx, r)
+}
+
+// OpenCalls gets all the calls that were made to Open.
+// Check the length with:
+//     len(mockedGitReaderWriter.OpenCalls())
+func (mock *GitReaderWriterMock) OpenCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+	}
+	mock.lockOpen.RLock()
+	calls = mock.calls.Open
+	mock.lockOpen.RUnlock()
+	return calls
+}
+
+// Pull calls PullFunc.
+func (mock *GitReaderWriterMock) Pull(ctx context.Context, r entity.Repository) error {
+	if mock.PullFunc == nil {
+		panic("GitReaderWriterMock.PullFunc: method is nil but GitReaderWriter.Pull was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockPull.Lock()
+	mock.calls.Pull = append(mock.calls.Pull, callInfo)
+	mock.lockPull.Unlock()
+	return mock.PullFunc(ctx, r)
+}
+
+// PullCalls gets all the calls that were made to Pull.
+// Check the length with:
+//     len(mockedGitReaderWriter.PullCalls())
+func (mock *GitReaderWriterMock) PullCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+	}
+	mock.lockPull.RLock()
+	calls = mock.calls.Pull
+	mock.lockPull.RUnlock()
+	return calls
+}
+
+// VerifyCommitSignature calls VerifyCommitSignatureFunc.
+func (mock *GitReaderWriterMock) VerifyCommitSignature(ctx context.Context, r entity.Repository, sha string, keys entity.SigningKeys) error {
+	if mock.VerifyCommitSignatureFunc == nil {
+		panic("GitReaderWriterMock.VerifyCommitSignatureFunc: method is nil but GitReaderWriter.VerifyCommitSignature was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		R    entity.Repository
+		Sha  string
+		Keys entity.SigningKeys
+	}{
+		Ctx:  ctx,
+		R:    r,
+		Sha:  sha,
+		Keys: keys,
+	}
+	mock.lockVerifyCommitSignature.Lock()
+	mock.calls.VerifyCommitSignature = append(mock.calls.VerifyCommitSignature, callInfo)
+	mock.lockVerifyCommitSignature.Unlock()
+	return mock.VerifyCommitSignatureFunc(ctx, r, sha, keys)
+}
+
+// VerifyCommitSignatureCalls gets all the calls that were made to VerifyCommitSignature.
+// Check the length with:
+//     len(mockedGitReaderWriter.VerifyCommitSignatureCalls())
+func (mock *GitReaderWriterMock) VerifyCommitSignatureCalls() []struct {
+	Ctx  context.Context
+	R    entity.Repository
+	Sha  string
+	Keys entity.SigningKeys
+} {
+	var calls []struct {
+		Ctx  context.Context
+		R    entity.Repository
+		Sha  string
+		Keys entity.SigningKeys
+	}
+	mock.lockVerifyCommitSignature.RLock()
+	calls = mock.calls.VerifyCommitSignature
+	mock.lockVerifyCommitSignature.RUnlock()
+	return calls
+}
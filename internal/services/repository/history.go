@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+// RecordSync saves the run of the synchronization of a repository. Only the last runs of each repository are kept.
+func (w *Service) RecordSync(ctx context.Context, run entity.SyncRun) error {
+	if run.ID == "" {
+		run.ID = uuid.NewV4().String()
+	}
+	return w.repoReaderWriter.InsertSyncRun(ctx, run)
+}
+
+// GetSyncHistory returns the last sync runs of the repository starting with the most recent one.
+// It returns a ResourceNotFoundError if the repository does not exist.
+func (w *Service) GetSyncHistory(ctx context.Context, id string) ([]entity.SyncRun, error) {
+	if _, err := w.repoReaderWriter.GetRepository(ctx, id); err != nil {
+		return []entity.SyncRun{}, err
+	}
+	return w.repoReaderWriter.GetSyncRuns(ctx, id)
+}
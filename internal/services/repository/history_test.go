@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+)
+
+var _ = Describe("Repository sync history", func() {
+	var (
+		runs             []entity.SyncRun
+		repoReaderWriter *repository.RepositoryReaderWriterMock
+		service          *repository.Service
+	)
+
+	BeforeEach(func() {
+		runs = []entity.SyncRun{}
+		repoReaderWriter = &repository.RepositoryReaderWriterMock{
+			GetRepositoryFunc: func(ctx context.Context, id string) (entity.Repository, error) {
+				if id != "repo" {
+					return entity.Repository{}, errService.NewResourceNotFoundError("repository", id)
+				}
+				return entity.Repository{Id: id}, nil
+			},
+			InsertSyncRunFunc: func(ctx context.Context, run entity.SyncRun) error {
+				runs = append([]entity.SyncRun{run}, runs...)
+				return nil
+			},
+			GetSyncRunsFunc: func(ctx context.Context, repoID string) ([]entity.SyncRun, error) {
+				return runs, nil
+			},
+		}
+		service = repository.NewRepositoryService(repoReaderWriter, &repository.GitReaderWriterMock{}, &repository.SecretReaderMock{})
+	})
+
+	It("records the sync runs with an id", func() {
+		run := entity.NewSyncRun(entity.Repository{Id: "repo", CurrentHeadSha: "current"})
+		run.ToSha = "target"
+		run.Manifests = entity.ManifestChanges{Created: 1, FileErrors: []entity.ManifestFileError{{Path: "broken.manifest.yaml", Reason: "invalid yaml"}}}
+		run.Finish(nil)
+
+		err := service.RecordSync(context.TODO(), run)
+		Expect(err).To(BeNil())
+
+		history, err := service.GetSyncHistory(context.TODO(), "repo")
+		Expect(err).To(BeNil())
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].ID).ToNot(BeEmpty())
+		Expect(history[0].RepositoryID).To(Equal("repo"))
+		Expect(history[0].FromSha).To(Equal("current"))
+		Expect(history[0].Error).To(BeEmpty())
+		Expect(history[0].Manifests.FileErrors).To(HaveLen(1))
+	})
+
+	It("fails to return the history of an unknown repository", func() {
+		_, err := service.GetSyncHistory(context.TODO(), "unknown")
+		Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		Expect(repoReaderWriter.GetSyncRunsCalls()).To(BeEmpty())
+	})
+})
@@ -2,19 +2,30 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 )
 
 type RepositoryReader interface {
+	GetRepository(ctx context.Context, id string) (entity.Repository, error)
 	GetRepositories(ctx context.Context) ([]entity.Repository, error)
+	GetSyncRuns(ctx context.Context, repoID string) ([]entity.SyncRun, error)
 }
 
 type RepositoryWriter interface {
 	InsertRepository(ctx context.Context, r entity.Repository) error
 	UpdateRepository(ctx context.Context, r entity.Repository) error
+	UpdateSettings(ctx context.Context, r entity.Repository, reset entity.RepositoryReset) error
+	UpdateRef(ctx context.Context, r entity.Repository) error
+	UpdateSyncState(ctx context.Context, r entity.Repository) error
+	UpdatePullPeriod(ctx context.Context, id string, period time.Duration, next time.Time) error
+	SetNextSync(ctx context.Context, id string, previous, next time.Time) error
+	DeleteRepository(ctx context.Context, id string) error
+	InsertSyncRun(ctx context.Context, run entity.SyncRun) error
 }
 
+//go:generate moq -out repository_rw_moq.go . RepositoryReaderWriter
 type RepositoryReaderWriter interface {
 	RepositoryReader
 	RepositoryWriter
@@ -24,17 +35,24 @@ type GitReader interface {
 	Open(ctx context.Context, r entity.Repository) (entity.Repository, error)
 	Pull(ctx context.Context, r entity.Repository) error
 	GetHeadSha(ctx context.Context, r entity.Repository) (string, error)
+	Checkout(ctx context.Context, r entity.Repository, sha string) error
+	VerifyCommitSignature(ctx context.Context, r entity.Repository, sha string, keys entity.SigningKeys) error
 }
 
 type GitWriter interface {
 	Clone(ctx context.Context, remoteRepo entity.Repository) (entity.Repository, error)
+	Delete(ctx context.Context, r entity.Repository) error
 }
 
+//go:generate moq -out git_rw_moq.go . GitReaderWriter
 type GitReaderWriter interface {
 	GitReader
 	GitWriter
 }
 
+//go:generate moq -out secret_reader_moq.go . SecretReader
 type SecretReader interface {
+	GetSecret(ctx context.Context, path, key string) (entity.Secret, error)
+	GetSigningKeys(ctx context.Context, path string) (entity.SigningKeys, error)
 	GetCredentialsFunc(ctx context.Context, authType entity.RepositoryAuthType, secretPath string) entity.CredentialsFunc
 }
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+)
+
+var commitShaRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// UpdateRef changes the git reference followed by the repository. Only one of branch, tag pattern or commit must be set.
+// The repository is pulled right away unless it is paused.
+func (w *Service) UpdateRef(ctx context.Context, id, branch, tagPattern, commit string) (entity.Repository, error) {
+	if branch == "" && tagPattern == "" && commit == "" {
+		return entity.Repository{}, errService.NewInvalidRepositoryRefError(id, "a branch, a tag pattern or a commit is required")
+	}
+
+	repo, err := w.repoReaderWriter.GetRepository(ctx, id)
+	if err != nil {
+		return entity.Repository{}, err
+	}
+
+	repo.Branch = branch
+	repo.TagPattern = tagPattern
+	repo.Commit = commit
+	if err := validateRef(repo); err != nil {
+		return entity.Repository{}, err
+	}
+
+	// the heads found for the previous reference are dropped. The current head is kept because it is still deployed.
+	repo.TargetHeadSha = ""
+	repo.RejectedHeadSha = ""
+	repo.RejectionReason = ""
+	repo.NextSyncAt = time.Time{}
+	if !repo.IsPaused() {
+		repo.NextSyncAt = time.Now()
+	}
+
+	if err := w.repoReaderWriter.UpdateRef(ctx, repo); err != nil {
+		return entity.Repository{}, err
+	}
+
+	return repo, nil
+}
+
+// validateRef checks that at most one reference is set and that it is well formed.
+// A repository without reference follows the default branch of the remote.
+func validateRef(r entity.Repository) error {
+	count := 0
+	for _, ref := range []string{r.Branch, r.TagPattern, r.Commit} {
+		if ref != "" {
+			count++
+		}
+	}
+	if count > 1 {
+		return errService.NewInvalidRepositoryRefError(r.Id, "only one of branch, tag pattern or commit can be set")
+	}
+
+	if r.TagPattern != "" {
+		if _, err := path.Match(r.TagPattern, ""); err != nil {
+			return errService.NewInvalidRepositoryRefError(r.Id, "tag pattern is not a valid glob pattern")
+		}
+	}
+
+	if r.Commit != "" && !commitShaRegexp.MatchString(r.Commit) {
+		return errService.NewInvalidRepositoryRefError(r.Id, "commit must be a full sha")
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package repository_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+)
+
+var _ = Describe("Git reference", func() {
+	const sha = "0123456789abcdef0123456789abcdef01234567"
+
+	var (
+		repo             entity.Repository
+		repoReaderWriter *repository.RepositoryReaderWriterMock
+		service          *repository.Service
+	)
+
+	BeforeEach(func() {
+		repo = entity.Repository{
+			Id:         "repo",
+			Branch:     "main",
+			PullPeriod: time.Minute,
+			NextSyncAt: time.Now().Add(time.Minute),
+		}
+		repoReaderWriter = &repository.RepositoryReaderWriterMock{
+			GetRepositoryFunc: func(ctx context.Context, id string) (entity.Repository, error) {
+				return repo, nil
+			},
+			UpdateRefFunc: func(ctx context.Context, r entity.Repository) error {
+				repo = r
+				return nil
+			},
+			InsertRepositoryFunc: func(ctx context.Context, r entity.Repository) error {
+				return nil
+			},
+		}
+		service = repository.NewRepositoryService(repoReaderWriter, &repository.GitReaderWriterMock{}, &repository.SecretReaderMock{})
+	})
+
+	It("replaces the branch by a tag pattern and syncs right away", func() {
+		r, err := service.UpdateRef(context.TODO(), "repo", "", "v*", "")
+		Expect(err).To(BeNil())
+		Expect(r.Branch).To(BeEmpty())
+		Expect(r.TagPattern).To(Equal("v*"))
+		Expect(repo.IsSyncDue(time.Now())).To(BeTrue())
+	})
+
+	It("pins the repository to a commit", func() {
+		r, err := service.UpdateRef(context.TODO(), "repo", "", "", sha)
+		Expect(err).To(BeNil())
+		Expect(r.Commit).To(Equal(sha))
+		Expect(r.Branch).To(BeEmpty())
+	})
+
+	It("drops the heads found for the previous reference", func() {
+		repo.CurrentHeadSha = "current"
+		repo.TargetHeadSha = "target"
+		repo.RejectedHeadSha = "target"
+		repo.RejectionReason = "unsigned"
+
+		r, err := service.UpdateRef(context.TODO(), "repo", "release", "", "")
+		Expect(err).To(BeNil())
+		Expect(r.CurrentHeadSha).To(Equal("current"))
+		Expect(r.TargetHeadSha).To(BeEmpty())
+		Expect(r.RejectedHeadSha).To(BeEmpty())
+		Expect(r.RejectionReason).To(BeEmpty())
+	})
+
+	DescribeTable("refuses invalid references",
+		func(branch, tagPattern, commit string) {
+			_, err := service.UpdateRef(context.TODO(), "repo", branch, tagPattern, commit)
+			Expect(errService.IsInvalidRepositoryRef(err)).To(BeTrue())
+			Expect(repoReaderWriter.UpdateRefCalls()).To(BeEmpty())
+		},
+		Entry("no reference", "", "", ""),
+		Entry("two references", "main", "v*", ""),
+		Entry("invalid pattern", "", "v[", ""),
+		Entry("short sha", "", "", sha[:7]),
+	)
+
+	It("refuses to add a repository with two references", func() {
+		err := service.Add(context.TODO(), entity.Repository{Id: "repo", Branch: "main", Commit: sha})
+		Expect(errService.IsInvalidRepositoryRef(err)).To(BeTrue())
+		Expect(repoReaderWriter.InsertRepositoryCalls()).To(BeEmpty())
+	})
+})
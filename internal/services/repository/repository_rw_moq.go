@@ -0,0 +1,645 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repository
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+	"time"
+)
+
+// Ensure, that RepositoryReaderWriterMock does implement RepositoryReaderWriter.
+// If this is not the case, regenerate this file with moq.
+var _ RepositoryReaderWriter = &RepositoryReaderWriterMock{}
+
+// RepositoryReaderWriterMock is a mock implementation of RepositoryReaderWriter.
+//
+// 	func TestSomethingThatUsesRepositoryReaderWriter(t *testing.T) {
+//
+// 		// make and configure a mocked RepositoryReaderWriter
+// 		mockedRepositoryReaderWriter := &RepositoryReaderWriterMock{
+// 			DeleteRepositoryFunc: func(ctx context.Context, id string) error {
+// 				panic("mock out the DeleteRepository method")
+// 			},
+// 			GetRepositoriesFunc: func(ctx context.Context) ([]entity.Repository, error) {
+// 				panic("mock out the GetRepositories method")
+// 			},
+// 			GetRepositoryFunc: func(ctx context.Context, id string) (entity.Repository, error) {
+// 				panic("mock out the GetRepository method")
+// 			},
+// 			GetSyncRunsFunc: func(ctx context.Context, repoID string) ([]entity.SyncRun, error) {
+// 				panic("mock out the GetSyncRuns method")
+// 			},
+// 			InsertRepositoryFunc: func(ctx context.Context, r entity.Repository) error {
+// 				panic("mock out the InsertRepository method")
+// 			},
+// 			InsertSyncRunFunc: func(ctx context.Context, run entity.SyncRun) error {
+// 				panic("mock out the InsertSyncRun method")
+// 			},
+// 			SetNextSyncFunc: func(ctx context.Context, id string, previous time.Time, next time.Time) error {
+// 				panic("mock out the SetNextSync method")
+// 			},
+// 			UpdatePullPeriodFunc: func(ctx context.Context, id string, period time.Duration, next time.Time) error {
+// 				panic("mock out the UpdatePullPeriod method")
+// 			},
+// 			UpdateRefFunc: func(ctx context.Context, r entity.Repository) error {
+// 				panic("mock out the UpdateRef method")
+// 			},
+// 			UpdateRepositoryFunc: func(ctx context.Context, r entity.Repository) error {
+// 				panic("mock out the UpdateRepository method")
+// 			},
+// 			UpdateSettingsFunc: func(ctx context.Context, r entity.Repository, reset entity.RepositoryReset) error {
+// 				panic("mock out the UpdateSettings method")
+// 			},
+// 			UpdateSyncStateFunc: func(ctx context.Context, r entity.Repository) error {
+// 				panic("mock out the UpdateSyncState method")
+// 			},
+// 		}
+//
+// 		// use mockedRepositoryReaderWriter in code that requires RepositoryReaderWriter
+// 		// and then make assertions.
+//
+// 	}
+type RepositoryReaderWriterMock struct {
+	// DeleteRepositoryFunc mocks the DeleteRepository method.
+	DeleteRepositoryFunc func(ctx context.Context, id string) error
+
+	// GetRepositoriesFunc mocks the GetRepositories method.
+	GetRepositoriesFunc func(ctx context.Context) ([]entity.Repository, error)
+
+	// GetRepositoryFunc mocks the GetRepository method.
+	GetRepositoryFunc func(ctx context.Context, id string) (entity.Repository, error)
+
+	// GetSyncRunsFunc mocks the GetSyncRuns method.
+	GetSyncRunsFunc func(ctx context.Context, repoID string) ([]entity.SyncRun, error)
+
+	// InsertRepositoryFunc mocks the InsertRepository method.
+	InsertRepositoryFunc func(ctx context.Context, r entity.Repository) error
+
+	// InsertSyncRunFunc mocks the InsertSyncRun method.
+	InsertSyncRunFunc func(ctx context.Context, run entity.SyncRun) error
+
+	// SetNextSyncFunc mocks the SetNextSync method.
+	SetNextSyncFunc func(ctx context.Context, id string, previous time.Time, next time.Time) error
+
+	// UpdatePullPeriodFunc mocks the UpdatePullPeriod method.
+	UpdatePullPeriodFunc func(ctx context.Context, id string, period time.Duration, next time.Time) error
+
+	// UpdateRefFunc mocks the UpdateRef method.
+	UpdateRefFunc func(ctx context.Context, r entity.Repository) error
+
+	// UpdateRepositoryFunc mocks the UpdateRepository method.
+	UpdateRepositoryFunc func(ctx context.Context, r entity.Repository) error
+
+	// UpdateSettingsFunc mocks the UpdateSettings method.
+	UpdateSettingsFunc func(ctx context.Context, r entity.Repository, reset entity.RepositoryReset) error
+
+	// UpdateSyncStateFunc mocks the UpdateSyncState method.
+	UpdateSyncStateFunc func(ctx context.Context, r entity.Repository) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteRepository holds details about calls to the DeleteRepository method.
+		DeleteRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetRepositories holds details about calls to the GetRepositories method.
+		GetRepositories []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetRepository holds details about calls to the GetRepository method.
+		GetRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetSyncRuns holds details about calls to the GetSyncRuns method.
+		GetSyncRuns []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoID is the repoID argument value.
+			RepoID string
+		}
+		// InsertRepository holds details about calls to the InsertRepository method.
+		InsertRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+		}
+		// InsertSyncRun holds details about calls to the InsertSyncRun method.
+		InsertSyncRun []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Run is the run argument value.
+			Run entity.SyncRun
+		}
+		// SetNextSync holds details about calls to the SetNextSync method.
+		SetNextSync []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Previous is the previous argument value.
+			Previous time.Time
+			// Next is the next argument value.
+			Next time.Time
+		}
+		// UpdatePullPeriod holds details about calls to the UpdatePullPeriod method.
+		UpdatePullPeriod []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Period is the period argument value.
+			Period time.Duration
+			// Next is the next argument value.
+			Next time.Time
+		}
+		// UpdateRef holds details about calls to the UpdateRef method.
+		UpdateRef []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+		}
+		// UpdateRepository holds details about calls to the UpdateRepository method.
+		UpdateRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+		}
+		// UpdateSettings holds details about calls to the UpdateSettings method.
+		UpdateSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+			// Reset is the reset argument value.
+			Reset entity.RepositoryReset
+		}
+		// UpdateSyncState holds details about calls to the UpdateSyncState method.
+		UpdateSyncState []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// R is the r argument value.
+			R entity.Repository
+		}
+	}
+	lockDeleteRepository sync.RWMutex
+	lockGetRepositories  sync.RWMutex
+	lockGetRepository    sync.RWMutex
+	lockGetSyncRuns      sync.RWMutex
+	lockInsertRepository sync.RWMutex
+	lockInsertSyncRun    sync.RWMutex
+	lockSetNextSync      sync.RWMutex
+	lockUpdatePullPeriod sync.RWMutex
+	lockUpdateRef        sync.RWMutex
+	lockUpdateRepository sync.RWMutex
+	lockUpdateSettings   sync.RWMutex
+	lockUpdateSyncState  sync.RWMutex
+}
+
+// DeleteRepository calls DeleteRepositoryFunc.
+func (mock *RepositoryReaderWriterMock) DeleteRepository(ctx context.Context, id string) error {
+	if mock.DeleteRepositoryFunc == nil {
+		panic("RepositoryReaderWriterMock.DeleteRepositoryFunc: method is nil but RepositoryReaderWriter.DeleteRepository was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteRepository.Lock()
+	mock.calls.DeleteRepository = append(mock.calls.DeleteRepository, callInfo)
+	mock.lockDeleteRepository.Unlock()
+	return mock.DeleteRepositoryFunc(ctx, id)
+}
+
+// DeleteRepositoryCalls gets all the calls that were made to DeleteRepository.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.DeleteRepositoryCalls())
+func (mock *RepositoryReaderWriterMock) DeleteRepositoryCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteRepository.RLock()
+	calls = mock.calls.DeleteRepository
+	mock.lockDeleteRepository.RUnlock()
+	return calls
+}
+
+// GetRepositories calls GetRepositoriesFunc.
+func (mock *RepositoryReaderWriterMock) GetRepositories(ctx context.Context) ([]entity.Repository, error) {
+	if mock.GetRepositoriesFunc == nil {
+		panic("RepositoryReaderWriterMock.GetRepositoriesFunc: method is nil but RepositoryReaderWriter.GetRepositories was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetRepositories.Lock()
+	mock.calls.GetRepositories = append(mock.calls.GetRepositories, callInfo)
+	mock.lockGetRepositories.Unlock()
+	return mock.GetRepositoriesFunc(ctx)
+}
+
+// GetRepositoriesCalls gets all the calls that were made to GetRepositories.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.GetRepositoriesCalls())
+func (mock *RepositoryReaderWriterMock) GetRepositoriesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetRepositories.RLock()
+	calls = mock.calls.GetRepositories
+	mock.lockGetRepositories.RUnlock()
+	return calls
+}
+
+// GetRepository calls GetRepositoryFunc.
+func (mock *RepositoryReaderWriterMock) GetRepository(ctx context.Context, id string) (entity.Repository, error) {
+	if mock.GetRepositoryFunc == nil {
+		panic("RepositoryReaderWriterMock.GetRepositoryFunc: method is nil but RepositoryReaderWriter.GetRepository was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetRepository.Lock()
+	mock.calls.GetRepository = append(mock.calls.GetRepository, callInfo)
+	mock.lockGetRepository.Unlock()
+	return mock.GetRepositoryFunc(ctx, id)
+}
+
+// GetRepositoryCalls gets all the calls that were made to GetRepository.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.GetRepositoryCalls())
+func (mock *RepositoryReaderWriterMock) GetRepositoryCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetRepository.RLock()
+	calls = mock.calls.GetRepository
+	mock.lockGetRepository.RUnlock()
+	return calls
+}
+
+// GetSyncRuns calls GetSyncRunsFunc.
+func (mock *RepositoryReaderWriterMock) GetSyncRuns(ctx context.Context, repoID string) ([]entity.SyncRun, error) {
+	if mock.GetSyncRunsFunc == nil {
+		panic("RepositoryReaderWriterMock.GetSyncRunsFunc: method is nil but RepositoryReaderWriter.GetSyncRuns was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		RepoID string
+	}{
+		Ctx:    ctx,
+		RepoID: repoID,
+	}
+	mock.lockGetSyncRuns.Lock()
+	mock.calls.GetSyncRuns = append(mock.calls.GetSyncRuns, callInfo)
+	mock.lockGetSyncRuns.Unlock()
+	return mock.GetSyncRunsFunc(ctx, repoID)
+}
+
+// GetSyncRunsCalls gets all the calls that were made to GetSyncRuns.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.GetSyncRunsCalls())
+func (mock *RepositoryReaderWriterMock) GetSyncRunsCalls() []struct {
+	Ctx    context.Context
+	RepoID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		RepoID string
+	}
+	mock.lockGetSyncRuns.RLock()
+	calls = mock.calls.GetSyncRuns
+	mock.lockGetSyncRuns.RUnlock()
+	return calls
+}
+
+// InsertRepository calls InsertRepositoryFunc.
+func (mock *RepositoryReaderWriterMock) InsertRepository(ctx context.Context, r entity.Repository) error {
+	if mock.InsertRepositoryFunc == nil {
+		panic("RepositoryReaderWriterMock.InsertRepositoryFunc: method is nil but RepositoryReaderWriter.InsertRepository was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockInsertRepository.Lock()
+	mock.calls.InsertRepository = append(mock.calls.InsertRepository, callInfo)
+	mock.lockInsertRepository.Unlock()
+	return mock.InsertRepositoryFunc(ctx, r)
+}
+
+// InsertRepositoryCalls gets all the calls that were made to InsertRepository.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.InsertRepositoryCalls())
+func (mock *RepositoryReaderWriterMock) InsertRepositoryCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+	}
+	mock.lockInsertRepository.RLock()
+	calls = mock.calls.InsertRepository
+	mock.lockInsertRepository.RUnlock()
+	return calls
+}
+
+// InsertSyncRun calls InsertSyncRunFunc.
+func (mock *RepositoryReaderWriterMock) InsertSyncRun(ctx context.Context, run entity.SyncRun) error {
+	if mock.InsertSyncRunFunc == nil {
+		panic("RepositoryReaderWriterMock.InsertSyncRunFunc: method is nil but RepositoryReaderWriter.InsertSyncRun was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Run entity.SyncRun
+	}{
+		Ctx: ctx,
+		Run: run,
+	}
+	mock.lockInsertSyncRun.Lock()
+	mock.calls.InsertSyncRun = append(mock.calls.InsertSyncRun, callInfo)
+	mock.lockInsertSyncRun.Unlock()
+	return mock.InsertSyncRunFunc(ctx, run)
+}
+
+// InsertSyncRunCalls gets all the calls that were made to InsertSyncRun.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.InsertSyncRunCalls())
+func (mock *RepositoryReaderWriterMock) InsertSyncRunCalls() []struct {
+	Ctx context.Context
+	Run entity.SyncRun
+} {
+	var calls []struct {
+		Ctx context.Context
+		Run entity.SyncRun
+	}
+	mock.lockInsertSyncRun.RLock()
+	calls = mock.calls.InsertSyncRun
+	mock.lockInsertSyncRun.RUnlock()
+	return calls
+}
+
+// SetNextSync calls SetNextSyncFunc.
+func (mock *RepositoryReaderWriterMock) SetNextSync(ctx context.Context, id string, previous time.Time, next time.Time) error {
+	if mock.SetNextSyncFunc == nil {
+		panic("RepositoryReaderWriterMock.SetNextSyncFunc: method is nil but RepositoryReaderWriter.SetNextSync was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ID       string
+		Previous time.Time
+		Next     time.Time
+	}{
+		Ctx:      ctx,
+		ID:       id,
+		Previous: previous,
+		Next:     next,
+	}
+	mock.lockSetNextSync.Lock()
+	mock.calls.SetNextSync = append(mock.calls.SetNextSync, callInfo)
+	mock.lockSetNextSync.Unlock()
+	return mock.SetNextSyncFunc(ctx, id, previous, next)
+}
+
+// SetNextSyncCalls gets all the calls that were made to SetNextSync.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.SetNextSyncCalls())
+func (mock *RepositoryReaderWriterMock) SetNextSyncCalls() []struct {
+	Ctx      context.Context
+	ID       string
+	Previous time.Time
+	Next     time.Time
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ID       string
+		Previous time.Time
+		Next     time.Time
+	}
+	mock.lockSetNextSync.RLock()
+	calls = mock.calls.SetNextSync
+	mock.lockSetNextSync.RUnlock()
+	return calls
+}
+
+// UpdatePullPeriod calls UpdatePullPeriodFunc.
+func (mock *RepositoryReaderWriterMock) UpdatePullPeriod(ctx context.Context, id string, period time.Duration, next time.Time) error {
+	if mock.UpdatePullPeriodFunc == nil {
+		panic("RepositoryReaderWriterMock.UpdatePullPeriodFunc: method is nil but RepositoryReaderWriter.UpdatePullPeriod was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     string
+		Period time.Duration
+		Next   time.Time
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Period: period,
+		Next:   next,
+	}
+	mock.lockUpdatePullPeriod.Lock()
+	mock.calls.UpdatePullPeriod = append(mock.calls.UpdatePullPeriod, callInfo)
+	mock.lockUpdatePullPeriod.Unlock()
+	return mock.UpdatePullPeriodFunc(ctx, id, period, next)
+}
+
+// UpdatePullPeriodCalls gets all the calls that were made to UpdatePullPeriod.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.UpdatePullPeriodCalls())
+func (mock *RepositoryReaderWriterMock) UpdatePullPeriodCalls() []struct {
+	Ctx    context.Context
+	ID     string
+	Period time.Duration
+	Next   time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     string
+		Period time.Duration
+		Next   time.Time
+	}
+	mock.lockUpdatePullPeriod.RLock()
+	calls = mock.calls.UpdatePullPeriod
+	mock.lockUpdatePullPeriod.RUnlock()
+	return calls
+}
+
+// UpdateRef calls UpdateRefFunc.
+func (mock *RepositoryReaderWriterMock) UpdateRef(ctx context.Context, r entity.Repository) error {
+	if mock.UpdateRefFunc == nil {
+		panic("RepositoryReaderWriterMock.UpdateRefFunc: method is nil but RepositoryReaderWriter.UpdateRef was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockUpdateRef.Lock()
+	mock.calls.UpdateRef = append(mock.calls.UpdateRef, callInfo)
+	mock.lockUpdateRef.Unlock()
+	return mock.UpdateRefFunc(ctx, r)
+}
+
+// UpdateRefCalls gets all the calls that were made to UpdateRef.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.UpdateRefCalls())
+func (mock *RepositoryReaderWriterMock) UpdateRefCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+	}
+	mock.lockUpdateRef.RLock()
+	calls = mock.calls.UpdateRef
+	mock.lockUpdateRef.RUnlock()
+	return calls
+}
+
+// UpdateRepository calls UpdateRepositoryFunc.
+func (mock *RepositoryReaderWriterMock) UpdateRepository(ctx context.Context, r entity.Repository) error {
+	if mock.UpdateRepositoryFunc == nil {
+		panic("RepositoryReaderWriterMock.UpdateRepositoryFunc: method is nil but RepositoryReaderWriter.UpdateRepository was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockUpdateRepository.Lock()
+	mock.calls.UpdateRepository = append(mock.calls.UpdateRepository, callInfo)
+	mock.lockUpdateRepository.Unlock()
+	return mock.UpdateRepositoryFunc(ctx, r)
+}
+
+// UpdateRepositoryCalls gets all the calls that were made to UpdateRepository.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.UpdateRepositoryCalls())
+func (mock *RepositoryReaderWriterMock) UpdateRepositoryCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+	}
+	mock.lockUpdateRepository.RLock()
+	calls = mock.calls.UpdateRepository
+	mock.lockUpdateRepository.RUnlock()
+	return calls
+}
+
+// UpdateSettings calls UpdateSettingsFunc.
+func (mock *RepositoryReaderWriterMock) UpdateSettings(ctx context.Context, r entity.Repository, reset entity.RepositoryReset) error {
+	if mock.UpdateSettingsFunc == nil {
+		panic("RepositoryReaderWriterMock.UpdateSettingsFunc: method is nil but RepositoryReaderWriter.UpdateSettings was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		R     entity.Repository
+		Reset entity.RepositoryReset
+	}{
+		Ctx:   ctx,
+		R:     r,
+		Reset: reset,
+	}
+	mock.lockUpdateSettings.Lock()
+	mock.calls.UpdateSettings = append(mock.calls.UpdateSettings, callInfo)
+	mock.lockUpdateSettings.Unlock()
+	return mock.UpdateSettingsFunc(ctx, r, reset)
+}
+
+// UpdateSettingsCalls gets all the calls that were made to UpdateSettings.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.UpdateSettingsCalls())
+func (mock *RepositoryReaderWriterMock) UpdateSettingsCalls() []struct {
+	Ctx   context.Context
+	R     entity.Repository
+	Reset entity.RepositoryReset
+} {
+	var calls []struct {
+		Ctx   context.Context
+		R     entity.Repository
+		Reset entity.RepositoryReset
+	}
+	mock.lockUpdateSettings.RLock()
+	calls = mock.calls.UpdateSettings
+	mock.lockUpdateSettings.RUnlock()
+	return calls
+}
+
+// UpdateSyncState calls UpdateSyncStateFunc.
+func (mock *RepositoryReaderWriterMock) UpdateSyncState(ctx context.Context, r entity.Repository) error {
+	if mock.UpdateSyncStateFunc == nil {
+		panic("RepositoryReaderWriterMock.UpdateSyncStateFunc: method is nil but RepositoryReaderWriter.UpdateSyncState was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		R   entity.Repository
+	}{
+		Ctx: ctx,
+		R:   r,
+	}
+	mock.lockUpdateSyncState.Lock()
+	mock.calls.UpdateSyncState = append(mock.calls.UpdateSyncState, callInfo)
+	mock.lockUpdateSyncState.Unlock()
+	return mock.UpdateSyncStateFunc(ctx, r)
+}
+
+// UpdateSyncStateCalls gets all the calls that were made to UpdateSyncState.
+// Check the length with:
+//     len(mockedRepositoryReaderWriter.UpdateSyncStateCalls())
+func (mock *RepositoryReaderWriterMock) UpdateSyncStateCalls() []struct {
+	Ctx context.Context
+	R   entity.Repository
+} {
+	var calls []struct {
+		Ctx context.Context
+		R   entity.Repository
+	}
+	mock.lockUpdateSyncState.RLock()
+	calls = mock.calls.UpdateSyncState
+	mock.lockUpdateSyncState.RUnlock()
+	return calls
+}
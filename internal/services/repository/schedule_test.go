@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+)
+
+var _ = Describe("Pull schedule", func() {
+	var (
+		repo             entity.Repository
+		repoReaderWriter *repository.RepositoryReaderWriterMock
+		service          *repository.Service
+	)
+
+	BeforeEach(func() {
+		repo = entity.Repository{
+			Id:         "repo",
+			PullPeriod: 100 * time.Second,
+		}
+		repoReaderWriter = &repository.RepositoryReaderWriterMock{
+			GetRepositoryFunc: func(ctx context.Context, id string) (entity.Repository, error) {
+				return repo, nil
+			},
+			UpdatePullPeriodFunc: func(ctx context.Context, id string, period time.Duration, next time.Time) error {
+				repo.PullPeriod = period
+				repo.NextSyncAt = next
+				return nil
+			},
+			SetNextSyncFunc: func(ctx context.Context, id string, previous, next time.Time) error {
+				if repo.NextSyncAt.Equal(previous) {
+					repo.NextSyncAt = next
+				}
+				return nil
+			},
+		}
+		service = repository.NewRepositoryService(repoReaderWriter, &repository.GitReaderWriterMock{}, &repository.SecretReaderMock{})
+	})
+
+	It("schedules the next sync one period from now with a jitter", func() {
+		for i := 0; i < 20; i++ {
+			now := time.Now()
+			next, err := service.ScheduleNextSync(context.TODO(), repo, time.Hour)
+			Expect(err).To(BeNil())
+			Expect(next).To(BeTemporally(">=", now.Add(90*time.Second)))
+			Expect(next).To(BeTemporally("<=", time.Now().Add(110*time.Second)))
+			Expect(repo.NextSyncAt).To(Equal(next))
+		}
+	})
+
+	It("keeps a sync requested while the repository was synced", func() {
+		synced := repo
+		requested := time.Now()
+		repo.NextSyncAt = requested
+
+		_, err := service.ScheduleNextSync(context.TODO(), synced, time.Hour)
+		Expect(err).To(BeNil())
+		Expect(repoReaderWriter.SetNextSyncCalls()[0].Previous.IsZero()).To(BeTrue())
+		Expect(repo.NextSyncAt).To(Equal(requested))
+	})
+
+	It("pulls the repositories accepting webhooks less often", func() {
+		repo.WebhookSecretPath = "webhook"
+		now := time.Now()
+		next, err := service.ScheduleNextSync(context.TODO(), repo, time.Hour)
+		Expect(err).To(BeNil())
+		Expect(next).To(BeTemporally(">=", now.Add(54*time.Minute)))
+		Expect(next).To(BeTemporally("<=", time.Now().Add(66*time.Minute)))
+	})
+
+	It("keeps the pull period of a repository accepting webhooks if it is longer", func() {
+		repo.WebhookSecretPath = "webhook"
+		now := time.Now()
+		next, err := service.ScheduleNextSync(context.TODO(), repo, time.Second)
+		Expect(err).To(BeNil())
+		Expect(next).To(BeTemporally(">=", now.Add(90*time.Second)))
+	})
+
+	It("does not schedule a paused repository", func() {
+		repo.PullPeriod = 0
+		next, err := service.ScheduleNextSync(context.TODO(), repo, time.Hour)
+		Expect(err).To(BeNil())
+		Expect(next.IsZero()).To(BeTrue())
+		Expect(repoReaderWriter.SetNextSyncCalls()).To(BeEmpty())
+	})
+
+	It("pauses a repository", func() {
+		repo.NextSyncAt = time.Now()
+		r, err := service.UpdatePullPeriod(context.TODO(), "repo", 0)
+		Expect(err).To(BeNil())
+		Expect(r.IsPaused()).To(BeTrue())
+		Expect(r.IsSyncDue(time.Now().Add(time.Hour))).To(BeFalse())
+		Expect(repo.NextSyncAt.IsZero()).To(BeTrue())
+	})
+
+	It("resumes a repository right away", func() {
+		repo.PullPeriod = 0
+		r, err := service.UpdatePullPeriod(context.TODO(), "repo", time.Minute)
+		Expect(err).To(BeNil())
+		Expect(r.PullPeriod).To(Equal(time.Minute))
+		Expect(r.IsSyncDue(time.Now())).To(BeTrue())
+	})
+})
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"path"
+	"strings"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+)
+
+// validateScope checks that the subpath stays inside the repository and that the globs are well formed.
+func validateScope(r entity.Repository) error {
+	if r.Subpath != "" {
+		if path.IsAbs(r.Subpath) {
+			return errService.NewInvalidRepositoryScopeError(r.Id, "subpath must be relative to the root of the repository")
+		}
+		if cleaned := path.Clean(r.Subpath); cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return errService.NewInvalidRepositoryScopeError(r.Id, "subpath is outside the repository")
+		}
+	}
+
+	for _, glob := range append(append([]string{}, r.IncludeGlobs...), r.ExcludeGlobs...) {
+		if strings.Contains(glob, "\n") {
+			return errService.NewInvalidRepositoryScopeError(r.Id, "glob must be on a single line")
+		}
+		if err := entity.ValidateGlob(glob); err != nil {
+			return errService.NewInvalidRepositoryScopeError(r.Id, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+)
+
+var _ = Describe("Repository scope", func() {
+	var (
+		repoReaderWriter *repository.RepositoryReaderWriterMock
+		service          *repository.Service
+	)
+
+	BeforeEach(func() {
+		repoReaderWriter = &repository.RepositoryReaderWriterMock{
+			InsertRepositoryFunc: func(ctx context.Context, r entity.Repository) error {
+				return nil
+			},
+		}
+		service = repository.NewRepositoryService(repoReaderWriter, &repository.GitReaderWriterMock{}, &repository.SecretReaderMock{})
+	})
+
+	It("adds a repository scoped to a subpath", func() {
+		err := service.Add(context.TODO(), entity.Repository{
+			Id:           "repo",
+			Subpath:      "teams/team-a",
+			IncludeGlobs: []string{"apps/**"},
+			ExcludeGlobs: []string{"**/testdata"},
+		})
+		Expect(err).To(BeNil())
+		Expect(repoReaderWriter.InsertRepositoryCalls()).To(HaveLen(1))
+	})
+
+	DescribeTable("refuses invalid scopes",
+		func(subpath string, include, exclude []string) {
+			err := service.Add(context.TODO(), entity.Repository{Id: "repo", Subpath: subpath, IncludeGlobs: include, ExcludeGlobs: exclude})
+			Expect(errService.IsInvalidRepositoryScope(err)).To(BeTrue())
+			Expect(repoReaderWriter.InsertRepositoryCalls()).To(BeEmpty())
+		},
+		Entry("absolute subpath", "/teams", nil, nil),
+		Entry("subpath outside the repository", "teams/../..", nil, nil),
+		Entry("invalid include glob", "", []string{"apps/[a"}, nil),
+		Entry("empty exclude glob", "", nil, []string{""}),
+	)
+})
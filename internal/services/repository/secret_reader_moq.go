@@ -0,0 +1,191 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repository
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that SecretReaderMock does implement SecretReader.
+// If this is not the case, regenerate this file with moq.
+var _ SecretReader = &SecretReaderMock{}
+
+// SecretReaderMock is a mock implementation of SecretReader.
+//
+// 	func TestSomethingThatUsesSecretReader(t *testing.T) {
+//
+// 		// make and configure a mocked SecretReader
+// 		mockedSecretReader := &SecretReaderMock{
+// 			GetCredentialsFuncFunc: func(ctx context.Context, authType entity.RepositoryAuthType, secretPath string) entity.CredentialsFunc {
+// 				panic("mock out the GetCredentialsFunc method")
+// 			},
+// 			GetSecretFunc: func(ctx context.Context, path string, key string) (entity.Secret, error) {
+// 				panic("mock out the GetSecret method")
+// 			},
+// 			GetSigningKeysFunc: func(ctx context.Context, path string) (entity.SigningKeys, error) {
+// 				panic("mock out the GetSigningKeys method")
+// 			},
+// 		}
+//
+// 		// use mockedSecretReader in code that requires SecretReader
+// 		// and then make assertions.
+//
+// 	}
+type SecretReaderMock struct {
+	// GetCredentialsFuncFunc mocks the GetCredentialsFunc method.
+	GetCredentialsFuncFunc func(ctx context.Context, authType entity.RepositoryAuthType, secretPath string) entity.CredentialsFunc
+
+	// GetSecretFunc mocks the GetSecret method.
+	GetSecretFunc func(ctx context.Context, path string, key string) (entity.Secret, error)
+
+	// GetSigningKeysFunc mocks the GetSigningKeys method.
+	GetSigningKeysFunc func(ctx context.Context, path string) (entity.SigningKeys, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetCredentialsFunc holds details about calls to the GetCredentialsFunc method.
+		GetCredentialsFunc []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuthType is the authType argument value.
+			AuthType entity.RepositoryAuthType
+			// SecretPath is the secretPath argument value.
+			SecretPath string
+		}
+		// GetSecret holds details about calls to the GetSecret method.
+		GetSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Path is the path argument value.
+			Path string
+			// Key is the key argument value.
+			Key string
+		}
+		// GetSigningKeys holds details about calls to the GetSigningKeys method.
+		GetSigningKeys []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Path is the path argument value.
+			Path string
+		}
+	}
+	lockGetCredentialsFunc sync.RWMutex
+	lockGetSecret          sync.RWMutex
+	lockGetSigningKeys     sync.RWMutex
+}
+
+// GetCredentialsFunc calls GetCredentialsFuncFunc.
+func (mock *SecretReaderMock) GetCredentialsFunc(ctx context.Context, authType entity.RepositoryAuthType, secretPath string) entity.CredentialsFunc {
+	if mock.GetCredentialsFuncFunc == nil {
+		panic("SecretReaderMock.GetCredentialsFuncFunc: method is nil but SecretReader.GetCredentialsFunc was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		AuthType   entity.RepositoryAuthType
+		SecretPath string
+	}{
+		Ctx:        ctx,
+		AuthType:   authType,
+		SecretPath: secretPath,
+	}
+	mock.lockGetCredentialsFunc.Lock()
+	mock.calls.GetCredentialsFunc = append(mock.calls.GetCredentialsFunc, callInfo)
+	mock.lockGetCredentialsFunc.Unlock()
+	return mock.GetCredentialsFuncFunc(ctx, authType, secretPath)
+}
+
+// GetCredentialsFuncCalls gets all the calls that were made to GetCredentialsFunc.
+// Check the length with:
+//     len(mockedSecretReader.GetCredentialsFuncCalls())
+func (mock *SecretReaderMock) GetCredentialsFuncCalls() []struct {
+	Ctx        context.Context
+	AuthType   entity.RepositoryAuthType
+	SecretPath string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		AuthType   entity.RepositoryAuthType
+		SecretPath string
+	}
+	mock.lockGetCredentialsFunc.RLock()
+	calls = mock.calls.GetCredentialsFunc
+	mock.lockGetCredentialsFunc.RUnlock()
+	return calls
+}
+
+// GetSecret calls GetSecretFunc.
+func (mock *SecretReaderMock) GetSecret(ctx context.Context, path string, key string) (entity.Secret, error) {
+	if mock.GetSecretFunc == nil {
+		panic("SecretReaderMock.GetSecretFunc: method is nil but SecretReader.GetSecret was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Path string
+		Key  string
+	}{
+		Ctx:  ctx,
+		Path: path,
+		Key:  key,
+	}
+	mock.lockGetSecret.Lock()
+	mock.calls.GetSecret = append(mock.calls.GetSecret, callInfo)
+	mock.lockGetSecret.Unlock()
+	return mock.GetSecretFunc(ctx, path, key)
+}
+
+// GetSecretCalls gets all the calls that were made to GetSecret.
+// Check the length with:
+//     len(mockedSecretReader.GetSecretCalls())
+func (mock *SecretReaderMock) GetSecretCalls() []struct {
+	Ctx  context.Context
+	Path string
+	Key  string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Path string
+		Key  string
+	}
+	mock.lockGetSecret.RLock()
+	calls = mock.calls.GetSecret
+	mock.lockGetSecret.RUnlock()
+	return calls
+}
+
+// GetSigningKeys calls GetSigningKeysFunc.
+func (mock *SecretReaderMock) GetSigningKeys(ctx context.Context, path string) (entity.SigningKeys, error) {
+	if mock.GetSigningKeysFunc == nil {
+		panic("SecretReaderMock.GetSigningKeysFunc: method is nil but SecretReader.GetSigningKeys was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Path string
+	}{
+		Ctx:  ctx,
+		Path: path,
+	}
+	mock.lockGetSigningKeys.Lock()
+	mock.calls.GetSigningKeys = append(mock.calls.GetSigningKeys, callInfo)
+	mock.lockGetSigningKeys.Unlock()
+	return mock.GetSigningKeysFunc(ctx, path)
+}
+
+// GetSigningKeysCalls gets all the calls that were made to GetSigningKeys.
+// Check the length with:
+//     len(mockedSecretReader.GetSigningKeysCalls())
+func (mock *SecretReaderMock) GetSigningKeysCalls() []struct {
+	Ctx  context.Context
+	Path string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Path string
+	}
+	mock.lockGetSigningKeys.RLock()
+	calls = mock.calls.GetSigningKeys
+	mock.lockGetSigningKeys.RUnlock()
+	return calls
+}
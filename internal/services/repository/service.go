@@ -2,11 +2,18 @@ package repository
 
 import (
 	"context"
+	"math/rand"
+	"strings"
+	"time"
 
 	"github.com/tupyy/tinyedge-controller/internal/entity"
 	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
 )
 
+// syncJitter is the part of the pull period by which the next pull is moved forward or backward so the repositories
+// added at the same time are not pulled together.
+const syncJitter = 0.1
+
 type Service struct {
 	gitReaderWriter  GitReaderWriter
 	repoReaderWriter RepositoryReaderWriter
@@ -33,6 +40,19 @@ func (r *Service) GetRepositories(ctx context.Context) ([]entity.Repository, err
 	return repos, nil
 }
 
+// GetRepository returns the repository with its credentials.
+func (r *Service) GetRepository(ctx context.Context, id string) (entity.Repository, error) {
+	repo, err := r.repoReaderWriter.GetRepository(ctx, id)
+	if err != nil {
+		return entity.Repository{}, err
+	}
+
+	if repo.AuthType != entity.NoRepositoryAuthType && repo.CredentialsSecretPath != "" {
+		repo.Credentials = r.secretReader.GetCredentialsFunc(ctx, repo.AuthType, repo.CredentialsSecretPath)
+	}
+	return repo, nil
+}
+
 func (r *Service) Open(ctx context.Context, repo entity.Repository) error {
 	if repo.LocalPath == "" {
 		return errService.NewResourceNotFoundError("git repository", repo.Id)
@@ -71,6 +91,83 @@ func (w *Service) Update(ctx context.Context, r entity.Repository) error {
 	return nil
 }
 
+// UpdateSyncState saves the local clone, the heads and the rejected head of the repository found by a sync.
+// The settings of the repository are not saved so the changes made meanwhile are kept.
+func (w *Service) UpdateSyncState(ctx context.Context, r entity.Repository) error {
+	return w.repoReaderWriter.UpdateSyncState(ctx, r)
+}
+
 func (w *Service) Add(ctx context.Context, r entity.Repository) error {
+	if err := validateID(r.Id); err != nil {
+		return err
+	}
+	if err := validateRef(r); err != nil {
+		return err
+	}
+	if err := validateScope(r); err != nil {
+		return err
+	}
 	return w.repoReaderWriter.InsertRepository(ctx, r)
 }
+
+// UpdatePullPeriod changes the pull period of the repository. A period of 0 pauses the repository.
+// Otherwise the repository is pulled right away and then every period.
+func (w *Service) UpdatePullPeriod(ctx context.Context, id string, period time.Duration) (entity.Repository, error) {
+	repo, err := w.repoReaderWriter.GetRepository(ctx, id)
+	if err != nil {
+		return entity.Repository{}, err
+	}
+
+	repo.PullPeriod = period
+	repo.NextSyncAt = time.Time{}
+	if !repo.IsPaused() {
+		repo.NextSyncAt = time.Now()
+	}
+
+	if err := w.repoReaderWriter.UpdatePullPeriod(ctx, repo.Id, repo.PullPeriod, repo.NextSyncAt); err != nil {
+		return entity.Repository{}, err
+	}
+
+	return repo, nil
+}
+
+// ScheduleNextSync sets the time of the next pull of the repository to one pull period from now, give or take the jitter.
+// A sync requested since the repository has been read is kept. Repositories accepting webhooks are synced as soon as they are pushed so they are pulled at most every webhookPullPeriod.
+func (w *Service) ScheduleNextSync(ctx context.Context, repo entity.Repository, webhookPullPeriod time.Duration) (time.Time, error) {
+	if repo.IsPaused() {
+		return time.Time{}, nil
+	}
+
+	period := repo.PullPeriod
+	if repo.WebhookSecretPath != "" && period < webhookPullPeriod {
+		period = webhookPullPeriod
+	}
+
+	next := time.Now().Add(withJitter(period))
+	if err := w.repoReaderWriter.SetNextSync(ctx, repo.Id, repo.NextSyncAt, next); err != nil {
+		return time.Time{}, err
+	}
+
+	return next, nil
+}
+
+func withJitter(period time.Duration) time.Duration {
+	max := int64(float64(period) * syncJitter)
+	if max <= 0 {
+		return period
+	}
+	return period - time.Duration(max) + time.Duration(rand.Int63n(2*max+1))
+}
+
+// validateID checks that the id can be used as the name of the directory holding the local clone of the repository.
+func validateID(id string) error {
+	switch {
+	case id == "":
+		return errService.NewInvalidRepositoryIDError(id, "id is missing")
+	case id == "." || id == "..":
+		return errService.NewInvalidRepositoryIDError(id, "id cannot be a relative directory")
+	case strings.ContainsAny(id, `/\`):
+		return errService.NewInvalidRepositoryIDError(id, "id cannot contain a path separator")
+	}
+	return nil
+}
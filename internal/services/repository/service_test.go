@@ -0,0 +1,41 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+)
+
+var _ = Describe("Repository id", func() {
+	var (
+		repoReaderWriter *repository.RepositoryReaderWriterMock
+		service          *repository.Service
+	)
+
+	BeforeEach(func() {
+		repoReaderWriter = &repository.RepositoryReaderWriterMock{
+			InsertRepositoryFunc: func(ctx context.Context, r entity.Repository) error {
+				return nil
+			},
+		}
+		service = repository.NewRepositoryService(repoReaderWriter, &repository.GitReaderWriterMock{}, &repository.SecretReaderMock{})
+	})
+
+	DescribeTable("refuses ids which are not a single path segment",
+		func(id string) {
+			err := service.Add(context.TODO(), entity.Repository{Id: id})
+			Expect(err).To(BeAssignableToTypeOf(errService.InvalidRepositoryIDError{}))
+			Expect(repoReaderWriter.InsertRepositoryCalls()).To(BeEmpty())
+		},
+		Entry("empty id", ""),
+		Entry("current directory", "."),
+		Entry("parent directory", ".."),
+		Entry("id with a separator", "../etc"),
+		Entry("id with a backslash", `..\etc`),
+	)
+})
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"go.uber.org/zap"
+)
+
+// UpdateSettings saves the url, the credentials, the secret paths and the scope of the repository.
+// When the url changes, the local clone is dropped and the repository is cloned again from the new remote at the next sync.
+// The clone of the previous remote is removed by the sync so it is never removed while the repository is pulled.
+// When the scope changes, the manifests are read again at the next sync even if the repository has not been pushed.
+func (w *Service) UpdateSettings(ctx context.Context, repo entity.Repository) (entity.Repository, error) {
+	if err := validateScope(repo); err != nil {
+		return entity.Repository{}, err
+	}
+
+	current, err := w.repoReaderWriter.GetRepository(ctx, repo.Id)
+	if err != nil {
+		return entity.Repository{}, err
+	}
+
+	reset := entity.NoRepositoryReset
+	switch {
+	case repo.Url != current.Url:
+		reset = entity.CloneRepositoryReset
+		repo.LocalPath = ""
+		repo.CurrentHeadSha = ""
+		repo.TargetHeadSha = ""
+		repo.RejectedHeadSha = ""
+		repo.RejectionReason = ""
+	case repo.Subpath != current.Subpath ||
+		!equalGlobs(repo.IncludeGlobs, current.IncludeGlobs) ||
+		!equalGlobs(repo.ExcludeGlobs, current.ExcludeGlobs):
+		reset = entity.HeadRepositoryReset
+		repo.CurrentHeadSha = ""
+	}
+
+	if reset != entity.NoRepositoryReset {
+		repo.NextSyncAt = current.NextSyncAt
+		if !current.IsPaused() {
+			repo.NextSyncAt = time.Now()
+		}
+	}
+
+	if err := w.repoReaderWriter.UpdateSettings(ctx, repo, reset); err != nil {
+		return entity.Repository{}, err
+	}
+
+	return repo, nil
+}
+
+// Delete removes the repository and its local clone. The manifests of the repository must be removed before.
+func (w *Service) Delete(ctx context.Context, repo entity.Repository) error {
+	if err := w.repoReaderWriter.DeleteRepository(ctx, repo.Id); err != nil {
+		return err
+	}
+
+	// the repository is gone so a clone left behind is only logged. It is reused if a repository with the same id is added.
+	if err := w.gitReaderWriter.Delete(ctx, repo); err != nil {
+		zap.S().Errorw("unable to remove the local clone of the deleted repository", "error", err, "repo_id", repo.Id)
+	}
+
+	return nil
+}
+
+func equalGlobs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package repository_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+)
+
+var _ = Describe("Repository settings", func() {
+	var (
+		repo             entity.Repository
+		repoReaderWriter *repository.RepositoryReaderWriterMock
+		gitReaderWriter  *repository.GitReaderWriterMock
+		service          *repository.Service
+	)
+
+	BeforeEach(func() {
+		repo = entity.Repository{
+			Id:             "repo",
+			Url:            "https://example.com/repo.git",
+			LocalPath:      "/var/repos/repo",
+			CurrentHeadSha: "current",
+			TargetHeadSha:  "current",
+			PullPeriod:     time.Minute,
+			NextSyncAt:     time.Now().Add(time.Minute),
+		}
+		repoReaderWriter = &repository.RepositoryReaderWriterMock{
+			GetRepositoryFunc: func(ctx context.Context, id string) (entity.Repository, error) {
+				return repo, nil
+			},
+			UpdateSettingsFunc: func(ctx context.Context, r entity.Repository, reset entity.RepositoryReset) error {
+				repo = r
+				return nil
+			},
+			DeleteRepositoryFunc: func(ctx context.Context, id string) error {
+				return nil
+			},
+		}
+		gitReaderWriter = &repository.GitReaderWriterMock{
+			DeleteFunc: func(ctx context.Context, r entity.Repository) error {
+				return nil
+			},
+		}
+		service = repository.NewRepositoryService(repoReaderWriter, gitReaderWriter, &repository.SecretReaderMock{})
+	})
+
+	It("keeps the clone when only the secret paths change", func() {
+		updated := repo
+		updated.WebhookSecretPath = "webhook"
+
+		r, err := service.UpdateSettings(context.TODO(), updated)
+		Expect(err).To(BeNil())
+		Expect(r.WebhookSecretPath).To(Equal("webhook"))
+		Expect(r.LocalPath).To(Equal("/var/repos/repo"))
+		Expect(r.CurrentHeadSha).To(Equal("current"))
+		Expect(gitReaderWriter.DeleteCalls()).To(BeEmpty())
+		Expect(repoReaderWriter.UpdateSettingsCalls()[0].Reset).To(Equal(entity.NoRepositoryReset))
+		Expect(repo.IsSyncDue(time.Now())).To(BeFalse())
+	})
+
+	It("drops the clone when the url changes", func() {
+		updated := repo
+		updated.Url = "https://example.com/other.git"
+
+		r, err := service.UpdateSettings(context.TODO(), updated)
+		Expect(err).To(BeNil())
+		// the clone is removed by the next sync which clones the new remote
+		Expect(gitReaderWriter.DeleteCalls()).To(BeEmpty())
+		Expect(repoReaderWriter.UpdateSettingsCalls()[0].Reset).To(Equal(entity.CloneRepositoryReset))
+		Expect(r.LocalPath).To(BeEmpty())
+		Expect(r.CurrentHeadSha).To(BeEmpty())
+		Expect(r.TargetHeadSha).To(BeEmpty())
+		Expect(repo.IsSyncDue(time.Now())).To(BeTrue())
+	})
+
+	It("reads the manifests again when the scope changes", func() {
+		updated := repo
+		updated.ExcludeGlobs = []string{"**/testdata"}
+
+		r, err := service.UpdateSettings(context.TODO(), updated)
+		Expect(err).To(BeNil())
+		Expect(gitReaderWriter.DeleteCalls()).To(BeEmpty())
+		Expect(repoReaderWriter.UpdateSettingsCalls()[0].Reset).To(Equal(entity.HeadRepositoryReset))
+		Expect(r.LocalPath).To(Equal("/var/repos/repo"))
+		Expect(r.CurrentHeadSha).To(BeEmpty())
+		Expect(repo.IsSyncDue(time.Now())).To(BeTrue())
+	})
+
+	It("keeps a paused repository paused when the url changes", func() {
+		repo.PullPeriod = 0
+		repo.NextSyncAt = time.Time{}
+		updated := repo
+		updated.Url = "https://example.com/other.git"
+
+		r, err := service.UpdateSettings(context.TODO(), updated)
+		Expect(err).To(BeNil())
+		Expect(r.NextSyncAt.IsZero()).To(BeTrue())
+	})
+
+	It("refuses an invalid scope", func() {
+		updated := repo
+		updated.Subpath = "../other"
+
+		_, err := service.UpdateSettings(context.TODO(), updated)
+		Expect(errService.IsInvalidRepositoryScope(err)).To(BeTrue())
+		Expect(repoReaderWriter.UpdateSettingsCalls()).To(BeEmpty())
+	})
+
+	It("deletes the repository and its clone", func() {
+		err := service.Delete(context.TODO(), repo)
+		Expect(err).To(BeNil())
+		Expect(repoReaderWriter.DeleteRepositoryCalls()).To(HaveLen(1))
+		Expect(gitReaderWriter.DeleteCalls()).To(HaveLen(1))
+	})
+
+	It("keeps the clone when the repository cannot be deleted", func() {
+		repoReaderWriter.DeleteRepositoryFunc = func(ctx context.Context, id string) error {
+			return errService.NewResourceNotFoundError("repository", id)
+		}
+
+		err := service.Delete(context.TODO(), repo)
+		Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		Expect(gitReaderWriter.DeleteCalls()).To(BeEmpty())
+	})
+})
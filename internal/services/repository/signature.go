@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+// VerifyHead checks the signature of the commit pulled from the repository against the trust policy of the repository.
+// The commit is verified before it is checked out so the manifests are never read from a refused commit.
+// If the commit is refused, a CommitSignatureError is returned.
+func (w *Service) VerifyHead(ctx context.Context, repo entity.Repository) error {
+	if repo.SigningKeysSecretPath == "" {
+		return nil
+	}
+
+	keys, err := w.secretReader.GetSigningKeys(ctx, repo.SigningKeysSecretPath)
+	if err != nil {
+		return fmt.Errorf("unable to read signing keys of repository %q: %w", repo.Id, err)
+	}
+
+	return w.gitReaderWriter.VerifyCommitSignature(ctx, repo, repo.TargetHeadSha, keys)
+}
+
+// Checkout checks out the commit in the local clone of the repository. It must be called only with accepted commits.
+func (w *Service) Checkout(ctx context.Context, repo entity.Repository, sha string) error {
+	return w.gitReaderWriter.Checkout(ctx, repo, sha)
+}
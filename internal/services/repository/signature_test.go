@@ -0,0 +1,78 @@
+package repository_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+)
+
+var _ = Describe("Commit signature", func() {
+	var (
+		repo         entity.Repository
+		gitReader    *repository.GitReaderWriterMock
+		secretReader *repository.SecretReaderMock
+		service      *repository.Service
+	)
+
+	BeforeEach(func() {
+		repo = entity.Repository{
+			Id:                    "repo",
+			SigningKeysSecretPath: "keys",
+			CurrentHeadSha:        "current",
+			TargetHeadSha:         "target",
+		}
+		gitReader = &repository.GitReaderWriterMock{
+			CheckoutFunc: func(ctx context.Context, r entity.Repository, sha string) error {
+				return nil
+			},
+			VerifyCommitSignatureFunc: func(ctx context.Context, r entity.Repository, sha string, keys entity.SigningKeys) error {
+				return nil
+			},
+		}
+		secretReader = &repository.SecretReaderMock{
+			GetSigningKeysFunc: func(ctx context.Context, path string) (entity.SigningKeys, error) {
+				return entity.SigningKeys{SSH: []string{"ssh-ed25519 AAAA"}}, nil
+			},
+		}
+		service = repository.NewRepositoryService(&repository.RepositoryReaderWriterMock{}, gitReader, secretReader)
+	})
+
+	It("does not verify the commits when the repository has no signing keys", func() {
+		repo.SigningKeysSecretPath = ""
+
+		err := service.VerifyHead(context.TODO(), repo)
+		Expect(err).To(BeNil())
+		Expect(gitReader.VerifyCommitSignatureCalls()).To(BeEmpty())
+	})
+
+	It("verifies the target commit with the keys of the repository", func() {
+		err := service.VerifyHead(context.TODO(), repo)
+		Expect(err).To(BeNil())
+		Expect(gitReader.VerifyCommitSignatureCalls()).To(HaveLen(1))
+		Expect(gitReader.VerifyCommitSignatureCalls()[0].Sha).To(Equal("target"))
+		Expect(gitReader.VerifyCommitSignatureCalls()[0].Keys.SSH).To(HaveLen(1))
+		Expect(gitReader.CheckoutCalls()).To(BeEmpty())
+	})
+
+	It("does not check out the target commit when it is refused", func() {
+		gitReader.VerifyCommitSignatureFunc = func(ctx context.Context, r entity.Repository, sha string, keys entity.SigningKeys) error {
+			return errService.NewCommitSignatureError(r.Id, sha, "commit is not signed")
+		}
+
+		err := service.VerifyHead(context.TODO(), repo)
+		Expect(errService.IsCommitSignatureError(err)).To(BeTrue())
+		Expect(gitReader.CheckoutCalls()).To(BeEmpty())
+	})
+
+	It("checks out the accepted commit", func() {
+		err := service.Checkout(context.TODO(), repo, repo.TargetHeadSha)
+		Expect(err).To(BeNil())
+		Expect(gitReader.CheckoutCalls()).To(HaveLen(1))
+		Expect(gitReader.CheckoutCalls()[0].Sha).To(Equal("target"))
+	})
+})
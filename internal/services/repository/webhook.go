@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+)
+
+// webhookSecretKey is the key of the webhook secret in the vault secret of the repository.
+const webhookSecretKey = "secret"
+
+// VerifyWebhook checks that the webhook received for the repository has been signed with the webhook secret of the repository.
+// It returns the repository if the signature is valid.
+func (r *Service) VerifyWebhook(ctx context.Context, repoID string, payload []byte, signature entity.WebhookSignature) (entity.Repository, error) {
+	repo, err := r.GetRepository(ctx, repoID)
+	if err != nil {
+		return entity.Repository{}, err
+	}
+
+	if repo.WebhookSecretPath == "" {
+		return entity.Repository{}, errService.NewInvalidWebhookSignatureError(repoID, "repository does not accept webhooks")
+	}
+
+	secret, err := r.secretReader.GetSecret(ctx, repo.WebhookSecretPath, webhookSecretKey)
+	if err != nil {
+		return entity.Repository{}, fmt.Errorf("unable to read webhook secret of repository %q: %w", repoID, err)
+	}
+
+	if signature.Value == "" {
+		return entity.Repository{}, errService.NewInvalidWebhookSignatureError(repoID, "signature is missing")
+	}
+
+	switch signature.Kind {
+	case entity.TokenWebhookSignature:
+		if subtle.ConstantTimeCompare([]byte(signature.Value), []byte(secret.Value)) != 1 {
+			return entity.Repository{}, errService.NewInvalidWebhookSignatureError(repoID, "token mismatch")
+		}
+	default:
+		expected, err := hex.DecodeString(strings.TrimPrefix(signature.Value, "sha256="))
+		if err != nil {
+			return entity.Repository{}, errService.NewInvalidWebhookSignatureError(repoID, "signature is not hex encoded")
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret.Value))
+		mac.Write(payload)
+		if !hmac.Equal(mac.Sum(nil), expected) {
+			return entity.Repository{}, errService.NewInvalidWebhookSignatureError(repoID, "signature mismatch")
+		}
+	}
+
+	return repo, nil
+}
@@ -0,0 +1,86 @@
+package repository_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/repository"
+)
+
+var _ = Describe("Webhook", func() {
+	var (
+		repo    entity.Repository
+		service *repository.Service
+		payload = []byte(`{"ref":"refs/heads/main"}`)
+	)
+
+	sign := func(key string) string {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write(payload)
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	BeforeEach(func() {
+		repo = entity.Repository{
+			Id:                "repo",
+			AuthType:          entity.NoRepositoryAuthType,
+			WebhookSecretPath: "webhooks/repo",
+		}
+		repoReaderWriter := &repository.RepositoryReaderWriterMock{
+			GetRepositoryFunc: func(ctx context.Context, id string) (entity.Repository, error) {
+				if id != repo.Id {
+					return entity.Repository{}, errService.NewResourceNotFoundError("repository", id)
+				}
+				return repo, nil
+			},
+		}
+		secretReader := &repository.SecretReaderMock{
+			GetSecretFunc: func(ctx context.Context, path, key string) (entity.Secret, error) {
+				return entity.Secret{Path: path, Key: key, Value: "key"}, nil
+			},
+		}
+		service = repository.NewRepositoryService(repoReaderWriter, &repository.GitReaderWriterMock{}, secretReader)
+	})
+
+	It("accepts a payload signed with the secret of the repository", func() {
+		r, err := service.VerifyWebhook(context.TODO(), "repo", payload, entity.WebhookSignature{Kind: entity.HMACWebhookSignature, Value: sign("key")})
+		Expect(err).To(BeNil())
+		Expect(r.Id).To(Equal("repo"))
+	})
+
+	It("refuses a payload signed with another secret", func() {
+		_, err := service.VerifyWebhook(context.TODO(), "repo", payload, entity.WebhookSignature{Kind: entity.HMACWebhookSignature, Value: sign("other")})
+		Expect(errService.IsInvalidWebhookSignature(err)).To(BeTrue())
+	})
+
+	It("refuses a payload without signature", func() {
+		_, err := service.VerifyWebhook(context.TODO(), "repo", payload, entity.WebhookSignature{Kind: entity.HMACWebhookSignature})
+		Expect(errService.IsInvalidWebhookSignature(err)).To(BeTrue())
+	})
+
+	It("compares the token of the token signatures", func() {
+		_, err := service.VerifyWebhook(context.TODO(), "repo", payload, entity.WebhookSignature{Kind: entity.TokenWebhookSignature, Value: "key"})
+		Expect(err).To(BeNil())
+
+		_, err = service.VerifyWebhook(context.TODO(), "repo", payload, entity.WebhookSignature{Kind: entity.TokenWebhookSignature, Value: "other"})
+		Expect(errService.IsInvalidWebhookSignature(err)).To(BeTrue())
+	})
+
+	It("refuses the webhooks of a repository without webhook secret", func() {
+		repo.WebhookSecretPath = ""
+		_, err := service.VerifyWebhook(context.TODO(), "repo", payload, entity.WebhookSignature{Kind: entity.HMACWebhookSignature, Value: sign("key")})
+		Expect(errService.IsInvalidWebhookSignature(err)).To(BeTrue())
+	})
+
+	It("returns not found for an unknown repository", func() {
+		_, err := service.VerifyWebhook(context.TODO(), "unknown", payload, entity.WebhookSignature{Kind: entity.HMACWebhookSignature, Value: sign("key")})
+		Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+	})
+})
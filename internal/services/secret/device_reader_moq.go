@@ -0,0 +1,75 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package secret
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that DeviceReaderMock does implement DeviceReader.
+// If this is not the case, regenerate this file with moq.
+var _ DeviceReader = &DeviceReaderMock{}
+
+// DeviceReaderMock is a mock implementation of DeviceReader.
+//
+// 	func TestSomethingThatUsesDeviceReader(t *testing.T) {
+//
+// 		// make and configure a mocked DeviceReader
+// 		mockedDeviceReader := &DeviceReaderMock{
+// 			GetDevicesFunc: func(ctx context.Context) ([]entity.Device, error) {
+// 				panic("mock out the GetDevices method")
+// 			},
+// 		}
+//
+// 		// use mockedDeviceReader in code that requires DeviceReader
+// 		// and then make assertions.
+//
+// 	}
+type DeviceReaderMock struct {
+	// GetDevicesFunc mocks the GetDevices method.
+	GetDevicesFunc func(ctx context.Context) ([]entity.Device, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetDevices holds details about calls to the GetDevices method.
+		GetDevices []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockGetDevices sync.RWMutex
+}
+
+// GetDevices calls GetDevicesFunc.
+func (mock *DeviceReaderMock) GetDevices(ctx context.Context) ([]entity.Device, error) {
+	if mock.GetDevicesFunc == nil {
+		panic("DeviceReaderMock.GetDevicesFunc: method is nil but DeviceReader.GetDevices was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDevices.Lock()
+	mock.calls.GetDevices = append(mock.calls.GetDevices, callInfo)
+	mock.lockGetDevices.Unlock()
+	return mock.GetDevicesFunc(ctx)
+}
+
+// GetDevicesCalls gets all the calls that were made to GetDevices.
+// Check the length with:
+//     len(mockedDeviceReader.GetDevicesCalls())
+func (mock *DeviceReaderMock) GetDevicesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDevices.RLock()
+	calls = mock.calls.GetDevices
+	mock.lockGetDevices.RUnlock()
+	return calls
+}
@@ -0,0 +1,33 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+//go:generate moq -out secret_rw_moq.go . SecretReaderWriter
+type SecretReaderWriter interface {
+	GetSecrets(ctx context.Context) ([]entity.SecretReference, error)
+	UpdateSecret(ctx context.Context, secret entity.SecretReference) error
+}
+
+//go:generate moq -out vault_reader_moq.go . VaultReader
+type VaultReader interface {
+	GetSecret(ctx context.Context, path, key string) (entity.Secret, error)
+}
+
+//go:generate moq -out device_reader_moq.go . DeviceReader
+type DeviceReader interface {
+	GetDevices(ctx context.Context) ([]entity.Device, error)
+}
+
+//go:generate moq -out workload_reader_moq.go . WorkloadReader
+type WorkloadReader interface {
+	GetWorkloads(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error)
+}
+
+//go:generate moq -out notifier_moq.go . Notifier
+type Notifier interface {
+	NotifyDevices(deviceIDs ...string)
+}
@@ -0,0 +1,73 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package secret
+
+import (
+	"sync"
+)
+
+// Ensure, that NotifierMock does implement Notifier.
+// If this is not the case, regenerate this file with moq.
+var _ Notifier = &NotifierMock{}
+
+// NotifierMock is a mock implementation of Notifier.
+//
+// 	func TestSomethingThatUsesNotifier(t *testing.T) {
+//
+// 		// make and configure a mocked Notifier
+// 		mockedNotifier := &NotifierMock{
+// 			NotifyDevicesFunc: func(deviceIDs ...string)  {
+// 				panic("mock out the NotifyDevices method")
+// 			},
+// 		}
+//
+// 		// use mockedNotifier in code that requires Notifier
+// 		// and then make assertions.
+//
+// 	}
+type NotifierMock struct {
+	// NotifyDevicesFunc mocks the NotifyDevices method.
+	NotifyDevicesFunc func(deviceIDs ...string)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// NotifyDevices holds details about calls to the NotifyDevices method.
+		NotifyDevices []struct {
+			// DeviceIDs is the deviceIDs argument value.
+			DeviceIDs []string
+		}
+	}
+	lockNotifyDevices sync.RWMutex
+}
+
+// NotifyDevices calls NotifyDevicesFunc.
+func (mock *NotifierMock) NotifyDevices(deviceIDs ...string) {
+	if mock.NotifyDevicesFunc == nil {
+		panic("NotifierMock.NotifyDevicesFunc: method is nil but Notifier.NotifyDevices was just called")
+	}
+	callInfo := struct {
+		DeviceIDs []string
+	}{
+		DeviceIDs: deviceIDs,
+	}
+	mock.lockNotifyDevices.Lock()
+	mock.calls.NotifyDevices = append(mock.calls.NotifyDevices, callInfo)
+	mock.lockNotifyDevices.Unlock()
+	mock.NotifyDevicesFunc(deviceIDs...)
+}
+
+// NotifyDevicesCalls gets all the calls that were made to NotifyDevices.
+// Check the length with:
+//     len(mockedNotifier.NotifyDevicesCalls())
+func (mock *NotifierMock) NotifyDevicesCalls() []struct {
+	DeviceIDs []string
+} {
+	var calls []struct {
+		DeviceIDs []string
+	}
+	mock.lockNotifyDevices.RLock()
+	calls = mock.calls.NotifyDevices
+	mock.lockNotifyDevices.RUnlock()
+	return calls
+}
@@ -0,0 +1,124 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package secret
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that SecretReaderWriterMock does implement SecretReaderWriter.
+// If this is not the case, regenerate this file with moq.
+var _ SecretReaderWriter = &SecretReaderWriterMock{}
+
+// SecretReaderWriterMock is a mock implementation of SecretReaderWriter.
+//
+// 	func TestSomethingThatUsesSecretReaderWriter(t *testing.T) {
+//
+// 		// make and configure a mocked SecretReaderWriter
+// 		mockedSecretReaderWriter := &SecretReaderWriterMock{
+// 			GetSecretsFunc: func(ctx context.Context) ([]entity.SecretReference, error) {
+// 				panic("mock out the GetSecrets method")
+// 			},
+// 			UpdateSecretFunc: func(ctx context.Context, secret entity.SecretReference) error {
+// 				panic("mock out the UpdateSecret method")
+// 			},
+// 		}
+//
+// 		// use mockedSecretReaderWriter in code that requires SecretReaderWriter
+// 		// and then make assertions.
+//
+// 	}
+type SecretReaderWriterMock struct {
+	// GetSecretsFunc mocks the GetSecrets method.
+	GetSecretsFunc func(ctx context.Context) ([]entity.SecretReference, error)
+
+	// UpdateSecretFunc mocks the UpdateSecret method.
+	UpdateSecretFunc func(ctx context.Context, secret entity.SecretReference) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetSecrets holds details about calls to the GetSecrets method.
+		GetSecrets []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// UpdateSecret holds details about calls to the UpdateSecret method.
+		UpdateSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Secret is the secret argument value.
+			Secret entity.SecretReference
+		}
+	}
+	lockGetSecrets   sync.RWMutex
+	lockUpdateSecret sync.RWMutex
+}
+
+// GetSecrets calls GetSecretsFunc.
+func (mock *SecretReaderWriterMock) GetSecrets(ctx context.Context) ([]entity.SecretReference, error) {
+	if mock.GetSecretsFunc == nil {
+		panic("SecretReaderWriterMock.GetSecretsFunc: method is nil but SecretReaderWriter.GetSecrets was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSecrets.Lock()
+	mock.calls.GetSecrets = append(mock.calls.GetSecrets, callInfo)
+	mock.lockGetSecrets.Unlock()
+	return mock.GetSecretsFunc(ctx)
+}
+
+// GetSecretsCalls gets all the calls that were made to GetSecrets.
+// Check the length with:
+//     len(mockedSecretReaderWriter.GetSecretsCalls())
+func (mock *SecretReaderWriterMock) GetSecretsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetSecrets.RLock()
+	calls = mock.calls.GetSecrets
+	mock.lockGetSecrets.RUnlock()
+	return calls
+}
+
+// UpdateSecret calls UpdateSecretFunc.
+func (mock *SecretReaderWriterMock) UpdateSecret(ctx context.Context, secret entity.SecretReference) error {
+	if mock.UpdateSecretFunc == nil {
+		panic("SecretReaderWriterMock.UpdateSecretFunc: method is nil but SecretReaderWriter.UpdateSecret was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Secret entity.SecretReference
+	}{
+		Ctx:    ctx,
+		Secret: secret,
+	}
+	mock.lockUpdateSecret.Lock()
+	mock.calls.UpdateSecret = append(mock.calls.UpdateSecret, callInfo)
+	mock.lockUpdateSecret.Unlock()
+	return mock.UpdateSecretFunc(ctx, secret)
+}
+
+// UpdateSecretCalls gets all the calls that were made to UpdateSecret.
+// Check the length with:
+//     len(mockedSecretReaderWriter.UpdateSecretCalls())
+func (mock *SecretReaderWriterMock) UpdateSecretCalls() []struct {
+	Ctx    context.Context
+	Secret entity.SecretReference
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Secret entity.SecretReference
+	}
+	mock.lockUpdateSecret.RLock()
+	calls = mock.calls.UpdateSecret
+	mock.lockUpdateSecret.RUnlock()
+	return calls
+}
@@ -0,0 +1,143 @@
+package secret_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/internal/services/secret"
+)
+
+var _ = Describe("Secret rotation", func() {
+	var (
+		secrets      map[string]entity.SecretReference
+		hashes       map[string]string
+		notified     []string
+		secretRW     *secret.SecretReaderWriterMock
+		vaultReader  *secret.VaultReaderMock
+		deviceReader *secret.DeviceReaderMock
+		workloads    *secret.WorkloadReaderMock
+		notifier     *secret.NotifierMock
+	)
+
+	BeforeEach(func() {
+		secrets = map[string]entity.SecretReference{
+			"db:password": {ID: "db:password", Path: "db", Key: "password", CurrentHash: "1", TargetHash: "1", Manifests: []string{"first"}},
+			"api:token":   {ID: "api:token", Path: "api", Key: "token", CurrentHash: "2", TargetHash: "2", Manifests: []string{"second"}},
+		}
+		hashes = map[string]string{"db:password": "1", "api:token": "2"}
+		notified = []string{}
+
+		secretRW = &secret.SecretReaderWriterMock{
+			GetSecretsFunc: func(ctx context.Context) ([]entity.SecretReference, error) {
+				return []entity.SecretReference{secrets["api:token"], secrets["db:password"]}, nil
+			},
+			UpdateSecretFunc: func(ctx context.Context, s entity.SecretReference) error {
+				secrets[s.ID] = s
+				return nil
+			},
+		}
+		vaultReader = &secret.VaultReaderMock{
+			GetSecretFunc: func(ctx context.Context, path string, key string) (entity.Secret, error) {
+				hash, ok := hashes[path+":"+key]
+				if !ok {
+					return entity.Secret{}, errors.New("secret not found")
+				}
+				return entity.Secret{Path: path, Key: key, Hash: hash}, nil
+			},
+		}
+		deviceReader = &secret.DeviceReaderMock{
+			GetDevicesFunc: func(ctx context.Context) ([]entity.Device, error) {
+				return []entity.Device{
+					{ID: "device1", Registred: true},
+					{ID: "device2", Registred: true},
+					{ID: "device3", Registred: false},
+				}, nil
+			},
+		}
+		workloads = &secret.WorkloadReaderMock{
+			GetWorkloadsFunc: func(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error) {
+				switch device.ID {
+				case "device1":
+					return []entity.DeviceWorkload{{ManifestV1: entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "first"}}}}, nil
+				default:
+					return []entity.DeviceWorkload{{ManifestV1: entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "second"}}}}, nil
+				}
+			},
+		}
+		notifier = &secret.NotifierMock{
+			NotifyDevicesFunc: func(deviceIDs ...string) {
+				notified = append(notified, deviceIDs...)
+			},
+		}
+	})
+
+	It("does nothing when no secret changed", func() {
+		rotated, err := secret.New(secretRW, vaultReader, deviceReader, workloads, notifier).RotateSecrets(context.TODO())
+		Expect(err).To(BeNil())
+		Expect(rotated).To(BeEmpty())
+		Expect(len(secretRW.UpdateSecretCalls())).To(Equal(0))
+		Expect(len(notifier.NotifyDevicesCalls())).To(Equal(0))
+	})
+
+	It("notifies the devices running the manifests of the rotated secret", func() {
+		hashes["db:password"] = "3"
+
+		rotated, err := secret.New(secretRW, vaultReader, deviceReader, workloads, notifier).RotateSecrets(context.TODO())
+		Expect(err).To(BeNil())
+		Expect(len(rotated)).To(Equal(1))
+		Expect(rotated[0].ID).To(Equal("db:password"))
+		Expect(notified).To(Equal([]string{"device1"}))
+		Expect(secrets["db:password"].CurrentHash).To(Equal("3"))
+		Expect(secrets["db:password"].TargetHash).To(Equal("3"))
+	})
+
+	It("does not notify the devices when the secret is read for the first time", func() {
+		secrets["db:password"] = entity.SecretReference{ID: "db:password", Path: "db", Key: "password", Manifests: []string{"first"}}
+
+		rotated, err := secret.New(secretRW, vaultReader, deviceReader, workloads, notifier).RotateSecrets(context.TODO())
+		Expect(err).To(BeNil())
+		Expect(rotated).To(BeEmpty())
+		Expect(len(notifier.NotifyDevicesCalls())).To(Equal(0))
+		Expect(secrets["db:password"].CurrentHash).To(Equal("1"))
+		Expect(secrets["db:password"].TargetHash).To(Equal("1"))
+	})
+
+	It("notifies the devices of a rotation detected but not delivered", func() {
+		s := secrets["api:token"]
+		s.TargetHash = "4"
+		secrets["api:token"] = s
+		hashes["api:token"] = "4"
+
+		rotated, err := secret.New(secretRW, vaultReader, deviceReader, workloads, notifier).RotateSecrets(context.TODO())
+		Expect(err).To(BeNil())
+		Expect(len(rotated)).To(Equal(1))
+		Expect(notified).To(Equal([]string{"device2"}))
+		Expect(secrets["api:token"].CurrentHash).To(Equal("4"))
+	})
+
+	It("skips the secrets which cannot be read from vault", func() {
+		delete(hashes, "api:token")
+		hashes["db:password"] = "3"
+
+		rotated, err := secret.New(secretRW, vaultReader, deviceReader, workloads, notifier).RotateSecrets(context.TODO())
+		Expect(err).To(BeNil())
+		Expect(len(rotated)).To(Equal(1))
+		Expect(secrets["api:token"].TargetHash).To(Equal("2"))
+	})
+
+	It("keeps the rotation pending when the devices cannot be read", func() {
+		hashes["db:password"] = "3"
+		deviceReader.GetDevicesFunc = func(ctx context.Context) ([]entity.Device, error) {
+			return nil, errors.New("error")
+		}
+
+		_, err := secret.New(secretRW, vaultReader, deviceReader, workloads, notifier).RotateSecrets(context.TODO())
+		Expect(err).ToNot(BeNil())
+		Expect(secrets["db:password"].CurrentHash).To(Equal("1"))
+		Expect(secrets["db:password"].TargetHash).To(Equal("3"))
+	})
+})
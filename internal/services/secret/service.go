@@ -0,0 +1,123 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"go.uber.org/zap"
+)
+
+// Service detects the secrets rotated in Vault and notifies the devices using them.
+// The hashes of the secrets are part of the configuration hash so the notified devices get a new configuration.
+type Service struct {
+	secretReaderWriter SecretReaderWriter
+	vaultReader        VaultReader
+	deviceReader       DeviceReader
+	workloadReader     WorkloadReader
+	notifier           Notifier
+}
+
+func New(secretReaderWriter SecretReaderWriter, vaultReader VaultReader, deviceReader DeviceReader, workloadReader WorkloadReader, notifier Notifier) *Service {
+	return &Service{
+		secretReaderWriter: secretReaderWriter,
+		vaultReader:        vaultReader,
+		deviceReader:       deviceReader,
+		workloadReader:     workloadReader,
+		notifier:           notifier,
+	}
+}
+
+// RotateSecrets reads the secrets referenced by the manifests from Vault and notifies the devices running the manifests
+// of the secrets whose value changed. It returns the rotated secrets.
+func (s *Service) RotateSecrets(ctx context.Context) ([]entity.SecretReference, error) {
+	secrets, err := s.secretReaderWriter.GetSecrets(ctx)
+	if err != nil {
+		return []entity.SecretReference{}, fmt.Errorf("unable to read secrets: %w", err)
+	}
+
+	rotated := make([]entity.SecretReference, 0)
+	for _, secret := range secrets {
+		value, err := s.vaultReader.GetSecret(ctx, secret.Path, secret.Key)
+		if err != nil {
+			zap.S().Errorw("unable to read secret from vault", "error", err, "secret_id", secret.ID)
+			continue
+		}
+
+		if value.Hash == secret.TargetHash {
+			if secret.IsRotated() {
+				rotated = append(rotated, secret)
+			}
+			continue
+		}
+
+		secret.TargetHash = value.Hash
+		// first read of the secret. The devices already got this value.
+		if secret.CurrentHash == "" {
+			secret.CurrentHash = value.Hash
+		}
+		if err := s.secretReaderWriter.UpdateSecret(ctx, secret); err != nil {
+			return []entity.SecretReference{}, fmt.Errorf("unable to update secret %q: %w", secret.ID, err)
+		}
+
+		if secret.IsRotated() {
+			zap.S().Infow("secret rotated", "secret_id", secret.ID, "manifests", secret.Manifests)
+			rotated = append(rotated, secret)
+		}
+	}
+
+	if len(rotated) == 0 {
+		return rotated, nil
+	}
+
+	devices, err := s.getDevices(ctx, rotated)
+	if err != nil {
+		return []entity.SecretReference{}, err
+	}
+	s.notifier.NotifyDevices(devices...)
+
+	for _, secret := range rotated {
+		secret.CurrentHash = secret.TargetHash
+		if err := s.secretReaderWriter.UpdateSecret(ctx, secret); err != nil {
+			return []entity.SecretReference{}, fmt.Errorf("unable to update secret %q: %w", secret.ID, err)
+		}
+	}
+
+	return rotated, nil
+}
+
+// getDevices returns the ids of the devices running at least one manifest using the secrets.
+func (s *Service) getDevices(ctx context.Context, secrets []entity.SecretReference) ([]string, error) {
+	manifests := make(map[string]struct{})
+	for _, secret := range secrets {
+		for _, id := range secret.Manifests {
+			manifests[id] = struct{}{}
+		}
+	}
+
+	devices, err := s.deviceReader.GetDevices(ctx)
+	if err != nil {
+		return []string{}, fmt.Errorf("unable to read devices: %w", err)
+	}
+
+	ids := make([]string, 0)
+	for _, device := range devices {
+		if !device.Registred || device.EnrolStatus == entity.DecommissionedEnrolStatus {
+			continue
+		}
+
+		workloads, err := s.workloadReader.GetWorkloads(ctx, device)
+		if err != nil {
+			return []string{}, fmt.Errorf("unable to read workloads of device %q: %w", device.ID, err)
+		}
+
+		for _, w := range workloads {
+			if _, found := manifests[w.GetID()]; found {
+				ids = append(ids, device.ID)
+				break
+			}
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,173 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package token
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that DeviceReaderMock does implement DeviceReader.
+// If this is not the case, regenerate this file with moq.
+var _ DeviceReader = &DeviceReaderMock{}
+
+// DeviceReaderMock is a mock implementation of DeviceReader.
+//
+// 	func TestSomethingThatUsesDeviceReader(t *testing.T) {
+//
+// 		// make and configure a mocked DeviceReader
+// 		mockedDeviceReader := &DeviceReaderMock{
+// 			GetDefaultNamespaceFunc: func(ctx context.Context) (entity.Namespace, error) {
+// 				panic("mock out the GetDefaultNamespace method")
+// 			},
+// 			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+// 				panic("mock out the GetNamespace method")
+// 			},
+// 			GetSetFunc: func(ctx context.Context, id string) (entity.Set, error) {
+// 				panic("mock out the GetSet method")
+// 			},
+// 		}
+//
+// 		// use mockedDeviceReader in code that requires DeviceReader
+// 		// and then make assertions.
+//
+// 	}
+type DeviceReaderMock struct {
+	// GetDefaultNamespaceFunc mocks the GetDefaultNamespace method.
+	GetDefaultNamespaceFunc func(ctx context.Context) (entity.Namespace, error)
+
+	// GetNamespaceFunc mocks the GetNamespace method.
+	GetNamespaceFunc func(ctx context.Context, id string) (entity.Namespace, error)
+
+	// GetSetFunc mocks the GetSet method.
+	GetSetFunc func(ctx context.Context, id string) (entity.Set, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetDefaultNamespace holds details about calls to the GetDefaultNamespace method.
+		GetDefaultNamespace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetNamespace holds details about calls to the GetNamespace method.
+		GetNamespace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetSet holds details about calls to the GetSet method.
+		GetSet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+	}
+	lockGetDefaultNamespace sync.RWMutex
+	lockGetNamespace        sync.RWMutex
+	lockGetSet              sync.RWMutex
+}
+
+// GetDefaultNamespace calls GetDefaultNamespaceFunc.
+func (mock *DeviceReaderMock) GetDefaultNamespace(ctx context.Context) (entity.Namespace, error) {
+	if mock.GetDefaultNamespaceFunc == nil {
+		panic("DeviceReaderMock.GetDefaultNamespaceFunc: method is nil but DeviceReader.GetDefaultNamespace was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDefaultNamespace.Lock()
+	mock.calls.GetDefaultNamespace = append(mock.calls.GetDefaultNamespace, callInfo)
+	mock.lockGetDefaultNamespace.Unlock()
+	return mock.GetDefaultNamespaceFunc(ctx)
+}
+
+// GetDefaultNamespaceCalls gets all the calls that were made to GetDefaultNamespace.
+// Check the length with:
+//     len(mockedDeviceReader.GetDefaultNamespaceCalls())
+func (mock *DeviceReaderMock) GetDefaultNamespaceCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDefaultNamespace.RLock()
+	calls = mock.calls.GetDefaultNamespace
+	mock.lockGetDefaultNamespace.RUnlock()
+	return calls
+}
+
+// GetNamespace calls GetNamespaceFunc.
+func (mock *DeviceReaderMock) GetNamespace(ctx context.Context, id string) (entity.Namespace, error) {
+	if mock.GetNamespaceFunc == nil {
+		panic("DeviceReaderMock.GetNamespaceFunc: method is nil but DeviceReader.GetNamespace was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetNamespace.Lock()
+	mock.calls.GetNamespace = append(mock.calls.GetNamespace, callInfo)
+	mock.lockGetNamespace.Unlock()
+	return mock.GetNamespaceFunc(ctx, id)
+}
+
+// GetNamespaceCalls gets all the calls that were made to GetNamespace.
+// Check the length with:
+//     len(mockedDeviceReader.GetNamespaceCalls())
+func (mock *DeviceReaderMock) GetNamespaceCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetNamespace.RLock()
+	calls = mock.calls.GetNamespace
+	mock.lockGetNamespace.RUnlock()
+	return calls
+}
+
+// GetSet calls GetSetFunc.
+func (mock *DeviceReaderMock) GetSet(ctx context.Context, id string) (entity.Set, error) {
+	if mock.GetSetFunc == nil {
+		panic("DeviceReaderMock.GetSetFunc: method is nil but DeviceReader.GetSet was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetSet.Lock()
+	mock.calls.GetSet = append(mock.calls.GetSet, callInfo)
+	mock.lockGetSet.Unlock()
+	return mock.GetSetFunc(ctx, id)
+}
+
+// GetSetCalls gets all the calls that were made to GetSet.
+// Check the length with:
+//     len(mockedDeviceReader.GetSetCalls())
+func (mock *DeviceReaderMock) GetSetCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetSet.RLock()
+	calls = mock.calls.GetSet
+	mock.lockGetSet.RUnlock()
+	return calls
+}
@@ -0,0 +1,24 @@
+package token
+
+import (
+	"context"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+//go:generate moq -out token_rw_moq.go . TokenReaderWriter
+type TokenReaderWriter interface {
+	GetToken(ctx context.Context, id string) (entity.EnrolmentToken, error)
+	GetTokens(ctx context.Context) ([]entity.EnrolmentToken, error)
+	CreateToken(ctx context.Context, token entity.EnrolmentToken) error
+	DeleteToken(ctx context.Context, id string) error
+	ConsumeToken(ctx context.Context, hash string) (entity.EnrolmentToken, error)
+	ReleaseToken(ctx context.Context, hash string) error
+}
+
+//go:generate moq -out device_reader_moq.go . DeviceReader
+type DeviceReader interface {
+	GetNamespace(ctx context.Context, id string) (entity.Namespace, error)
+	GetDefaultNamespace(ctx context.Context) (entity.Namespace, error)
+	GetSet(ctx context.Context, id string) (entity.Set, error)
+}
@@ -0,0 +1,147 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultTokenTTL = 24 * time.Hour
+	tokenLength     = 32
+	idLength        = 8
+)
+
+type Service struct {
+	repo         TokenReaderWriter
+	deviceReader DeviceReader
+}
+
+func New(repo TokenReaderWriter, deviceReader DeviceReader) *Service {
+	return &Service{repo, deviceReader}
+}
+
+func (s *Service) GetTokens(ctx context.Context) ([]entity.EnrolmentToken, error) {
+	return s.repo.GetTokens(ctx)
+}
+
+// CreateToken mints a new enrolment token and returns it along with its stored representation.
+// The token is returned only once. If namespaceID is empty, the device is placed in the namespace of the set or
+// in the default namespace if the set is empty too.
+func (s *Service) CreateToken(ctx context.Context, namespaceID string, setID string, maxUses int, ttl time.Duration) (string, entity.EnrolmentToken, error) {
+	if maxUses <= 0 {
+		maxUses = 1
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultTokenTTL
+	}
+
+	t := entity.EnrolmentToken{
+		MaxUses: maxUses,
+	}
+
+	switch {
+	case setID != "":
+		set, err := s.deviceReader.GetSet(ctx, setID)
+		if err != nil {
+			return "", entity.EnrolmentToken{}, err
+		}
+		if namespaceID != "" && set.NamespaceID != namespaceID {
+			return "", entity.EnrolmentToken{}, errService.NewResourceNotFoundErrorWithReason(fmt.Sprintf("set %q not found in namespace %q", setID, namespaceID))
+		}
+		t.NamespaceID = set.NamespaceID
+		t.SetID = &set.Name
+	case namespaceID != "":
+		if _, err := s.deviceReader.GetNamespace(ctx, namespaceID); err != nil {
+			return "", entity.EnrolmentToken{}, err
+		}
+		t.NamespaceID = namespaceID
+	default:
+		namespace, err := s.deviceReader.GetDefaultNamespace(ctx)
+		if err != nil {
+			return "", entity.EnrolmentToken{}, err
+		}
+		t.NamespaceID = namespace.Name
+	}
+
+	id, err := randomBytes(idLength)
+	if err != nil {
+		return "", entity.EnrolmentToken{}, err
+	}
+
+	secret, err := randomBytes(tokenLength)
+	if err != nil {
+		return "", entity.EnrolmentToken{}, err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	t.ID = hex.EncodeToString(id)
+	t.Hash = hash(token)
+	t.CreatedAt = time.Now().UTC()
+	t.ExpiresAt = t.CreatedAt.Add(ttl)
+
+	if err := s.repo.CreateToken(ctx, t); err != nil {
+		return "", entity.EnrolmentToken{}, err
+	}
+
+	zap.S().Infow("enrolment token created", "token_id", t.ID, "namespace_id", t.NamespaceID, "set_id", setID, "max_uses", t.MaxUses, "expires_at", t.ExpiresAt)
+
+	return token, t, nil
+}
+
+func (s *Service) DeleteToken(ctx context.Context, id string) (entity.EnrolmentToken, error) {
+	t, err := s.repo.GetToken(ctx, id)
+	if err != nil {
+		return entity.EnrolmentToken{}, err
+	}
+
+	if err := s.repo.DeleteToken(ctx, id); err != nil {
+		return entity.EnrolmentToken{}, err
+	}
+
+	return t, nil
+}
+
+// ConsumeToken validates the token and uses it once. It returns InvalidEnrolmentTokenError if the token
+// is unknown, expired or it has been used too many times.
+func (s *Service) ConsumeToken(ctx context.Context, token string) (entity.EnrolmentToken, error) {
+	t, err := s.repo.ConsumeToken(ctx, hash(token))
+	if err != nil {
+		if errService.IsResourceNotFound(err) {
+			return entity.EnrolmentToken{}, errService.NewInvalidEnrolmentTokenError(err.Error())
+		}
+		return entity.EnrolmentToken{}, err
+	}
+
+	zap.S().Infow("enrolment token used", "token_id", t.ID, "uses", t.Uses, "max_uses", t.MaxUses)
+
+	return t, nil
+}
+
+// ReleaseToken gives back a use of the token consumed by an enrolment which failed afterwards.
+func (s *Service) ReleaseToken(ctx context.Context, token string) error {
+	return s.repo.ReleaseToken(ctx, hash(token))
+}
+
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("unable to generate random bytes: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,108 @@
+package token_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/token"
+)
+
+var _ = Describe("Token", func() {
+	var (
+		repo         *token.TokenReaderWriterMock
+		deviceReader *token.DeviceReaderMock
+	)
+
+	BeforeEach(func() {
+		repo = &token.TokenReaderWriterMock{
+			CreateTokenFunc: func(ctx context.Context, t entity.EnrolmentToken) error {
+				return nil
+			},
+		}
+		deviceReader = &token.DeviceReaderMock{
+			GetNamespaceFunc: func(ctx context.Context, id string) (entity.Namespace, error) {
+				return entity.Namespace{Name: id}, nil
+			},
+			GetDefaultNamespaceFunc: func(ctx context.Context) (entity.Namespace, error) {
+				return entity.Namespace{Name: "default", IsDefault: true}, nil
+			},
+			GetSetFunc: func(ctx context.Context, id string) (entity.Set, error) {
+				return entity.Set{Name: id, NamespaceID: "namespace"}, nil
+			},
+		}
+	})
+
+	Describe("CreateToken", func() {
+		It("stores only the hash of the token", func() {
+			service := token.New(repo, deviceReader)
+			secret, t, err := service.CreateToken(context.TODO(), "namespace", "", 3, time.Hour)
+			Expect(err).To(BeNil())
+			Expect(secret).NotTo(BeEmpty())
+
+			calls := repo.CreateTokenCalls()
+			Expect(len(calls)).To(Equal(1))
+			sum := sha256.Sum256([]byte(secret))
+			Expect(calls[0].Token.Hash).To(Equal(hex.EncodeToString(sum[:])))
+			Expect(calls[0].Token.Hash).NotTo(ContainSubstring(secret))
+			Expect(t.NamespaceID).To(Equal("namespace"))
+			Expect(t.SetID).To(BeNil())
+			Expect(t.MaxUses).To(Equal(3))
+			Expect(t.ExpiresAt.Sub(t.CreatedAt)).To(Equal(time.Hour))
+		})
+
+		It("uses the namespace of the set", func() {
+			service := token.New(repo, deviceReader)
+			_, t, err := service.CreateToken(context.TODO(), "", "set", 0, 0)
+			Expect(err).To(BeNil())
+			Expect(t.NamespaceID).To(Equal("namespace"))
+			Expect(*t.SetID).To(Equal("set"))
+			Expect(t.MaxUses).To(Equal(1))
+			Expect(t.ExpiresAt.Sub(t.CreatedAt)).To(Equal(token.DefaultTokenTTL))
+		})
+
+		It("uses the default namespace", func() {
+			service := token.New(repo, deviceReader)
+			_, t, err := service.CreateToken(context.TODO(), "", "", 1, time.Hour)
+			Expect(err).To(BeNil())
+			Expect(t.NamespaceID).To(Equal("default"))
+		})
+
+		It("fails when the set is not in the namespace", func() {
+			service := token.New(repo, deviceReader)
+			_, _, err := service.CreateToken(context.TODO(), "other", "set", 1, time.Hour)
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+			Expect(len(repo.CreateTokenCalls())).To(Equal(0))
+		})
+	})
+
+	Describe("ConsumeToken", func() {
+		It("consumes the token by its hash", func() {
+			repo.ConsumeTokenFunc = func(ctx context.Context, hash string) (entity.EnrolmentToken, error) {
+				return entity.EnrolmentToken{ID: "id", NamespaceID: "namespace", Uses: 1, MaxUses: 1}, nil
+			}
+			service := token.New(repo, deviceReader)
+			t, err := service.ConsumeToken(context.TODO(), "secret")
+			Expect(err).To(BeNil())
+			Expect(t.NamespaceID).To(Equal("namespace"))
+
+			sum := sha256.Sum256([]byte("secret"))
+			Expect(repo.ConsumeTokenCalls()[0].Hash).To(Equal(hex.EncodeToString(sum[:])))
+		})
+
+		It("returns invalid token error when the token is unknown, expired or exhausted", func() {
+			repo.ConsumeTokenFunc = func(ctx context.Context, hash string) (entity.EnrolmentToken, error) {
+				return entity.EnrolmentToken{}, errService.NewResourceNotFoundErrorWithReason("enrolment token not found, expired or exhausted")
+			}
+			service := token.New(repo, deviceReader)
+			_, err := service.ConsumeToken(context.TODO(), "secret")
+			Expect(err).To(BeAssignableToTypeOf(errService.InvalidEnrolmentTokenError{}))
+		})
+	})
+})
@@ -0,0 +1,320 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package token
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that TokenReaderWriterMock does implement TokenReaderWriter.
+// If this is not the case, regenerate this file with moq.
+var _ TokenReaderWriter = &TokenReaderWriterMock{}
+
+// TokenReaderWriterMock is a mock implementation of TokenReaderWriter.
+//
+// 	func TestSomethingThatUsesTokenReaderWriter(t *testing.T) {
+//
+// 		// make and configure a mocked TokenReaderWriter
+// 		mockedTokenReaderWriter := &TokenReaderWriterMock{
+// 			ConsumeTokenFunc: func(ctx context.Context, hash string) (entity.EnrolmentToken, error) {
+// 				panic("mock out the ConsumeToken method")
+// 			},
+// 			CreateTokenFunc: func(ctx context.Context, token entity.EnrolmentToken) error {
+// 				panic("mock out the CreateToken method")
+// 			},
+// 			DeleteTokenFunc: func(ctx context.Context, id string) error {
+// 				panic("mock out the DeleteToken method")
+// 			},
+// 			GetTokenFunc: func(ctx context.Context, id string) (entity.EnrolmentToken, error) {
+// 				panic("mock out the GetToken method")
+// 			},
+// 			GetTokensFunc: func(ctx context.Context) ([]entity.EnrolmentToken, error) {
+// 				panic("mock out the GetTokens method")
+// 			},
+// 			ReleaseTokenFunc: func(ctx context.Context, hash string) error {
+// 				panic("mock out the ReleaseToken method")
+// 			},
+// 		}
+//
+// 		// use mockedTokenReaderWriter in code that requires TokenReaderWriter
+// 		// and then make assertions.
+//
+// 	}
+type TokenReaderWriterMock struct {
+	// ConsumeTokenFunc mocks the ConsumeToken method.
+	ConsumeTokenFunc func(ctx context.Context, hash string) (entity.EnrolmentToken, error)
+
+	// CreateTokenFunc mocks the CreateToken method.
+	CreateTokenFunc func(ctx context.Context, token entity.EnrolmentToken) error
+
+	// DeleteTokenFunc mocks the DeleteToken method.
+	DeleteTokenFunc func(ctx context.Context, id string) error
+
+	// GetTokenFunc mocks the GetToken method.
+	GetTokenFunc func(ctx context.Context, id string) (entity.EnrolmentToken, error)
+
+	// GetTokensFunc mocks the GetTokens method.
+	GetTokensFunc func(ctx context.Context) ([]entity.EnrolmentToken, error)
+
+	// ReleaseTokenFunc mocks the ReleaseToken method.
+	ReleaseTokenFunc func(ctx context.Context, hash string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ConsumeToken holds details about calls to the ConsumeToken method.
+		ConsumeToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hash is the hash argument value.
+			Hash string
+		}
+		// CreateToken holds details about calls to the CreateToken method.
+		CreateToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token entity.EnrolmentToken
+		}
+		// DeleteToken holds details about calls to the DeleteToken method.
+		DeleteToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetToken holds details about calls to the GetToken method.
+		GetToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetTokens holds details about calls to the GetTokens method.
+		GetTokens []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ReleaseToken holds details about calls to the ReleaseToken method.
+		ReleaseToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hash is the hash argument value.
+			Hash string
+		}
+	}
+	lockConsumeToken sync.RWMutex
+	lockCreateToken  sync.RWMutex
+	lockDeleteToken  sync.RWMutex
+	lockGetToken     sync.RWMutex
+	lockGetTokens    sync.RWMutex
+	lockReleaseToken sync.RWMutex
+}
+
+// ConsumeToken calls ConsumeTokenFunc.
+func (mock *TokenReaderWriterMock) ConsumeToken(ctx context.Context, hash string) (entity.EnrolmentToken, error) {
+	if mock.ConsumeTokenFunc == nil {
+		panic("TokenReaderWriterMock.ConsumeTokenFunc: method is nil but TokenReaderWriter.ConsumeToken was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Hash string
+	}{
+		Ctx:  ctx,
+		Hash: hash,
+	}
+	mock.lockConsumeToken.Lock()
+	mock.calls.ConsumeToken = append(mock.calls.ConsumeToken, callInfo)
+	mock.lockConsumeToken.Unlock()
+	return mock.ConsumeTokenFunc(ctx, hash)
+}
+
+// ConsumeTokenCalls gets all the calls that were made to ConsumeToken.
+// Check the length with:
+//     len(mockedTokenReaderWriter.ConsumeTokenCalls())
+func (mock *TokenReaderWriterMock) ConsumeTokenCalls() []struct {
+	Ctx  context.Context
+	Hash string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Hash string
+	}
+	mock.lockConsumeToken.RLock()
+	calls = mock.calls.ConsumeToken
+	mock.lockConsumeToken.RUnlock()
+	return calls
+}
+
+// CreateToken calls CreateTokenFunc.
+func (mock *TokenReaderWriterMock) CreateToken(ctx context.Context, token entity.EnrolmentToken) error {
+	if mock.CreateTokenFunc == nil {
+		panic("TokenReaderWriterMock.CreateTokenFunc: method is nil but TokenReaderWriter.CreateToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token entity.EnrolmentToken
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockCreateToken.Lock()
+	mock.calls.CreateToken = append(mock.calls.CreateToken, callInfo)
+	mock.lockCreateToken.Unlock()
+	return mock.CreateTokenFunc(ctx, token)
+}
+
+// CreateTokenCalls gets all the calls that were made to CreateToken.
+// Check the length with:
+//     len(mockedTokenReaderWriter.CreateTokenCalls())
+func (mock *TokenReaderWriterMock) CreateTokenCalls() []struct {
+	Ctx   context.Context
+	Token entity.EnrolmentToken
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token entity.EnrolmentToken
+	}
+	mock.lockCreateToken.RLock()
+	calls = mock.calls.CreateToken
+	mock.lockCreateToken.RUnlock()
+	return calls
+}
+
+// DeleteToken calls DeleteTokenFunc.
+func (mock *TokenReaderWriterMock) DeleteToken(ctx context.Context, id string) error {
+	if mock.DeleteTokenFunc == nil {
+		panic("TokenReaderWriterMock.DeleteTokenFunc: method is nil but TokenReaderWriter.DeleteToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteToken.Lock()
+	mock.calls.DeleteToken = append(mock.calls.DeleteToken, callInfo)
+	mock.lockDeleteToken.Unlock()
+	return mock.DeleteTokenFunc(ctx, id)
+}
+
+// DeleteTokenCalls gets all the calls that were made to DeleteToken.
+// Check the length with:
+//     len(mockedTokenReaderWriter.DeleteTokenCalls())
+func (mock *TokenReaderWriterMock) DeleteTokenCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteToken.RLock()
+	calls = mock.calls.DeleteToken
+	mock.lockDeleteToken.RUnlock()
+	return calls
+}
+
+// GetToken calls GetTokenFunc.
+func (mock *TokenReaderWriterMock) GetToken(ctx context.Context, id string) (entity.EnrolmentToken, error) {
+	if mock.GetTokenFunc == nil {
+		panic("TokenReaderWriterMock.GetTokenFunc: method is nil but TokenReaderWriter.GetToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetToken.Lock()
+	mock.calls.GetToken = append(mock.calls.GetToken, callInfo)
+	mock.lockGetToken.Unlock()
+	return mock.GetTokenFunc(ctx, id)
+}
+
+// GetTokenCalls gets all the calls that were made to GetToken.
+// Check the length with:
+//     len(mockedTokenReaderWriter.GetTokenCalls())
+func (mock *TokenReaderWriterMock) GetTokenCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetToken.RLock()
+	calls = mock.calls.GetToken
+	mock.lockGetToken.RUnlock()
+	return calls
+}
+
+// GetTokens calls GetTokensFunc.
+func (mock *TokenReaderWriterMock) GetTokens(ctx context.Context) ([]entity.EnrolmentToken, error) {
+	if mock.GetTokensFunc == nil {
+		panic("TokenReaderWriterMock.GetTokensFunc: method is nil but TokenReaderWriter.GetTokens was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetTokens.Lock()
+	mock.calls.GetTokens = append(mock.calls.GetTokens, callInfo)
+	mock.lockGetTokens.Unlock()
+	return mock.GetTokensFunc(ctx)
+}
+
+// GetTokensCalls gets all the calls that were made to GetTokens.
+// Check the length with:
+//     len(mockedTokenReaderWriter.GetTokensCalls())
+func (mock *TokenReaderWriterMock) GetTokensCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetTokens.RLock()
+	calls = mock.calls.GetTokens
+	mock.lockGetTokens.RUnlock()
+	return calls
+}
+
+// ReleaseToken calls ReleaseTokenFunc.
+func (mock *TokenReaderWriterMock) ReleaseToken(ctx context.Context, hash string) error {
+	if mock.ReleaseTokenFunc == nil {
+		panic("TokenReaderWriterMock.ReleaseTokenFunc: method is nil but TokenReaderWriter.ReleaseToken was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Hash string
+	}{
+		Ctx:  ctx,
+		Hash: hash,
+	}
+	mock.lockReleaseToken.Lock()
+	mock.calls.ReleaseToken = append(mock.calls.ReleaseToken, callInfo)
+	mock.lockReleaseToken.Unlock()
+	return mock.ReleaseTokenFunc(ctx, hash)
+}
+
+// ReleaseTokenCalls gets all the calls that were made to ReleaseToken.
+// Check the length with:
+//     len(mockedTokenReaderWriter.ReleaseTokenCalls())
+func (mock *TokenReaderWriterMock) ReleaseTokenCalls() []struct {
+	Ctx  context.Context
+	Hash string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Hash string
+	}
+	mock.lockReleaseToken.RLock()
+	calls = mock.calls.ReleaseToken
+	mock.lockReleaseToken.RUnlock()
+	return calls
+}
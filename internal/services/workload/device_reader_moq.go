@@ -0,0 +1,222 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package workload
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that DeviceReaderMock does implement DeviceReader.
+// If this is not the case, regenerate this file with moq.
+var _ DeviceReader = &DeviceReaderMock{}
+
+// DeviceReaderMock is a mock implementation of DeviceReader.
+//
+// 	func TestSomethingThatUsesDeviceReader(t *testing.T) {
+//
+// 		// make and configure a mocked DeviceReader
+// 		mockedDeviceReader := &DeviceReaderMock{
+// 			GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+// 				panic("mock out the GetDevice method")
+// 			},
+// 			GetDevicesFunc: func(ctx context.Context) ([]entity.Device, error) {
+// 				panic("mock out the GetDevices method")
+// 			},
+// 			GetManifestWorkloadStatusesFunc: func(ctx context.Context, manifestID string) ([]entity.WorkloadDeployment, error) {
+// 				panic("mock out the GetManifestWorkloadStatuses method")
+// 			},
+// 			GetWorkloadStatusesFunc: func(ctx context.Context, deviceID string) ([]entity.WorkloadDeployment, error) {
+// 				panic("mock out the GetWorkloadStatuses method")
+// 			},
+// 		}
+//
+// 		// use mockedDeviceReader in code that requires DeviceReader
+// 		// and then make assertions.
+//
+// 	}
+type DeviceReaderMock struct {
+	// GetDeviceFunc mocks the GetDevice method.
+	GetDeviceFunc func(ctx context.Context, id string) (entity.Device, error)
+
+	// GetDevicesFunc mocks the GetDevices method.
+	GetDevicesFunc func(ctx context.Context) ([]entity.Device, error)
+
+	// GetManifestWorkloadStatusesFunc mocks the GetManifestWorkloadStatuses method.
+	GetManifestWorkloadStatusesFunc func(ctx context.Context, manifestID string) ([]entity.WorkloadDeployment, error)
+
+	// GetWorkloadStatusesFunc mocks the GetWorkloadStatuses method.
+	GetWorkloadStatusesFunc func(ctx context.Context, deviceID string) ([]entity.WorkloadDeployment, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetDevice holds details about calls to the GetDevice method.
+		GetDevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetDevices holds details about calls to the GetDevices method.
+		GetDevices []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetManifestWorkloadStatuses holds details about calls to the GetManifestWorkloadStatuses method.
+		GetManifestWorkloadStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ManifestID is the manifestID argument value.
+			ManifestID string
+		}
+		// GetWorkloadStatuses holds details about calls to the GetWorkloadStatuses method.
+		GetWorkloadStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DeviceID is the deviceID argument value.
+			DeviceID string
+		}
+	}
+	lockGetDevice                   sync.RWMutex
+	lockGetDevices                  sync.RWMutex
+	lockGetManifestWorkloadStatuses sync.RWMutex
+	lockGetWorkloadStatuses         sync.RWMutex
+}
+
+// GetDevice calls GetDeviceFunc.
+func (mock *DeviceReaderMock) GetDevice(ctx context.Context, id string) (entity.Device, error) {
+	if mock.GetDeviceFunc == nil {
+		panic("DeviceReaderMock.GetDeviceFunc: method is nil but DeviceReader.GetDevice was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetDevice.Lock()
+	mock.calls.GetDevice = append(mock.calls.GetDevice, callInfo)
+	mock.lockGetDevice.Unlock()
+	return mock.GetDeviceFunc(ctx, id)
+}
+
+// GetDeviceCalls gets all the calls that were made to GetDevice.
+// Check the length with:
+//     len(mockedDeviceReader.GetDeviceCalls())
+func (mock *DeviceReaderMock) GetDeviceCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetDevice.RLock()
+	calls = mock.calls.GetDevice
+	mock.lockGetDevice.RUnlock()
+	return calls
+}
+
+// GetDevices calls GetDevicesFunc.
+func (mock *DeviceReaderMock) GetDevices(ctx context.Context) ([]entity.Device, error) {
+	if mock.GetDevicesFunc == nil {
+		panic("DeviceReaderMock.GetDevicesFunc: method is nil but DeviceReader.GetDevices was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDevices.Lock()
+	mock.calls.GetDevices = append(mock.calls.GetDevices, callInfo)
+	mock.lockGetDevices.Unlock()
+	return mock.GetDevicesFunc(ctx)
+}
+
+// GetDevicesCalls gets all the calls that were made to GetDevices.
+// Check the length with:
+//     len(mockedDeviceReader.GetDevicesCalls())
+func (mock *DeviceReaderMock) GetDevicesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDevices.RLock()
+	calls = mock.calls.GetDevices
+	mock.lockGetDevices.RUnlock()
+	return calls
+}
+
+// GetManifestWorkloadStatuses calls GetManifestWorkloadStatusesFunc.
+func (mock *DeviceReaderMock) GetManifestWorkloadStatuses(ctx context.Context, manifestID string) ([]entity.WorkloadDeployment, error) {
+	if mock.GetManifestWorkloadStatusesFunc == nil {
+		panic("DeviceReaderMock.GetManifestWorkloadStatusesFunc: method is nil but DeviceReader.GetManifestWorkloadStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ManifestID string
+	}{
+		Ctx:        ctx,
+		ManifestID: manifestID,
+	}
+	mock.lockGetManifestWorkloadStatuses.Lock()
+	mock.calls.GetManifestWorkloadStatuses = append(mock.calls.GetManifestWorkloadStatuses, callInfo)
+	mock.lockGetManifestWorkloadStatuses.Unlock()
+	return mock.GetManifestWorkloadStatusesFunc(ctx, manifestID)
+}
+
+// GetManifestWorkloadStatusesCalls gets all the calls that were made to GetManifestWorkloadStatuses.
+// Check the length with:
+//     len(mockedDeviceReader.GetManifestWorkloadStatusesCalls())
+func (mock *DeviceReaderMock) GetManifestWorkloadStatusesCalls() []struct {
+	Ctx        context.Context
+	ManifestID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ManifestID string
+	}
+	mock.lockGetManifestWorkloadStatuses.RLock()
+	calls = mock.calls.GetManifestWorkloadStatuses
+	mock.lockGetManifestWorkloadStatuses.RUnlock()
+	return calls
+}
+
+// GetWorkloadStatuses calls GetWorkloadStatusesFunc.
+func (mock *DeviceReaderMock) GetWorkloadStatuses(ctx context.Context, deviceID string) ([]entity.WorkloadDeployment, error) {
+	if mock.GetWorkloadStatusesFunc == nil {
+		panic("DeviceReaderMock.GetWorkloadStatusesFunc: method is nil but DeviceReader.GetWorkloadStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		DeviceID string
+	}{
+		Ctx:      ctx,
+		DeviceID: deviceID,
+	}
+	mock.lockGetWorkloadStatuses.Lock()
+	mock.calls.GetWorkloadStatuses = append(mock.calls.GetWorkloadStatuses, callInfo)
+	mock.lockGetWorkloadStatuses.Unlock()
+	return mock.GetWorkloadStatusesFunc(ctx, deviceID)
+}
+
+// GetWorkloadStatusesCalls gets all the calls that were made to GetWorkloadStatuses.
+// Check the length with:
+//     len(mockedDeviceReader.GetWorkloadStatusesCalls())
+func (mock *DeviceReaderMock) GetWorkloadStatusesCalls() []struct {
+	Ctx      context.Context
+	DeviceID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		DeviceID string
+	}
+	mock.lockGetWorkloadStatuses.RLock()
+	calls = mock.calls.GetWorkloadStatuses
+	mock.lockGetWorkloadStatuses.RUnlock()
+	return calls
+}
@@ -0,0 +1,25 @@
+package workload
+
+import (
+	"context"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+//go:generate moq -out device_reader_moq.go . DeviceReader
+type DeviceReader interface {
+	GetDevice(ctx context.Context, id string) (entity.Device, error)
+	GetDevices(ctx context.Context) ([]entity.Device, error)
+	GetWorkloadStatuses(ctx context.Context, deviceID string) ([]entity.WorkloadDeployment, error)
+	GetManifestWorkloadStatuses(ctx context.Context, manifestID string) ([]entity.WorkloadDeployment, error)
+}
+
+//go:generate moq -out manifest_reader_moq.go . ManifestReader
+type ManifestReader interface {
+	GetManifest(ctx context.Context, id string) (entity.Manifest, error)
+}
+
+//go:generate moq -out workload_reader_moq.go . WorkloadReader
+type WorkloadReader interface {
+	GetWorkloads(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error)
+}
@@ -0,0 +1,115 @@
+package workload
+
+import (
+	"context"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+)
+
+// Service compares the workloads which should run on the devices with the states reported in the heartbeats.
+type Service struct {
+	deviceReader   DeviceReader
+	manifestReader ManifestReader
+	workloadReader WorkloadReader
+}
+
+func New(deviceReader DeviceReader, manifestReader ManifestReader, workloadReader WorkloadReader) *Service {
+	return &Service{
+		deviceReader:   deviceReader,
+		manifestReader: manifestReader,
+		workloadReader: workloadReader,
+	}
+}
+
+// GetDeviceWorkloads returns the last workload states reported by the device.
+// The states are stale if the device is not online.
+func (s *Service) GetDeviceWorkloads(ctx context.Context, deviceID string) ([]entity.WorkloadDeployment, error) {
+	device, err := s.deviceReader.GetDevice(ctx, deviceID)
+	if err != nil {
+		return []entity.WorkloadDeployment{}, err
+	}
+
+	deployments, err := s.deviceReader.GetWorkloadStatuses(ctx, deviceID)
+	if err != nil {
+		return []entity.WorkloadDeployment{}, err
+	}
+
+	for i := range deployments {
+		deployments[i].Stale = device.State != entity.OnlineDeviceState
+	}
+
+	return deployments, nil
+}
+
+// GetManifestRollout returns the deployment status of the manifest on each device targeted by it.
+// A device is targeted if the manifest is part of its workloads, whether by id, set, namespace or labels.
+func (s *Service) GetManifestRollout(ctx context.Context, manifestID string) (entity.ManifestRollout, error) {
+	if _, err := s.manifestReader.GetManifest(ctx, manifestID); err != nil {
+		return entity.ManifestRollout{}, err
+	}
+
+	devices, err := s.deviceReader.GetDevices(ctx)
+	if err != nil {
+		return entity.ManifestRollout{}, err
+	}
+
+	statuses, err := s.deviceReader.GetManifestWorkloadStatuses(ctx, manifestID)
+	if err != nil {
+		return entity.ManifestRollout{}, err
+	}
+
+	reported := make(map[string]entity.WorkloadDeployment, len(statuses))
+	for _, status := range statuses {
+		reported[status.DeviceID] = status
+	}
+
+	rollout := entity.ManifestRollout{
+		ManifestID:        manifestID,
+		TargetedDevices:   make([]string, 0),
+		Deployments:       make([]entity.WorkloadDeployment, 0, len(statuses)),
+		UnreportedDevices: make([]string, 0),
+	}
+
+	for _, device := range devices {
+		if device.EnrolStatus != entity.EnroledStatus {
+			continue
+		}
+
+		targeted, err := s.isTargeted(ctx, device, manifestID)
+		if err != nil {
+			return entity.ManifestRollout{}, err
+		}
+
+		if !targeted {
+			continue
+		}
+
+		rollout.TargetedDevices = append(rollout.TargetedDevices, device.ID)
+
+		deployment, found := reported[device.ID]
+		if !found {
+			rollout.UnreportedDevices = append(rollout.UnreportedDevices, device.ID)
+			continue
+		}
+
+		deployment.Stale = device.State != entity.OnlineDeviceState
+		rollout.Deployments = append(rollout.Deployments, deployment)
+	}
+
+	return rollout, nil
+}
+
+func (s *Service) isTargeted(ctx context.Context, device entity.Device, manifestID string) (bool, error) {
+	workloads, err := s.workloadReader.GetWorkloads(ctx, device)
+	if err != nil {
+		return false, err
+	}
+
+	for _, w := range workloads {
+		if w.GetID() == manifestID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
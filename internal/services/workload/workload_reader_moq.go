@@ -0,0 +1,81 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package workload
+
+import (
+	"context"
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"sync"
+)
+
+// Ensure, that WorkloadReaderMock does implement WorkloadReader.
+// If this is not the case, regenerate this file with moq.
+var _ WorkloadReader = &WorkloadReaderMock{}
+
+// WorkloadReaderMock is a mock implementation of WorkloadReader.
+//
+// 	func TestSomethingThatUsesWorkloadReader(t *testing.T) {
+//
+// 		// make and configure a mocked WorkloadReader
+// 		mockedWorkloadReader := &WorkloadReaderMock{
+// 			GetWorkloadsFunc: func(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error) {
+// 				panic("mock out the GetWorkloads method")
+// 			},
+// 		}
+//
+// 		// use mockedWorkloadReader in code that requires WorkloadReader
+// 		// and then make assertions.
+//
+// 	}
+type WorkloadReaderMock struct {
+	// GetWorkloadsFunc mocks the GetWorkloads method.
+	GetWorkloadsFunc func(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetWorkloads holds details about calls to the GetWorkloads method.
+		GetWorkloads []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Device is the device argument value.
+			Device entity.Device
+		}
+	}
+	lockGetWorkloads sync.RWMutex
+}
+
+// GetWorkloads calls GetWorkloadsFunc.
+func (mock *WorkloadReaderMock) GetWorkloads(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error) {
+	if mock.GetWorkloadsFunc == nil {
+		panic("WorkloadReaderMock.GetWorkloadsFunc: method is nil but WorkloadReader.GetWorkloads was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Device entity.Device
+	}{
+		Ctx:    ctx,
+		Device: device,
+	}
+	mock.lockGetWorkloads.Lock()
+	mock.calls.GetWorkloads = append(mock.calls.GetWorkloads, callInfo)
+	mock.lockGetWorkloads.Unlock()
+	return mock.GetWorkloadsFunc(ctx, device)
+}
+
+// GetWorkloadsCalls gets all the calls that were made to GetWorkloads.
+// Check the length with:
+//     len(mockedWorkloadReader.GetWorkloadsCalls())
+func (mock *WorkloadReaderMock) GetWorkloadsCalls() []struct {
+	Ctx    context.Context
+	Device entity.Device
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Device entity.Device
+	}
+	mock.lockGetWorkloads.RLock()
+	calls = mock.calls.GetWorkloads
+	mock.lockGetWorkloads.RUnlock()
+	return calls
+}
@@ -0,0 +1,123 @@
+package workload_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
+	"github.com/tupyy/tinyedge-controller/internal/services/workload"
+)
+
+var _ = Describe("Workload status", func() {
+	var (
+		deviceReader   *workload.DeviceReaderMock
+		manifestReader *workload.ManifestReaderMock
+		workloadReader *workload.WorkloadReaderMock
+		service        *workload.Service
+	)
+
+	BeforeEach(func() {
+		devices := map[string]entity.Device{
+			"online":     {ID: "online", EnrolStatus: entity.EnroledStatus, State: entity.OnlineDeviceState},
+			"offline":    {ID: "offline", EnrolStatus: entity.EnroledStatus, State: entity.OfflineDeviceState},
+			"unreported": {ID: "unreported", EnrolStatus: entity.EnroledStatus, State: entity.OnlineDeviceState},
+			"other":      {ID: "other", EnrolStatus: entity.EnroledStatus, State: entity.OnlineDeviceState},
+			"pending":    {ID: "pending", EnrolStatus: entity.PendingEnrolStatus},
+		}
+
+		deviceReader = &workload.DeviceReaderMock{
+			GetDeviceFunc: func(ctx context.Context, id string) (entity.Device, error) {
+				d, ok := devices[id]
+				if !ok {
+					return entity.Device{}, errService.NewResourceNotFoundError("device", id)
+				}
+				return d, nil
+			},
+			GetDevicesFunc: func(ctx context.Context) ([]entity.Device, error) {
+				return []entity.Device{devices["online"], devices["offline"], devices["unreported"], devices["other"], devices["pending"]}, nil
+			},
+			GetWorkloadStatusesFunc: func(ctx context.Context, deviceID string) ([]entity.WorkloadDeployment, error) {
+				return []entity.WorkloadDeployment{{DeviceID: deviceID, ManifestID: "manifest", State: entity.RunningWorkloadState}}, nil
+			},
+			GetManifestWorkloadStatusesFunc: func(ctx context.Context, manifestID string) ([]entity.WorkloadDeployment, error) {
+				return []entity.WorkloadDeployment{
+					{DeviceID: "online", ManifestID: manifestID, State: entity.RunningWorkloadState},
+					{DeviceID: "offline", ManifestID: manifestID, State: entity.CrashedWorkloadState},
+					{DeviceID: "other", ManifestID: manifestID, State: entity.StoppedWorkloadState},
+				}, nil
+			},
+		}
+
+		manifestReader = &workload.ManifestReaderMock{
+			GetManifestFunc: func(ctx context.Context, id string) (entity.Manifest, error) {
+				return entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: id}}, nil
+			},
+		}
+
+		workloadReader = &workload.WorkloadReaderMock{
+			GetWorkloadsFunc: func(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error) {
+				if device.ID == "other" {
+					return []entity.DeviceWorkload{}, nil
+				}
+				return []entity.DeviceWorkload{{ManifestV1: entity.ManifestV1{ObjectMeta: entity.ObjectMeta{Id: "manifest"}}}}, nil
+			},
+		}
+
+		service = workload.New(deviceReader, manifestReader, workloadReader)
+	})
+
+	Context("device", func() {
+		It("returns the states reported by an online device", func() {
+			deployments, err := service.GetDeviceWorkloads(context.TODO(), "online")
+			Expect(err).To(BeNil())
+			Expect(len(deployments)).To(Equal(1))
+			Expect(deployments[0].State).To(Equal(entity.RunningWorkloadState))
+			Expect(deployments[0].Stale).To(BeFalse())
+		})
+
+		It("marks the states of an offline device as stale", func() {
+			deployments, err := service.GetDeviceWorkloads(context.TODO(), "offline")
+			Expect(err).To(BeNil())
+			Expect(len(deployments)).To(Equal(1))
+			Expect(deployments[0].Stale).To(BeTrue())
+		})
+
+		It("returns not found when the device does not exist", func() {
+			_, err := service.GetDeviceWorkloads(context.TODO(), "unknown")
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("manifest", func() {
+		It("computes the rollout of the manifest on the targeted devices", func() {
+			rollout, err := service.GetManifestRollout(context.TODO(), "manifest")
+			Expect(err).To(BeNil())
+			Expect(rollout.TargetedDevices).To(ConsistOf("online", "offline", "unreported"))
+			Expect(rollout.UnreportedDevices).To(ConsistOf("unreported"))
+			Expect(rollout.Count(entity.RunningWorkloadState)).To(Equal(1))
+			Expect(rollout.Count(entity.CrashedWorkloadState)).To(Equal(1))
+			Expect(rollout.Count(entity.StoppedWorkloadState)).To(Equal(0))
+			Expect(rollout.StaleDevices()).To(ConsistOf("offline"))
+		})
+
+		It("returns error when the manifest cannot be read", func() {
+			manifestReader.GetManifestFunc = func(ctx context.Context, id string) (entity.Manifest, error) {
+				return nil, errService.NewResourceNotFoundError("manifest", id)
+			}
+			_, err := service.GetManifestRollout(context.TODO(), "manifest")
+			Expect(errService.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("returns error when the workloads of a device cannot be computed", func() {
+			workloadReader.GetWorkloadsFunc = func(ctx context.Context, device entity.Device) ([]entity.DeviceWorkload, error) {
+				return nil, errors.New("error")
+			}
+			_, err := service.GetManifestRollout(context.TODO(), "manifest")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/services"
+	"go.uber.org/zap"
+)
+
+// CRLWorker periodically refreshes the certificate revocation list used by the auth service.
+type CRLWorker struct {
+	authService *services.Auth
+	period      time.Duration
+	lastRun     time.Time
+}
+
+func NewCRLWorker(a *services.Auth, period time.Duration) *CRLWorker {
+	return &CRLWorker{
+		authService: a,
+		period:      period,
+	}
+}
+
+func (c *CRLWorker) Do(ctx context.Context) error {
+	if time.Since(c.lastRun) < c.period {
+		return nil
+	}
+
+	if err := c.authService.RefreshRevocationList(ctx); err != nil {
+		return err
+	}
+	c.lastRun = time.Now()
+
+	stats := c.authService.CacheStats()
+	zap.S().Debugw("revocation list refreshed", "cache_hits", stats.Hits, "cache_misses", stats.Misses, "cache_degraded", stats.Degraded, "cache_size", stats.Size)
+
+	return nil
+}
+
+func (c *CRLWorker) Name() string {
+	return "crlWorker"
+}
@@ -0,0 +1,51 @@
+package workers
+
+import (
+	"context"
+
+	"github.com/tupyy/tinyedge-controller/internal/entity"
+	"github.com/tupyy/tinyedge-controller/internal/services"
+	"go.uber.org/zap"
+)
+
+// DeviceStateWorker flips the devices between online, late and offline based on their last heartbeat.
+type DeviceStateWorker struct {
+	deviceService *services.Device
+	confService   *services.Configuration
+}
+
+func NewDeviceStateWorker(d *services.Device, c *services.Configuration) *DeviceStateWorker {
+	return &DeviceStateWorker{
+		deviceService: d,
+		confService:   c,
+	}
+}
+
+func (d *DeviceStateWorker) Do(ctx context.Context) error {
+	devices, err := d.deviceService.GetDevices(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, device := range devices {
+		if !device.Registred || device.EnrolStatus == entity.DecommissionedEnrolStatus {
+			continue
+		}
+
+		period, err := d.confService.GetHeartbeatPeriod(ctx, device)
+		if err != nil {
+			zap.S().Errorw("unable to get heartbeat period", "error", err, "device_id", device.ID)
+			continue
+		}
+
+		if _, err := d.deviceService.UpdateDeviceState(ctx, device, period); err != nil {
+			zap.S().Errorw("unable to update device state", "error", err, "device_id", device.ID)
+		}
+	}
+
+	return nil
+}
+
+func (d *DeviceStateWorker) Name() string {
+	return "deviceStateWorker"
+}
@@ -0,0 +1,45 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupyy/tinyedge-controller/internal/services"
+	"go.uber.org/zap"
+)
+
+// SecretRotationWorker periodically checks the secrets in Vault and redeploys the workloads using the rotated ones.
+type SecretRotationWorker struct {
+	secretService *services.Secret
+	period        time.Duration
+	lastRun       time.Time
+}
+
+func NewSecretRotationWorker(s *services.Secret, period time.Duration) *SecretRotationWorker {
+	return &SecretRotationWorker{
+		secretService: s,
+		period:        period,
+	}
+}
+
+func (s *SecretRotationWorker) Do(ctx context.Context) error {
+	if time.Since(s.lastRun) < s.period {
+		return nil
+	}
+
+	rotated, err := s.secretService.RotateSecrets(ctx)
+	if err != nil {
+		return err
+	}
+	s.lastRun = time.Now()
+
+	for _, secret := range rotated {
+		zap.S().Infow("workloads redeployed after secret rotation", "secret_id", secret.ID, "manifests", secret.Manifests)
+	}
+
+	return nil
+}
+
+func (s *SecretRotationWorker) Name() string {
+	return "secretRotationWorker"
+}
@@ -2,79 +2,245 @@ package workers
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.com/tupyy/tinyedge-controller/internal/entity"
 	"github.com/tupyy/tinyedge-controller/internal/services"
 	errService "github.com/tupyy/tinyedge-controller/internal/services/errors"
 	"go.uber.org/zap"
 )
 
+// GitOpsWorker pulls the repositories and updates their manifests. Each repository is pulled on its own schedule
+// as a fallback for the webhooks which sync a repository as soon as it is pushed.
 type GitOpsWorker struct {
 	manifestService   *services.Manifest
 	repositoryService *services.Repository
 	confService       *services.Configuration
+	// webhookPullPeriod is the minimum period between two pulls of the repositories accepting webhooks.
+	webhookPullPeriod time.Duration
+	// lock prevents the webhooks and the polling from syncing a repository at the same time.
+	lock sync.Mutex
+	// pending holds the ids of the repositories whose sync has been requested by a webhook and not started yet.
+	pending     map[string]struct{}
+	pendingLock sync.Mutex
+	// requested wakes up Start when a sync is requested.
+	requested chan struct{}
 }
 
-func NewGitOpsWorker(r *services.Repository, m *services.Manifest, c *services.Configuration) *GitOpsWorker {
+func NewGitOpsWorker(r *services.Repository, m *services.Manifest, c *services.Configuration, webhookPullPeriod time.Duration) *GitOpsWorker {
 	return &GitOpsWorker{
 		manifestService:   m,
 		repositoryService: r,
 		confService:       c,
+		webhookPullPeriod: webhookPullPeriod,
+		pending:           make(map[string]struct{}),
+		requested:         make(chan struct{}, 1),
 	}
 }
 
-func (g *GitOpsWorker) Do(ctx context.Context) error {
-	repos, err := g.repositoryService.GetRepositories(ctx)
-	if err != nil {
-		return err
+// RequestSync asks for the repository to be synced by Start as soon as possible. It does not wait for the sync.
+// The requests received for a repository before its sync starts are served by a single sync.
+func (g *GitOpsWorker) RequestSync(id string) {
+	g.pendingLock.Lock()
+	g.pending[id] = struct{}{}
+	g.pendingLock.Unlock()
+
+	select {
+	case g.requested <- struct{}{}:
+	default:
 	}
+}
 
-	for _, repo := range repos {
-		err := g.repositoryService.Open(ctx, repo)
-		if err != nil {
-			if errService.IsResourceNotFound(err) {
-				// clone it
-				clone, err := g.repositoryService.Clone(ctx, repo)
-				if err != nil {
-					zap.S().Errorw("unable to clone repository", "error", err, "repo_id", repo.Id, "repo_url", repo.Url)
-					continue
+// Start syncs the repositories requested with RequestSync until the context is done.
+func (g *GitOpsWorker) Start(ctx context.Context) {
+	for {
+		select {
+		case <-g.requested:
+			for _, id := range g.takePending() {
+				if ctx.Err() != nil {
+					return
 				}
-				// save the clone and exit the loop
-				if err := g.repositoryService.Update(ctx, clone); err != nil {
-					zap.S().Errorw("unable to update repository", "error", err, "repo_id", repo.Id, "repo_url", repo.Url)
+				if err := g.SyncRepository(ctx, id); err != nil {
+					zap.S().Errorw("unable to sync repository after webhook", "error", err, "repo_id", id)
+					continue
 				}
-				continue
+				zap.S().Infow("repository synced after webhook", "repo_id", id)
 			}
+		case <-ctx.Done():
+			return
 		}
+	}
+}
 
-		r, err := g.repositoryService.PullRepository(ctx, repo)
-		if err != nil {
-			zap.S().Errorw("unable to pull repository", "error", err, "repo_id", repo.Id, "repo_url", repo.Url)
+func (g *GitOpsWorker) takePending() []string {
+	g.pendingLock.Lock()
+	defer g.pendingLock.Unlock()
+
+	ids := make([]string, 0, len(g.pending))
+	for id := range g.pending {
+		ids = append(ids, id)
+	}
+	g.pending = make(map[string]struct{})
+	return ids
+}
+
+func (g *GitOpsWorker) Do(ctx context.Context) error {
+	repos, err := g.repositoryService.GetRepositories(ctx)
+	if err != nil {
+		return err
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	now := time.Now()
+	for _, repo := range repos {
+		if !repo.IsSyncDue(now) {
 			continue
 		}
 
-		if r.TargetHeadSha == r.CurrentHeadSha {
-			zap.S().Debugw("repo is up to date. skipping...", "repo.url", repo.Url, "head_sha", repo.TargetHeadSha)
-			continue
+		if err := g.sync(ctx, repo); err != nil {
+			zap.S().Errorw("unable to sync repository", "error", err, "repo_id", repo.Id, "repo_url", repo.Url)
 		}
 
-		zap.S().Infow("changes detected in repo", "repo_url", repo.Url, "head sha", r.TargetHeadSha, "repo_current_sha", r.CurrentHeadSha)
+		g.scheduleNextSync(ctx, repo)
+	}
+	return nil
+}
 
-		if err := g.manifestService.UpdateManifests(ctx, r); err != nil {
-			zap.S().Errorw("unable to update repository's manifests", "error", err, "repo_id", r.Id, "repo_url", r.Url)
-			continue
-		}
+// SyncRepository pulls the repository and updates its manifests right away. Paused repositories are not synced.
+func (g *GitOpsWorker) SyncRepository(ctx context.Context, id string) error {
+	repo, err := g.repositoryService.GetRepository(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if repo.IsPaused() {
+		zap.S().Debugw("repository is paused. skipping...", "repo_id", repo.Id)
+		return nil
+	}
 
-		// all done. set current sha to target sha
-		r.CurrentHeadSha = r.TargetHeadSha
-		if err := g.repositoryService.Update(ctx, r); err != nil {
-			zap.S().Errorw("unable to update current sha of the repository", "error", err, "repo_id", r.Id)
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if err := g.sync(ctx, repo); err != nil {
+		return err
+	}
+
+	// the repository is up to date so the next pull can wait a whole period.
+	g.scheduleNextSync(ctx, repo)
+	return nil
+}
+
+func (g *GitOpsWorker) scheduleNextSync(ctx context.Context, repo entity.Repository) {
+	next, err := g.repositoryService.ScheduleNextSync(ctx, repo, g.webhookPullPeriod)
+	if err != nil {
+		zap.S().Errorw("unable to schedule the next sync of the repository", "error", err, "repo_id", repo.Id)
+		return
+	}
+	zap.S().Debugw("next sync scheduled", "repo_id", repo.Id, "next_sync_at", next)
+}
+
+// sync pulls the repository and records the run in the sync history of the repository.
+// Pulls which find the repository up to date are not recorded so they do not push the meaningful runs out of the history.
+func (g *GitOpsWorker) sync(ctx context.Context, repo entity.Repository) error {
+	run := entity.NewSyncRun(repo)
+	err := g.pull(ctx, repo, &run)
+	if err == nil && run.ToSha == "" {
+		return nil
+	}
+	// a commit refused because of its signature is verified again at each pull. It is recorded only the first time.
+	if err != nil && repo.RejectedHeadSha != "" && run.ToSha == repo.RejectedHeadSha {
+		return err
+	}
+
+	run.Finish(err)
+	if err := g.repositoryService.RecordSync(ctx, run); err != nil {
+		zap.S().Errorw("unable to record the sync of the repository", "error", err, "repo_id", repo.Id)
+	}
+
+	return err
+}
+
+func (g *GitOpsWorker) pull(ctx context.Context, repo entity.Repository, run *entity.SyncRun) error {
+	r, err := g.fetch(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	if r.TargetHeadSha == r.CurrentHeadSha {
+		zap.S().Debugw("repo is up to date. skipping...", "repo.url", repo.Url, "head_sha", repo.TargetHeadSha)
+		return nil
+	}
+
+	zap.S().Infow("changes detected in repo", "repo_url", repo.Url, "head sha", r.TargetHeadSha, "repo_current_sha", r.CurrentHeadSha)
+	run.ToSha = r.TargetHeadSha
+
+	if err := g.repositoryService.VerifyHead(ctx, r); err != nil {
+		if signatureErr, ok := err.(errService.CommitSignatureError); ok {
+			// keep the current sha and record the rejection
+			r.RejectedHeadSha = r.TargetHeadSha
+			r.RejectionReason = signatureErr.Reason
+			if err := g.repositoryService.UpdateSyncState(ctx, r); err != nil {
+				zap.S().Errorw("unable to record the rejection of the commit", "error", err, "repo_id", r.Id)
+			}
 		}
+		return fmt.Errorf("unable to accept commit %q: %w", r.TargetHeadSha, err)
+	}
+
+	if err := g.repositoryService.Checkout(ctx, r, r.TargetHeadSha); err != nil {
+		return fmt.Errorf("unable to checkout commit %q: %w", r.TargetHeadSha, err)
+	}
 
-		zap.S().Infow("repository and references updated", "repo_id", r.Id, "repo_url", r.Url, "repo_current_sha", r.CurrentHeadSha)
+	changes, err := g.manifestService.UpdateManifests(ctx, r)
+	if err != nil {
+		return fmt.Errorf("unable to update repository's manifests: %w", err)
+	}
+	run.Manifests = changes
+
+	// all done. set current sha to target sha
+	r.CurrentHeadSha = r.TargetHeadSha
+	r.RejectedHeadSha = ""
+	r.RejectionReason = ""
+	if err := g.repositoryService.UpdateSyncState(ctx, r); err != nil {
+		return fmt.Errorf("unable to update current sha of the repository: %w", err)
 	}
+
+	zap.S().Infow("repository and references updated", "repo_id", r.Id, "repo_url", r.Url, "repo_current_sha", r.CurrentHeadSha)
 	return nil
 }
 
+// fetch pulls the repository and resolves the commit followed by it. The repository is cloned if its local clone is missing.
+// The manifests of a new clone are read by the same sync so a new repository does not wait a whole pull period.
+func (g *GitOpsWorker) fetch(ctx context.Context, repo entity.Repository) (entity.Repository, error) {
+	err := g.repositoryService.Open(ctx, repo)
+	if err != nil && errService.IsResourceNotFound(err) {
+		clone, err := g.repositoryService.Clone(ctx, repo)
+		if err != nil {
+			return entity.Repository{}, fmt.Errorf("unable to clone repository: %w", err)
+		}
+		// the working tree of a new clone is empty. Restore the commit accepted before the clone was lost.
+		if clone.CurrentHeadSha != "" {
+			if err := g.repositoryService.Checkout(ctx, clone, clone.CurrentHeadSha); err != nil {
+				return entity.Repository{}, fmt.Errorf("unable to checkout commit %q: %w", clone.CurrentHeadSha, err)
+			}
+		}
+		// save the clone so it is not cloned again if the commit is refused
+		if err := g.repositoryService.UpdateSyncState(ctx, clone); err != nil {
+			return entity.Repository{}, fmt.Errorf("unable to update repository: %w", err)
+		}
+		return clone, nil
+	}
+
+	r, err := g.repositoryService.PullRepository(ctx, repo)
+	if err != nil {
+		return entity.Repository{}, fmt.Errorf("unable to pull repository: %w", err)
+	}
+	return r, nil
+}
+
 func (g *GitOpsWorker) Name() string {
 	return "gitOpsWorker"
 }
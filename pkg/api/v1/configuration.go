@@ -1,8 +1,26 @@
 package v1
 
+// Configuration is the manifest holding the configuration of the devices.
+// A configuration targeting a device overrides the one of its set which overrides the one of its namespace.
+// Only the fields which are set are overridden.
 type Configuration struct {
-	Kind        string `yaml:"kind" validate:"required"`
-	Version     string `yaml:"version"`
-	Name        string `yaml:"name"`
-	Description string `yaml:"description"`
+	Kind        string   `yaml:"kind" validate:"required"`
+	Version     string   `yaml:"version"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Selector    Selector `yaml:"selectors"`
+	// HeartbeatPeriod is a duration like "30s" or "1m".
+	HeartbeatPeriod string    `yaml:"heartbeatPeriod"`
+	LogLevel        string    `yaml:"logLevel"`
+	Profiles        []Profile `yaml:"profiles"`
+}
+
+type Profile struct {
+	Name       string             `yaml:"name"`
+	Conditions []ProfileCondition `yaml:"conditions"`
+}
+
+type ProfileCondition struct {
+	Name       string `yaml:"name"`
+	Expression string `yaml:"expression"`
 }
@@ -11,9 +11,19 @@ type Workload struct {
 }
 
 type Selector struct {
-	Namespaces []string `yaml:"namespaces"`
-	Sets       []string `yaml:"sets"`
-	Devices    []string `yaml:"devices"`
+	Namespaces       []string                   `yaml:"namespaces"`
+	Sets             []string                   `yaml:"sets"`
+	Devices          []string                   `yaml:"devices"`
+	MatchLabels      map[string]string          `yaml:"matchLabels"`
+	MatchExpressions []LabelSelectorRequirement `yaml:"matchExpressions"`
+}
+
+// LabelSelectorRequirement is a selector that contains a key, an operator and values.
+// Operator is one of In, NotIn, Exists or DoesNotExist. Values must be empty for Exists and DoesNotExist.
+type LabelSelectorRequirement struct {
+	Key      string   `yaml:"key"`
+	Operator string   `yaml:"operator"`
+	Values   []string `yaml:"values"`
 }
 
 type Secret struct {
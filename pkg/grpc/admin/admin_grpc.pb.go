@@ -29,6 +29,18 @@ type AdminServiceClient interface {
 	GetDevice(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*common.Device, error)
 	// AddWorkloadToSet add a device to a set.
 	UpdateDevice(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*common.Device, error)
+	// GetPendingDevices returns the list of devices waiting for enrolment approval.
+	GetPendingDevices(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*DevicesListResponse, error)
+	// ApproveDevice enrols a pending device. Optionally, the device is placed in a namespace and/or set.
+	ApproveDevice(ctx context.Context, in *ApproveDeviceRequest, opts ...grpc.CallOption) (*common.Device, error)
+	// RefuseDevice refuses the enrolment of a pending device.
+	RefuseDevice(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*common.Device, error)
+	// DecommissionDevice revokes the certificate of the device and removes it.
+	DecommissionDevice(ctx context.Context, in *DecommissionDeviceRequest, opts ...grpc.CallOption) (*common.Device, error)
+	// UpdateDeviceLabels adds, updates or removes labels of a device.
+	UpdateDeviceLabels(ctx context.Context, in *UpdateDeviceLabelsRequest, opts ...grpc.CallOption) (*common.Device, error)
+	// UpdateVariables adds, updates or removes the template variables of a namespace, set or device.
+	UpdateVariables(ctx context.Context, in *UpdateVariablesRequest, opts ...grpc.CallOption) (*Variables, error)
 	// GetSets returns a list of device sets.
 	GetSets(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SetsListResponse, error)
 	// GetSet returns a device set.
@@ -51,10 +63,32 @@ type AdminServiceClient interface {
 	GetManifests(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ManifestListResponse, error)
 	// GetManifest return a manifests
 	GetManifest(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Manifest, error)
+	// GetManifestRollout returns the status of the manifest's workload on the devices targeted by it.
+	GetManifestRollout(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*ManifestRollout, error)
+	// GetDeviceWorkloads returns the status of the workloads reported by the device.
+	GetDeviceWorkloads(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*DeviceWorkloadsResponse, error)
 	// GetRepositories return a list of repositories
 	GetRepositories(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*RepositoryListResponse, error)
 	// AddRepository add a repository
 	AddRepository(ctx context.Context, in *AddRepositoryRequest, opts ...grpc.CallOption) (*AddRepositoryResponse, error)
+	// UpdateRepositoryRef changes the branch, the tag pattern or the commit followed by a repository.
+	UpdateRepositoryRef(ctx context.Context, in *UpdateRepositoryRefRequest, opts ...grpc.CallOption) (*Repository, error)
+	// UpdateRepositoryPullPeriod changes the pull period of a repository. A period of 0 pauses the repository.
+	UpdateRepositoryPullPeriod(ctx context.Context, in *UpdateRepositoryPullPeriodRequest, opts ...grpc.CallOption) (*Repository, error)
+	// UpdateRepository changes the url, the credentials, the secret paths or the scope of a repository.
+	UpdateRepository(ctx context.Context, in *UpdateRepositoryRequest, opts ...grpc.CallOption) (*Repository, error)
+	// DeleteRepository removes a repository, its manifests and its local clone.
+	DeleteRepository(ctx context.Context, in *DeleteRepositoryRequest, opts ...grpc.CallOption) (*Repository, error)
+	// GetRepositorySyncHistory returns the last sync runs of a repository starting with the most recent one.
+	GetRepositorySyncHistory(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*RepositorySyncHistory, error)
+	// AddEnrolmentToken mints a new enrolment token. The token is returned only once.
+	AddEnrolmentToken(ctx context.Context, in *AddEnrolmentTokenRequest, opts ...grpc.CallOption) (*EnrolmentToken, error)
+	// GetEnrolmentTokens returns the list of enrolment tokens.
+	GetEnrolmentTokens(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*EnrolmentTokenListResponse, error)
+	// DeleteEnrolmentToken removes an enrolment token.
+	DeleteEnrolmentToken(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*EnrolmentToken, error)
+	// GetAuthCacheStats returns the counters of the device certificate cache.
+	GetAuthCacheStats(ctx context.Context, in *common.Empty, opts ...grpc.CallOption) (*AuthCacheStats, error)
 }
 
 type adminServiceClient struct {
@@ -92,6 +126,60 @@ func (c *adminServiceClient) UpdateDevice(ctx context.Context, in *UpdateDeviceR
 	return out, nil
 }
 
+func (c *adminServiceClient) GetPendingDevices(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*DevicesListResponse, error) {
+	out := new(DevicesListResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/GetPendingDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApproveDevice(ctx context.Context, in *ApproveDeviceRequest, opts ...grpc.CallOption) (*common.Device, error) {
+	out := new(common.Device)
+	err := c.cc.Invoke(ctx, "/AdminService/ApproveDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RefuseDevice(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*common.Device, error) {
+	out := new(common.Device)
+	err := c.cc.Invoke(ctx, "/AdminService/RefuseDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DecommissionDevice(ctx context.Context, in *DecommissionDeviceRequest, opts ...grpc.CallOption) (*common.Device, error) {
+	out := new(common.Device)
+	err := c.cc.Invoke(ctx, "/AdminService/DecommissionDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateDeviceLabels(ctx context.Context, in *UpdateDeviceLabelsRequest, opts ...grpc.CallOption) (*common.Device, error) {
+	out := new(common.Device)
+	err := c.cc.Invoke(ctx, "/AdminService/UpdateDeviceLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateVariables(ctx context.Context, in *UpdateVariablesRequest, opts ...grpc.CallOption) (*Variables, error) {
+	out := new(Variables)
+	err := c.cc.Invoke(ctx, "/AdminService/UpdateVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetSets(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SetsListResponse, error) {
 	out := new(SetsListResponse)
 	err := c.cc.Invoke(ctx, "/AdminService/GetSets", in, out, opts...)
@@ -191,6 +279,24 @@ func (c *adminServiceClient) GetManifest(ctx context.Context, in *IdRequest, opt
 	return out, nil
 }
 
+func (c *adminServiceClient) GetManifestRollout(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*ManifestRollout, error) {
+	out := new(ManifestRollout)
+	err := c.cc.Invoke(ctx, "/AdminService/GetManifestRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDeviceWorkloads(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*DeviceWorkloadsResponse, error) {
+	out := new(DeviceWorkloadsResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/GetDeviceWorkloads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetRepositories(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*RepositoryListResponse, error) {
 	out := new(RepositoryListResponse)
 	err := c.cc.Invoke(ctx, "/AdminService/GetRepositories", in, out, opts...)
@@ -209,6 +315,87 @@ func (c *adminServiceClient) AddRepository(ctx context.Context, in *AddRepositor
 	return out, nil
 }
 
+func (c *adminServiceClient) UpdateRepositoryRef(ctx context.Context, in *UpdateRepositoryRefRequest, opts ...grpc.CallOption) (*Repository, error) {
+	out := new(Repository)
+	err := c.cc.Invoke(ctx, "/AdminService/UpdateRepositoryRef", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateRepositoryPullPeriod(ctx context.Context, in *UpdateRepositoryPullPeriodRequest, opts ...grpc.CallOption) (*Repository, error) {
+	out := new(Repository)
+	err := c.cc.Invoke(ctx, "/AdminService/UpdateRepositoryPullPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateRepository(ctx context.Context, in *UpdateRepositoryRequest, opts ...grpc.CallOption) (*Repository, error) {
+	out := new(Repository)
+	err := c.cc.Invoke(ctx, "/AdminService/UpdateRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteRepository(ctx context.Context, in *DeleteRepositoryRequest, opts ...grpc.CallOption) (*Repository, error) {
+	out := new(Repository)
+	err := c.cc.Invoke(ctx, "/AdminService/DeleteRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetRepositorySyncHistory(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*RepositorySyncHistory, error) {
+	out := new(RepositorySyncHistory)
+	err := c.cc.Invoke(ctx, "/AdminService/GetRepositorySyncHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddEnrolmentToken(ctx context.Context, in *AddEnrolmentTokenRequest, opts ...grpc.CallOption) (*EnrolmentToken, error) {
+	out := new(EnrolmentToken)
+	err := c.cc.Invoke(ctx, "/AdminService/AddEnrolmentToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetEnrolmentTokens(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*EnrolmentTokenListResponse, error) {
+	out := new(EnrolmentTokenListResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/GetEnrolmentTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteEnrolmentToken(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*EnrolmentToken, error) {
+	out := new(EnrolmentToken)
+	err := c.cc.Invoke(ctx, "/AdminService/DeleteEnrolmentToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAuthCacheStats(ctx context.Context, in *common.Empty, opts ...grpc.CallOption) (*AuthCacheStats, error) {
+	out := new(AuthCacheStats)
+	err := c.cc.Invoke(ctx, "/AdminService/GetAuthCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -219,6 +406,18 @@ type AdminServiceServer interface {
 	GetDevice(context.Context, *IdRequest) (*common.Device, error)
 	// AddWorkloadToSet add a device to a set.
 	UpdateDevice(context.Context, *UpdateDeviceRequest) (*common.Device, error)
+	// GetPendingDevices returns the list of devices waiting for enrolment approval.
+	GetPendingDevices(context.Context, *ListRequest) (*DevicesListResponse, error)
+	// ApproveDevice enrols a pending device. Optionally, the device is placed in a namespace and/or set.
+	ApproveDevice(context.Context, *ApproveDeviceRequest) (*common.Device, error)
+	// RefuseDevice refuses the enrolment of a pending device.
+	RefuseDevice(context.Context, *IdRequest) (*common.Device, error)
+	// DecommissionDevice revokes the certificate of the device and removes it.
+	DecommissionDevice(context.Context, *DecommissionDeviceRequest) (*common.Device, error)
+	// UpdateDeviceLabels adds, updates or removes labels of a device.
+	UpdateDeviceLabels(context.Context, *UpdateDeviceLabelsRequest) (*common.Device, error)
+	// UpdateVariables adds, updates or removes the template variables of a namespace, set or device.
+	UpdateVariables(context.Context, *UpdateVariablesRequest) (*Variables, error)
 	// GetSets returns a list of device sets.
 	GetSets(context.Context, *ListRequest) (*SetsListResponse, error)
 	// GetSet returns a device set.
@@ -241,10 +440,32 @@ type AdminServiceServer interface {
 	GetManifests(context.Context, *ListRequest) (*ManifestListResponse, error)
 	// GetManifest return a manifests
 	GetManifest(context.Context, *IdRequest) (*Manifest, error)
+	// GetManifestRollout returns the status of the manifest's workload on the devices targeted by it.
+	GetManifestRollout(context.Context, *IdRequest) (*ManifestRollout, error)
+	// GetDeviceWorkloads returns the status of the workloads reported by the device.
+	GetDeviceWorkloads(context.Context, *IdRequest) (*DeviceWorkloadsResponse, error)
 	// GetRepositories return a list of repositories
 	GetRepositories(context.Context, *ListRequest) (*RepositoryListResponse, error)
 	// AddRepository add a repository
 	AddRepository(context.Context, *AddRepositoryRequest) (*AddRepositoryResponse, error)
+	// UpdateRepositoryRef changes the branch, the tag pattern or the commit followed by a repository.
+	UpdateRepositoryRef(context.Context, *UpdateRepositoryRefRequest) (*Repository, error)
+	// UpdateRepositoryPullPeriod changes the pull period of a repository. A period of 0 pauses the repository.
+	UpdateRepositoryPullPeriod(context.Context, *UpdateRepositoryPullPeriodRequest) (*Repository, error)
+	// UpdateRepository changes the url, the credentials, the secret paths or the scope of a repository.
+	UpdateRepository(context.Context, *UpdateRepositoryRequest) (*Repository, error)
+	// DeleteRepository removes a repository, its manifests and its local clone.
+	DeleteRepository(context.Context, *DeleteRepositoryRequest) (*Repository, error)
+	// GetRepositorySyncHistory returns the last sync runs of a repository starting with the most recent one.
+	GetRepositorySyncHistory(context.Context, *IdRequest) (*RepositorySyncHistory, error)
+	// AddEnrolmentToken mints a new enrolment token. The token is returned only once.
+	AddEnrolmentToken(context.Context, *AddEnrolmentTokenRequest) (*EnrolmentToken, error)
+	// GetEnrolmentTokens returns the list of enrolment tokens.
+	GetEnrolmentTokens(context.Context, *ListRequest) (*EnrolmentTokenListResponse, error)
+	// DeleteEnrolmentToken removes an enrolment token.
+	DeleteEnrolmentToken(context.Context, *IdRequest) (*EnrolmentToken, error)
+	// GetAuthCacheStats returns the counters of the device certificate cache.
+	GetAuthCacheStats(context.Context, *common.Empty) (*AuthCacheStats, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -261,6 +482,24 @@ func (UnimplementedAdminServiceServer) GetDevice(context.Context, *IdRequest) (*
 func (UnimplementedAdminServiceServer) UpdateDevice(context.Context, *UpdateDeviceRequest) (*common.Device, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDevice not implemented")
 }
+func (UnimplementedAdminServiceServer) GetPendingDevices(context.Context, *ListRequest) (*DevicesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingDevices not implemented")
+}
+func (UnimplementedAdminServiceServer) ApproveDevice(context.Context, *ApproveDeviceRequest) (*common.Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDevice not implemented")
+}
+func (UnimplementedAdminServiceServer) RefuseDevice(context.Context, *IdRequest) (*common.Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefuseDevice not implemented")
+}
+func (UnimplementedAdminServiceServer) DecommissionDevice(context.Context, *DecommissionDeviceRequest) (*common.Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionDevice not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateDeviceLabels(context.Context, *UpdateDeviceLabelsRequest) (*common.Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeviceLabels not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateVariables(context.Context, *UpdateVariablesRequest) (*Variables, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVariables not implemented")
+}
 func (UnimplementedAdminServiceServer) GetSets(context.Context, *ListRequest) (*SetsListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSets not implemented")
 }
@@ -294,12 +533,45 @@ func (UnimplementedAdminServiceServer) GetManifests(context.Context, *ListReques
 func (UnimplementedAdminServiceServer) GetManifest(context.Context, *IdRequest) (*Manifest, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetManifest not implemented")
 }
+func (UnimplementedAdminServiceServer) GetManifestRollout(context.Context, *IdRequest) (*ManifestRollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManifestRollout not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDeviceWorkloads(context.Context, *IdRequest) (*DeviceWorkloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceWorkloads not implemented")
+}
 func (UnimplementedAdminServiceServer) GetRepositories(context.Context, *ListRequest) (*RepositoryListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRepositories not implemented")
 }
 func (UnimplementedAdminServiceServer) AddRepository(context.Context, *AddRepositoryRequest) (*AddRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddRepository not implemented")
 }
+func (UnimplementedAdminServiceServer) UpdateRepositoryRef(context.Context, *UpdateRepositoryRefRequest) (*Repository, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepositoryRef not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateRepositoryPullPeriod(context.Context, *UpdateRepositoryPullPeriodRequest) (*Repository, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepositoryPullPeriod not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateRepository(context.Context, *UpdateRepositoryRequest) (*Repository, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepository not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteRepository(context.Context, *DeleteRepositoryRequest) (*Repository, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepository not implemented")
+}
+func (UnimplementedAdminServiceServer) GetRepositorySyncHistory(context.Context, *IdRequest) (*RepositorySyncHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepositorySyncHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) AddEnrolmentToken(context.Context, *AddEnrolmentTokenRequest) (*EnrolmentToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEnrolmentToken not implemented")
+}
+func (UnimplementedAdminServiceServer) GetEnrolmentTokens(context.Context, *ListRequest) (*EnrolmentTokenListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrolmentTokens not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteEnrolmentToken(context.Context, *IdRequest) (*EnrolmentToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnrolmentToken not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAuthCacheStats(context.Context, *common.Empty) (*AuthCacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthCacheStats not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -367,6 +639,114 @@ func _AdminService_UpdateDevice_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetPendingDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPendingDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetPendingDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPendingDevices(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApproveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApproveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/ApproveDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApproveDevice(ctx, req.(*ApproveDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RefuseDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RefuseDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/RefuseDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RefuseDevice(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DecommissionDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DecommissionDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/DecommissionDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DecommissionDevice(ctx, req.(*DecommissionDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDeviceLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDeviceLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/UpdateDeviceLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDeviceLabels(ctx, req.(*UpdateDeviceLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/UpdateVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateVariables(ctx, req.(*UpdateVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRequest)
 	if err := dec(in); err != nil {
@@ -565,6 +945,42 @@ func _AdminService_GetManifest_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetManifestRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetManifestRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetManifestRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetManifestRollout(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDeviceWorkloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDeviceWorkloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetDeviceWorkloads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDeviceWorkloads(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRequest)
 	if err := dec(in); err != nil {
@@ -601,6 +1017,168 @@ func _AdminService_AddRepository_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UpdateRepositoryRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepositoryRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateRepositoryRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/UpdateRepositoryRef",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateRepositoryRef(ctx, req.(*UpdateRepositoryRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateRepositoryPullPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepositoryPullPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateRepositoryPullPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/UpdateRepositoryPullPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateRepositoryPullPeriod(ctx, req.(*UpdateRepositoryPullPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/UpdateRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateRepository(ctx, req.(*UpdateRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/DeleteRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteRepository(ctx, req.(*DeleteRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetRepositorySyncHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetRepositorySyncHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetRepositorySyncHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetRepositorySyncHistory(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddEnrolmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEnrolmentTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddEnrolmentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/AddEnrolmentToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddEnrolmentToken(ctx, req.(*AddEnrolmentTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetEnrolmentTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetEnrolmentTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetEnrolmentTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetEnrolmentTokens(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteEnrolmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteEnrolmentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/DeleteEnrolmentToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteEnrolmentToken(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAuthCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAuthCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetAuthCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAuthCacheStats(ctx, req.(*common.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -620,6 +1198,30 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDevice",
 			Handler:    _AdminService_UpdateDevice_Handler,
 		},
+		{
+			MethodName: "GetPendingDevices",
+			Handler:    _AdminService_GetPendingDevices_Handler,
+		},
+		{
+			MethodName: "ApproveDevice",
+			Handler:    _AdminService_ApproveDevice_Handler,
+		},
+		{
+			MethodName: "RefuseDevice",
+			Handler:    _AdminService_RefuseDevice_Handler,
+		},
+		{
+			MethodName: "DecommissionDevice",
+			Handler:    _AdminService_DecommissionDevice_Handler,
+		},
+		{
+			MethodName: "UpdateDeviceLabels",
+			Handler:    _AdminService_UpdateDeviceLabels_Handler,
+		},
+		{
+			MethodName: "UpdateVariables",
+			Handler:    _AdminService_UpdateVariables_Handler,
+		},
 		{
 			MethodName: "GetSets",
 			Handler:    _AdminService_GetSets_Handler,
@@ -664,6 +1266,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetManifest",
 			Handler:    _AdminService_GetManifest_Handler,
 		},
+		{
+			MethodName: "GetManifestRollout",
+			Handler:    _AdminService_GetManifestRollout_Handler,
+		},
+		{
+			MethodName: "GetDeviceWorkloads",
+			Handler:    _AdminService_GetDeviceWorkloads_Handler,
+		},
 		{
 			MethodName: "GetRepositories",
 			Handler:    _AdminService_GetRepositories_Handler,
@@ -672,6 +1282,42 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddRepository",
 			Handler:    _AdminService_AddRepository_Handler,
 		},
+		{
+			MethodName: "UpdateRepositoryRef",
+			Handler:    _AdminService_UpdateRepositoryRef_Handler,
+		},
+		{
+			MethodName: "UpdateRepositoryPullPeriod",
+			Handler:    _AdminService_UpdateRepositoryPullPeriod_Handler,
+		},
+		{
+			MethodName: "UpdateRepository",
+			Handler:    _AdminService_UpdateRepository_Handler,
+		},
+		{
+			MethodName: "DeleteRepository",
+			Handler:    _AdminService_DeleteRepository_Handler,
+		},
+		{
+			MethodName: "GetRepositorySyncHistory",
+			Handler:    _AdminService_GetRepositorySyncHistory_Handler,
+		},
+		{
+			MethodName: "AddEnrolmentToken",
+			Handler:    _AdminService_AddEnrolmentToken_Handler,
+		},
+		{
+			MethodName: "GetEnrolmentTokens",
+			Handler:    _AdminService_GetEnrolmentTokens_Handler,
+		},
+		{
+			MethodName: "DeleteEnrolmentToken",
+			Handler:    _AdminService_DeleteEnrolmentToken_Handler,
+		},
+		{
+			MethodName: "GetAuthCacheStats",
+			Handler:    _AdminService_GetAuthCacheStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
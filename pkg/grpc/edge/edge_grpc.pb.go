@@ -28,9 +28,15 @@ type EdgeServiceClient interface {
 	// Register is called by a worker to indicate it is ready and capable of
 	// handling the specified type of work.
 	Register(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*RegistrationResponse, error)
+	// RenewCertificate is called by a registered device to renew its certificate before it expires.
+	// The device must authenticate with its current certificate.
+	RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*RegistrationResponse, error)
 	// GetConfig can be called by a worker to get the current configuration
 	// state of the dispatcher service.
 	GetConfiguration(ctx context.Context, in *ConfigurationRequest, opts ...grpc.CallOption) (*ConfigurationResponse, error)
+	// WatchConfiguration sends the configuration of the device when the stream is opened and then
+	// each time the configuration changes.
+	WatchConfiguration(ctx context.Context, in *ConfigurationRequest, opts ...grpc.CallOption) (EdgeService_WatchConfigurationClient, error)
 	// Heartbeat is called by the worker to send the heartbeat information.
 	Heartbeat(ctx context.Context, in *common.HeartbeatInfo, opts ...grpc.CallOption) (*common.Empty, error)
 }
@@ -61,6 +67,15 @@ func (c *edgeServiceClient) Register(ctx context.Context, in *RegistrationReques
 	return out, nil
 }
 
+func (c *edgeServiceClient) RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*RegistrationResponse, error) {
+	out := new(RegistrationResponse)
+	err := c.cc.Invoke(ctx, "/EdgeService/RenewCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *edgeServiceClient) GetConfiguration(ctx context.Context, in *ConfigurationRequest, opts ...grpc.CallOption) (*ConfigurationResponse, error) {
 	out := new(ConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/EdgeService/GetConfiguration", in, out, opts...)
@@ -70,6 +85,38 @@ func (c *edgeServiceClient) GetConfiguration(ctx context.Context, in *Configurat
 	return out, nil
 }
 
+func (c *edgeServiceClient) WatchConfiguration(ctx context.Context, in *ConfigurationRequest, opts ...grpc.CallOption) (EdgeService_WatchConfigurationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EdgeService_ServiceDesc.Streams[0], "/EdgeService/WatchConfiguration", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &edgeServiceWatchConfigurationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EdgeService_WatchConfigurationClient interface {
+	Recv() (*ConfigurationResponse, error)
+	grpc.ClientStream
+}
+
+type edgeServiceWatchConfigurationClient struct {
+	grpc.ClientStream
+}
+
+func (x *edgeServiceWatchConfigurationClient) Recv() (*ConfigurationResponse, error) {
+	m := new(ConfigurationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *edgeServiceClient) Heartbeat(ctx context.Context, in *common.HeartbeatInfo, opts ...grpc.CallOption) (*common.Empty, error) {
 	out := new(common.Empty)
 	err := c.cc.Invoke(ctx, "/EdgeService/Heartbeat", in, out, opts...)
@@ -88,9 +135,15 @@ type EdgeServiceServer interface {
 	// Register is called by a worker to indicate it is ready and capable of
 	// handling the specified type of work.
 	Register(context.Context, *RegistrationRequest) (*RegistrationResponse, error)
+	// RenewCertificate is called by a registered device to renew its certificate before it expires.
+	// The device must authenticate with its current certificate.
+	RenewCertificate(context.Context, *RenewCertificateRequest) (*RegistrationResponse, error)
 	// GetConfig can be called by a worker to get the current configuration
 	// state of the dispatcher service.
 	GetConfiguration(context.Context, *ConfigurationRequest) (*ConfigurationResponse, error)
+	// WatchConfiguration sends the configuration of the device when the stream is opened and then
+	// each time the configuration changes.
+	WatchConfiguration(*ConfigurationRequest, EdgeService_WatchConfigurationServer) error
 	// Heartbeat is called by the worker to send the heartbeat information.
 	Heartbeat(context.Context, *common.HeartbeatInfo) (*common.Empty, error)
 	mustEmbedUnimplementedEdgeServiceServer()
@@ -106,9 +159,15 @@ func (UnimplementedEdgeServiceServer) Enrol(context.Context, *EnrolRequest) (*En
 func (UnimplementedEdgeServiceServer) Register(context.Context, *RegistrationRequest) (*RegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (UnimplementedEdgeServiceServer) RenewCertificate(context.Context, *RenewCertificateRequest) (*RegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
 func (UnimplementedEdgeServiceServer) GetConfiguration(context.Context, *ConfigurationRequest) (*ConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
+func (UnimplementedEdgeServiceServer) WatchConfiguration(*ConfigurationRequest, EdgeService_WatchConfigurationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfiguration not implemented")
+}
 func (UnimplementedEdgeServiceServer) Heartbeat(context.Context, *common.HeartbeatInfo) (*common.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
@@ -161,6 +220,24 @@ func _EdgeService_Register_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EdgeService_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EdgeServiceServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EdgeService/RenewCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EdgeServiceServer).RenewCertificate(ctx, req.(*RenewCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EdgeService_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConfigurationRequest)
 	if err := dec(in); err != nil {
@@ -179,6 +256,27 @@ func _EdgeService_GetConfiguration_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EdgeService_WatchConfiguration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConfigurationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EdgeServiceServer).WatchConfiguration(m, &edgeServiceWatchConfigurationServer{stream})
+}
+
+type EdgeService_WatchConfigurationServer interface {
+	Send(*ConfigurationResponse) error
+	grpc.ServerStream
+}
+
+type edgeServiceWatchConfigurationServer struct {
+	grpc.ServerStream
+}
+
+func (x *edgeServiceWatchConfigurationServer) Send(m *ConfigurationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _EdgeService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(common.HeartbeatInfo)
 	if err := dec(in); err != nil {
@@ -212,6 +310,10 @@ var EdgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Register",
 			Handler:    _EdgeService_Register_Handler,
 		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _EdgeService_RenewCertificate_Handler,
+		},
 		{
 			MethodName: "GetConfiguration",
 			Handler:    _EdgeService_GetConfiguration_Handler,
@@ -221,6 +323,12 @@ var EdgeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _EdgeService_Heartbeat_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchConfiguration",
+			Handler:       _EdgeService_WatchConfiguration_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "edge.proto",
 }
@@ -1 +1,109 @@
 package models
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	goyaml "github.com/go-yaml/yaml"
+)
+
+// Kind is the kind of a Kubernetes object supported in the workloads.
+type Kind string
+
+const (
+	PodKind       Kind = "Pod"
+	ConfigMapKind Kind = "ConfigMap"
+	SecretKind    Kind = "Secret"
+)
+
+// Object is a validated Kubernetes object read from a resource file.
+type Object struct {
+	Kind Kind
+	Name string
+	// Content is the yaml document of the object. Comments are not kept.
+	Content []byte
+}
+
+// Unmarshal parses the yaml documents of content as Kubernetes Pods, ConfigMaps or Secrets and validates them.
+// Empty documents are ignored.
+func Unmarshal(content []byte) ([]Object, error) {
+	objects := make([]Object, 0, 1)
+
+	decoder := goyaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var document interface{}
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid yaml: %w", err)
+		}
+		if document == nil {
+			continue
+		}
+
+		data, err := goyaml.Marshal(document)
+		if err != nil {
+			return nil, err
+		}
+
+		o, err := unmarshalObject(data)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", len(objects)+1, err)
+		}
+		objects = append(objects, o)
+	}
+
+	if len(objects) == 0 {
+		return nil, errors.New("no kubernetes object found")
+	}
+
+	return objects, nil
+}
+
+func unmarshalObject(data []byte) (Object, error) {
+	var meta TypeMeta
+	if err := goyaml.Unmarshal(data, &meta); err != nil {
+		return Object{}, err
+	}
+
+	if meta.APIVersion != "v1" {
+		return Object{}, fmt.Errorf("unsupported apiVersion %q for %s", meta.APIVersion, meta.Kind)
+	}
+
+	var (
+		name     string
+		validate func() error
+	)
+	switch Kind(meta.Kind) {
+	case PodKind:
+		var p Pod
+		if err := goyaml.Unmarshal(data, &p); err != nil {
+			return Object{}, err
+		}
+		name, validate = p.Metadata.Name, p.Validate
+	case ConfigMapKind:
+		var c ConfigMap
+		if err := goyaml.Unmarshal(data, &c); err != nil {
+			return Object{}, err
+		}
+		name, validate = c.Metadata.Name, c.Validate
+	case SecretKind:
+		var s Secret
+		if err := goyaml.Unmarshal(data, &s); err != nil {
+			return Object{}, err
+		}
+		name, validate = s.Metadata.Name, s.Validate
+	default:
+		return Object{}, fmt.Errorf("unsupported kind %q", meta.Kind)
+	}
+
+	if err := validate(); err != nil {
+		return Object{}, err
+	}
+
+	return Object{Kind: Kind(meta.Kind), Name: name, Content: data}, nil
+}
@@ -1 +1,126 @@
 package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TypeMeta holds the api version and kind of a Kubernetes object.
+type TypeMeta struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+}
+
+// ObjectMeta holds the subset of the Kubernetes object metadata used by the controller.
+type ObjectMeta struct {
+	Name        string            `yaml:"name"`
+	Namespace   string            `yaml:"namespace"`
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+}
+
+// Pod is the subset of a Kubernetes Pod validated by the controller. The device receives the whole document.
+type Pod struct {
+	TypeMeta `yaml:",inline"`
+	Metadata ObjectMeta `yaml:"metadata"`
+	Spec     PodSpec    `yaml:"spec"`
+}
+
+type PodSpec struct {
+	InitContainers []Container `yaml:"initContainers"`
+	Containers     []Container `yaml:"containers"`
+	Volumes        []Volume    `yaml:"volumes"`
+}
+
+type Container struct {
+	Name  string `yaml:"name"`
+	Image string `yaml:"image"`
+}
+
+type Volume struct {
+	Name      string                 `yaml:"name"`
+	ConfigMap *ConfigMapVolumeSource `yaml:"configMap"`
+	Secret    *SecretVolumeSource    `yaml:"secret"`
+}
+
+type ConfigMapVolumeSource struct {
+	Name string `yaml:"name"`
+}
+
+type SecretVolumeSource struct {
+	SecretName string `yaml:"secretName"`
+}
+
+// Validate checks that the pod has a name and that every container has a name and an image.
+func (p Pod) Validate() error {
+	if p.Metadata.Name == "" {
+		return errors.New("pod has no name")
+	}
+
+	if len(p.Spec.Containers) == 0 {
+		return fmt.Errorf("pod %q has no containers", p.Metadata.Name)
+	}
+
+	containers := append(append([]Container{}, p.Spec.InitContainers...), p.Spec.Containers...)
+	names := make(map[string]struct{}, len(containers))
+	for _, c := range containers {
+		if c.Name == "" {
+			return fmt.Errorf("pod %q has a container without name", p.Metadata.Name)
+		}
+		if c.Image == "" {
+			return fmt.Errorf("container %q of pod %q has no image", c.Name, p.Metadata.Name)
+		}
+		if _, found := names[c.Name]; found {
+			return fmt.Errorf("pod %q has two containers named %q", p.Metadata.Name, c.Name)
+		}
+		names[c.Name] = struct{}{}
+	}
+
+	for _, v := range p.Spec.Volumes {
+		if v.Name == "" {
+			return fmt.Errorf("pod %q has a volume without name", p.Metadata.Name)
+		}
+	}
+
+	return nil
+}
+
+// ConfigMap is a Kubernetes ConfigMap.
+type ConfigMap struct {
+	TypeMeta   `yaml:",inline"`
+	Metadata   ObjectMeta        `yaml:"metadata"`
+	Data       map[string]string `yaml:"data"`
+	BinaryData map[string]string `yaml:"binaryData"`
+}
+
+// Validate checks that the config map has a name and that a key is not set in both data and binaryData.
+func (c ConfigMap) Validate() error {
+	if c.Metadata.Name == "" {
+		return errors.New("configmap has no name")
+	}
+
+	for k := range c.BinaryData {
+		if _, found := c.Data[k]; found {
+			return fmt.Errorf("key %q of configmap %q is set in both data and binaryData", k, c.Metadata.Name)
+		}
+	}
+
+	return nil
+}
+
+// Secret is a Kubernetes Secret stored in the repository.
+type Secret struct {
+	TypeMeta   `yaml:",inline"`
+	Metadata   ObjectMeta        `yaml:"metadata"`
+	Type       string            `yaml:"type"`
+	Data       map[string]string `yaml:"data"`
+	StringData map[string]string `yaml:"stringData"`
+}
+
+// Validate checks that the secret has a name.
+func (s Secret) Validate() error {
+	if s.Metadata.Name == "" {
+		return errors.New("secret has no name")
+	}
+	return nil
+}
@@ -1 +1,201 @@
 package models
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// QuadletType is the type of a podman Quadlet unit given by the extension of its file.
+type QuadletType string
+
+const (
+	ContainerQuadletType QuadletType = "container"
+	VolumeQuadletType    QuadletType = "volume"
+	NetworkQuadletType   QuadletType = "network"
+	KubeQuadletType      QuadletType = "kube"
+)
+
+// section returns the name of the section specific to the type of unit.
+func (q QuadletType) section() string {
+	switch q {
+	case ContainerQuadletType:
+		return "Container"
+	case VolumeQuadletType:
+		return "Volume"
+	case NetworkQuadletType:
+		return "Network"
+	case KubeQuadletType:
+		return "Kube"
+	default:
+		return ""
+	}
+}
+
+// requiredKeys returns the keys which must be set in the section specific to the type of unit.
+func (q QuadletType) requiredKeys() []string {
+	switch q {
+	case ContainerQuadletType:
+		return []string{"Image"}
+	case KubeQuadletType:
+		return []string{"Yaml"}
+	default:
+		return []string{}
+	}
+}
+
+// commonSections are the systemd sections allowed in every unit.
+var commonSections = map[string]struct{}{
+	"Unit":    {},
+	"Install": {},
+	"Service": {},
+	"Quadlet": {},
+}
+
+// Quadlet is a podman Quadlet unit file.
+type Quadlet struct {
+	// Name is the file name of the unit, e.g. web.container.
+	Name string
+	Type QuadletType
+	// Sections holds the values of the keys of each section. A key can be set several times.
+	Sections map[string]map[string][]string
+	// Content is the content of the unit file as read from the repository.
+	Content []byte
+}
+
+// IsQuadlet returns true if the file name has the extension of a Quadlet unit.
+func IsQuadlet(filename string) bool {
+	_, ok := quadletType(filename)
+	return ok
+}
+
+// ParseQuadlet parses and validates the content of a Quadlet unit file.
+func ParseQuadlet(filename string, content []byte) (Quadlet, error) {
+	name := filepath.Base(filename)
+	t, ok := quadletType(name)
+	if !ok {
+		return Quadlet{}, fmt.Errorf("%q is not a quadlet unit", filename)
+	}
+
+	q := Quadlet{
+		Name:     name,
+		Type:     t,
+		Sections: make(map[string]map[string][]string),
+		Content:  content,
+	}
+
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		// a line ending with a backslash continues on the next line
+		for strings.HasSuffix(line, "\\") && scanner.Scan() {
+			lineNumber++
+			line = strings.TrimSpace(strings.TrimSuffix(line, "\\")) + " " + strings.TrimSpace(scanner.Text())
+		}
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || len(line) < 3 {
+				return Quadlet{}, fmt.Errorf("%s:%d: invalid section header %q", name, lineNumber, line)
+			}
+			section = line[1 : len(line)-1]
+			if _, ok := q.Sections[section]; !ok {
+				q.Sections[section] = make(map[string][]string)
+			}
+			continue
+		}
+
+		if section == "" {
+			return Quadlet{}, fmt.Errorf("%s:%d: key set outside of a section", name, lineNumber)
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return Quadlet{}, fmt.Errorf("%s:%d: expected key=value, got %q", name, lineNumber, line)
+		}
+		q.Sections[section][key] = append(q.Sections[section][key], strings.TrimSpace(value))
+	}
+	if err := scanner.Err(); err != nil {
+		return Quadlet{}, fmt.Errorf("unable to read %q: %w", name, err)
+	}
+
+	if err := q.Validate(); err != nil {
+		return Quadlet{}, err
+	}
+
+	return q, nil
+}
+
+// Validate checks that the unit has the section of its type with the required keys and no unknown sections.
+// Sections starting with "X-" are systemd extensions and are accepted.
+func (q Quadlet) Validate() error {
+	if strings.TrimSuffix(q.Name, filepath.Ext(q.Name)) == "" {
+		return fmt.Errorf("quadlet unit %q has no name", q.Name)
+	}
+
+	main := q.Type.section()
+	if main == "" {
+		return fmt.Errorf("%s: unknown quadlet type %q", q.Name, q.Type)
+	}
+
+	keys, ok := q.Sections[main]
+	if !ok {
+		return fmt.Errorf("%s: missing [%s] section", q.Name, main)
+	}
+
+	for _, k := range q.Type.requiredKeys() {
+		if v := keys[k]; len(v) == 0 || v[len(v)-1] == "" {
+			return fmt.Errorf("%s: missing %s= in [%s] section", q.Name, k, main)
+		}
+	}
+
+	for s := range q.Sections {
+		if _, ok := commonSections[s]; ok || s == main || strings.HasPrefix(s, "X-") {
+			continue
+		}
+		return fmt.Errorf("%s: unknown section [%s] in a %s unit", q.Name, s, q.Type)
+	}
+
+	return nil
+}
+
+// Get returns the last value of the key in the section or an empty string if the key is not set.
+func (q Quadlet) Get(section, key string) string {
+	values := q.Sections[section][key]
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+// MarshalYAML returns the document sent to the device for this unit.
+func (q Quadlet) MarshalYAML() (interface{}, error) {
+	return struct {
+		Kind    string `yaml:"kind"`
+		Name    string `yaml:"name"`
+		Content string `yaml:"content"`
+	}{
+		Kind:    "Quadlet",
+		Name:    q.Name,
+		Content: string(q.Content),
+	}, nil
+}
+
+func quadletType(filename string) (QuadletType, bool) {
+	switch t := QuadletType(strings.TrimPrefix(filepath.Ext(filename), ".")); t {
+	case ContainerQuadletType, VolumeQuadletType, NetworkQuadletType, KubeQuadletType:
+		return t, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// TemplateExtension is the extension of the resource files rendered with the variables of the device, e.g. pod.yaml.tmpl.
+const TemplateExtension = ".tmpl"
+
+// TemplateData is the data available in the templated resources, e.g. {{ .DeviceID }} or {{ .Vars.site }}.
+type TemplateData struct {
+	DeviceID  string
+	Namespace string
+	// Set is empty if the device is not in a set.
+	Set    string
+	Labels map[string]string
+	// Vars holds the variables of the namespace overridden by the ones of the set and of the device.
+	Vars map[string]string
+}
+
+// Template is a resource rendered with the variables of the device when its configuration is computed.
+type Template struct {
+	// Name is the file name of the resource without the template extension, e.g. web.container.
+	Name    string
+	Content []byte
+}
+
+// IsTemplate returns true if the file name has the template extension.
+func IsTemplate(filename string) bool {
+	return strings.HasSuffix(filename, TemplateExtension)
+}
+
+// ParseTemplate checks the syntax of a templated resource.
+func ParseTemplate(filename string, content []byte) (Template, error) {
+	t := Template{
+		Name:    filepath.Base(strings.TrimSuffix(filename, TemplateExtension)),
+		Content: content,
+	}
+
+	if _, err := t.parse(); err != nil {
+		return Template{}, err
+	}
+
+	return t, nil
+}
+
+// Render executes the template with the data. Using a variable which is not set is an error.
+func (t Template) Render(data TemplateData) ([]byte, error) {
+	tmpl, err := t.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("unable to render %q: %w", t.Name, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (t Template) parse() (*template.Template, error) {
+	tmpl, err := template.New(t.Name).Option("missingkey=error").Parse(string(t.Content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %q: %w", t.Name, err)
+	}
+	return tmpl, nil
+}
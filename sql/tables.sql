@@ -3,14 +3,25 @@ BEGIN;
 CREATE TABLE repo (
     id varchar(255) PRIMARY KEY,
     url TEXT NOT NULL,
-    branch TEXT, -- should be an enum allowing only "master" or "main"
+    branch TEXT, -- branch, tag_pattern and commit_sha are the git reference followed by the repository. Only one of them is set
     local_path TEXT,
     auth_type varchar(20),
     auth_secret_path varchar(20),
     current_head_sha TEXT,
     target_head_sha TEXT,
     pull_period_seconds SMALLINT DEFAULT 20,
-    CHECK(pull_period_seconds >= 0) -- if 0 stop pulling
+    webhook_secret_path TEXT, -- vault secret holding the key of the push webhooks. null if the repository has no webhook
+    next_sync_at TIMESTAMP, -- time of the next pull. null if the repository has never been pulled
+    tag_pattern TEXT, -- glob pattern of the tags. The highest semantic version among the matching tags is followed
+    commit_sha TEXT,
+    signing_keys_secret_path TEXT, -- vault secret holding the keys trusted to sign the commits. null if the signatures are not verified
+    rejected_head_sha TEXT, -- last commit refused because its signature did not verify
+    rejection_reason TEXT,
+    subpath TEXT, -- directory holding the manifests. null if the manifests are searched in the whole repository
+    include_globs TEXT, -- newline separated globs of the files used for the manifests
+    exclude_globs TEXT, -- newline separated globs of the files ignored
+    CHECK(pull_period_seconds >= 0), -- if 0 stop pulling
+    CHECK(num_nonnulls(branch, tag_pattern, commit_sha) <= 1)
 );
 
 CREATE TYPE ref_type as ENUM ('workload', 'configuration');
@@ -19,7 +30,8 @@ CREATE TABLE manifest (
     id varchar(255) PRIMARY KEY,
     version varchar(30) NOT NULL,
     repo_id varchar(255) NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
-    path TEXT NOT NULL
+    path TEXT NOT NULL,
+    label_selector TEXT -- label selector of the manifest as json. null if the manifest does not select devices by labels.
 );
 
 CREATE TABLE namespace (
@@ -40,7 +52,66 @@ CREATE TABLE device (
     registered BOOLEAN NOT NULL DEFAULT false,
     certificate_sn TEXT,
     namespace_id varchar(255) NOT NULL REFERENCES namespace(id) ON DELETE SET NULL,
-    device_set_id varchar(255) REFERENCES device_set(id) ON DELETE SET NULL
+    device_set_id varchar(255) REFERENCES device_set(id) ON DELETE SET NULL,
+    state varchar(20) NOT NULL DEFAULT 'offline', -- online, late or offline
+    last_seen TIMESTAMP,
+    uptime_seconds BIGINT,
+    configuration_hash TEXT,
+    decommissioned_at TIMESTAMP
+);
+
+CREATE TABLE device_labels (
+    device_id varchar(255) REFERENCES device(id) ON DELETE CASCADE,
+    key varchar(255) NOT NULL,
+    value TEXT NOT NULL,
+    CONSTRAINT device_labels_pk PRIMARY KEY (
+        device_id,
+        key
+    )
+);
+
+-- variables used to render the templated resources of the workloads.
+-- The variables of the device override the ones of the set which override the ones of the namespace.
+CREATE TABLE device_variables (
+    device_id varchar(255) REFERENCES device(id) ON DELETE CASCADE,
+    key varchar(255) NOT NULL,
+    value TEXT NOT NULL,
+    CONSTRAINT device_variables_pk PRIMARY KEY (
+        device_id,
+        key
+    )
+);
+
+CREATE TABLE set_variables (
+    device_set_id varchar(255) REFERENCES device_set(id) ON DELETE CASCADE,
+    key varchar(255) NOT NULL,
+    value TEXT NOT NULL,
+    CONSTRAINT set_variables_pk PRIMARY KEY (
+        device_set_id,
+        key
+    )
+);
+
+CREATE TABLE namespace_variables (
+    namespace_id varchar(255) REFERENCES namespace(id) ON DELETE CASCADE,
+    key varchar(255) NOT NULL,
+    value TEXT NOT NULL,
+    CONSTRAINT namespace_variables_pk PRIMARY KEY (
+        namespace_id,
+        key
+    )
+);
+
+CREATE TABLE enrolment_token (
+    id varchar(255) PRIMARY KEY,
+    token_hash TEXT NOT NULL UNIQUE, -- sha256 of the token. The token itself is never stored.
+    namespace_id varchar(255) NOT NULL REFERENCES namespace(id) ON DELETE CASCADE,
+    device_set_id varchar(255) REFERENCES device_set(id) ON DELETE CASCADE,
+    max_uses INTEGER NOT NULL DEFAULT 1,
+    uses INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    CHECK(uses <= max_uses)
 );
 
 CREATE TABLE devices_manifests (
@@ -70,20 +141,62 @@ CREATE TABLE sets_manifests (
     )
 );
 
+-- secrets referenced by the manifests. target_hash is the hash of the value read from Vault and
+-- current_hash the one of the value sent to the devices. They differ when the secret has been rotated.
 CREATE TABLE secret (
     id varchar(255) PRIMARY KEY,
     path varchar(255) NOT NULL,
+    key varchar(255) NOT NULL,
     current_hash TEXT NOT NULL,
     target_hash TEXT NOT NULL
 );
 
 CREATE TABLE secrets_manifests (
-    secret_id varchar(255) REFERENCES secret(id),
-    manifest_id varchar(255) REFERENCES manifest(id),
+    secret_id varchar(255) REFERENCES secret(id) ON DELETE CASCADE,
+    manifest_id varchar(255) REFERENCES manifest(id) ON DELETE CASCADE,
     CONSTRAINT secret_manifest_pk PRIMARY KEY(
         secret_id,
         manifest_id
     )
 );
 
+-- last status of the workloads reported by the devices in their heartbeats.
+-- manifest_id is not a foreign key because devices may report workloads which are not known anymore.
+CREATE TABLE workload_status (
+    device_id varchar(255) REFERENCES device(id) ON DELETE CASCADE,
+    manifest_id varchar(255) NOT NULL,
+    state varchar(20) NOT NULL DEFAULT 'deploying',
+    last_updated TIMESTAMP,
+    reported_at TIMESTAMP NOT NULL,
+    CONSTRAINT workload_status_pk PRIMARY KEY (
+        device_id,
+        manifest_id
+    )
+);
+
+-- runs of the synchronization of the repositories with their remote. Only the last runs of each repository are kept.
+CREATE TABLE repo_sync (
+    id varchar(255) PRIMARY KEY,
+    repo_id varchar(255) NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    started_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP NOT NULL,
+    from_sha TEXT, -- null if the repository has never been pulled before the run
+    to_sha TEXT,
+    manifests_created INTEGER NOT NULL DEFAULT 0,
+    manifests_updated INTEGER NOT NULL DEFAULT 0,
+    manifests_deleted INTEGER NOT NULL DEFAULT 0,
+    error TEXT -- null if the run succeeded
+);
+
+-- manifest files refused during a sync run.
+CREATE TABLE repo_sync_file_error (
+    sync_id varchar(255) REFERENCES repo_sync(id) ON DELETE CASCADE,
+    path TEXT NOT NULL,
+    error TEXT NOT NULL,
+    CONSTRAINT repo_sync_file_error_pk PRIMARY KEY (
+        sync_id,
+        path
+    )
+);
+
 COMMIT;